@@ -6,12 +6,14 @@ var services = mapOf(
         "administrativeunits" to "Administrative Units",
         "approleassignments" to "App Role Assignments",
         "applications" to "Applications",
+        "authenticationevents" to "Authentication Events",
         "conditionalaccess" to "Conditional Access",
         "directoryobjects" to "Directory Objects",
         "directoryroles" to "Directory Roles",
         "domains" to "Domains",
         "groups" to "Groups",
         "identitygovernance" to "Identity Governance",
+        "identityproviders" to "Identity Providers",
         "invitations" to "Invitations",
         "policies" to "Policies",
         "serviceprincipals" to "Service Principals",
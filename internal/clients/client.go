@@ -22,12 +22,14 @@ import (
 	administrativeunits "github.com/hashicorp/terraform-provider-azuread/internal/services/administrativeunits/client"
 	applications "github.com/hashicorp/terraform-provider-azuread/internal/services/applications/client"
 	approleassignments "github.com/hashicorp/terraform-provider-azuread/internal/services/approleassignments/client"
+	authenticationevents "github.com/hashicorp/terraform-provider-azuread/internal/services/authenticationevents/client"
 	conditionalaccess "github.com/hashicorp/terraform-provider-azuread/internal/services/conditionalaccess/client"
 	directoryobjects "github.com/hashicorp/terraform-provider-azuread/internal/services/directoryobjects/client"
 	directoryroles "github.com/hashicorp/terraform-provider-azuread/internal/services/directoryroles/client"
 	domains "github.com/hashicorp/terraform-provider-azuread/internal/services/domains/client"
 	groups "github.com/hashicorp/terraform-provider-azuread/internal/services/groups/client"
 	identitygovernance "github.com/hashicorp/terraform-provider-azuread/internal/services/identitygovernance/client"
+	identityproviders "github.com/hashicorp/terraform-provider-azuread/internal/services/identityproviders/client"
 	invitations "github.com/hashicorp/terraform-provider-azuread/internal/services/invitations/client"
 	policies "github.com/hashicorp/terraform-provider-azuread/internal/services/policies/client"
 	serviceprincipals "github.com/hashicorp/terraform-provider-azuread/internal/services/serviceprincipals/client"
@@ -48,21 +50,23 @@ type Client struct {
 
 	StopContext context.Context
 
-	AdministrativeUnits *administrativeunits.Client
-	Applications        *applications.Client
-	AppRoleAssignments  *approleassignments.Client
-	ConditionalAccess   *conditionalaccess.Client
-	DirectoryObjects    *directoryobjects.Client
-	DirectoryRoles      *directoryroles.Client
-	Domains             *domains.Client
-	Groups              *groups.Client
-	IdentityGovernance  *identitygovernance.Client
-	Invitations         *invitations.Client
-	Policies            *policies.Client
-	ServicePrincipals   *serviceprincipals.Client
-	Synchronization     *synchronization.Client
-	UserFlows           *userflows.Client
-	Users               *users.Client
+	AdministrativeUnits  *administrativeunits.Client
+	Applications         *applications.Client
+	AppRoleAssignments   *approleassignments.Client
+	AuthenticationEvents *authenticationevents.Client
+	ConditionalAccess    *conditionalaccess.Client
+	DirectoryObjects     *directoryobjects.Client
+	DirectoryRoles       *directoryroles.Client
+	Domains              *domains.Client
+	Groups               *groups.Client
+	IdentityGovernance   *identitygovernance.Client
+	IdentityProviders    *identityproviders.Client
+	Invitations          *invitations.Client
+	Policies             *policies.Client
+	ServicePrincipals    *serviceprincipals.Client
+	Synchronization      *synchronization.Client
+	UserFlows            *userflows.Client
+	Users                *users.Client
 }
 
 func (client *Client) build(ctx context.Context, o *common.ClientOptions) error {
@@ -79,6 +83,9 @@ func (client *Client) build(ctx context.Context, o *common.ClientOptions) error
 	if client.AppRoleAssignments, err = approleassignments.NewClient(o); err != nil {
 		return fmt.Errorf("building clients for AppRoleAssignments: %v", err)
 	}
+	if client.AuthenticationEvents, err = authenticationevents.NewClient(o); err != nil {
+		return fmt.Errorf("building clients for AuthenticationEvents: %v", err)
+	}
 	if client.ConditionalAccess, err = conditionalaccess.NewClient(o); err != nil {
 		return fmt.Errorf("building clients for ConditionalAccess: %v", err)
 	}
@@ -97,6 +104,9 @@ func (client *Client) build(ctx context.Context, o *common.ClientOptions) error
 	if client.IdentityGovernance, err = identitygovernance.NewClient(o); err != nil {
 		return fmt.Errorf("building clients for IdentityGovernance: %v", err)
 	}
+	if client.IdentityProviders, err = identityproviders.NewClient(o); err != nil {
+		return fmt.Errorf("building clients for IdentityProviders: %v", err)
+	}
 	if client.Invitations, err = invitations.NewClient(o); err != nil {
 		return fmt.Errorf("building clients for Invitations: %v", err)
 	}
@@ -56,14 +56,12 @@ func GetPasswordCredential(passwordCredentials *[]stable.PasswordCredential, id
 	return
 }
 
+// GetTokenSigningCertificateThumbprint returns the SHA-1 thumbprint of a certificate as an uppercase hexadecimal string.
+// The certificate can be either PEM-encoded, or base64-encoded DER as returned by the Microsoft Graph API
 func GetTokenSigningCertificateThumbprint(certByte []byte) (string, error) {
-	block, _ := pem.Decode(certByte)
-	if block == nil {
-		return "", fmt.Errorf("decoding certificate block")
-	}
-	cert, err := x509.ParseCertificate(block.Bytes)
+	cert, err := ParseCertificate(string(certByte))
 	if err != nil {
-		return "", fmt.Errorf("parsing certificate block data: %+v", err)
+		return "", err
 	}
 	thumbprint := sha1.Sum(cert.Raw)
 
@@ -74,6 +72,80 @@ func GetTokenSigningCertificateThumbprint(certByte []byte) (string, error) {
 	return buf.String(), nil
 }
 
+// ParseCertificate parses an X.509 certificate, which can be either PEM-encoded, or base64-encoded DER as returned by
+// the Microsoft Graph API
+func ParseCertificate(value string) (*x509.Certificate, error) {
+	value = strings.TrimSpace(value)
+
+	var der []byte
+	if block, _ := pem.Decode([]byte(value)); block != nil {
+		der = block.Bytes
+	} else {
+		decoded, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode base64 certificate data")
+		}
+		der = decoded
+	}
+
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, fmt.Errorf("parsing certificate block data: %+v", err)
+	}
+
+	return cert, nil
+}
+
+// NormalizeCertificate returns the base64-encoded DER representation of a certificate, which can be either PEM-encoded
+// or base64-encoded DER, as expected by the Microsoft Graph API
+func NormalizeCertificate(value string) (string, error) {
+	cert, err := ParseCertificate(value)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(cert.Raw), nil
+}
+
+// ValidateCertificate is a SchemaValidateFunc which tests that the value is a PEM-encoded or base64-encoded DER X.509
+// certificate, and warns when the certificate has already expired
+func ValidateCertificate(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	cert, err := ParseCertificate(v)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a PEM-encoded or base64-encoded DER X.509 certificate: %+v", k, err))
+		return
+	}
+
+	if time.Now().After(cert.NotAfter) {
+		warnings = append(warnings, fmt.Sprintf("the certificate specified for %q expired at %s", k, cert.NotAfter.Format(time.RFC3339)))
+	}
+
+	return
+}
+
+// CertificateDiffSuppress suppresses differences in the encoding of otherwise identical certificates
+func CertificateDiffSuppress(_, old, new string, _ *pluginsdk.ResourceData) bool {
+	if old == "" || new == "" {
+		return false
+	}
+
+	oldCert, err := ParseCertificate(old)
+	if err != nil {
+		return false
+	}
+	newCert, err := ParseCertificate(new)
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(oldCert.Raw, newCert.Raw)
+}
+
 func KeyCredentialForResource(d *pluginsdk.ResourceData) (*stable.KeyCredential, error) {
 	keyType := d.Get("type").(string)
 	value := d.Get("value").(string)
@@ -3,6 +3,14 @@
 
 package credentials
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
 type CredentialError struct {
 	str  string
 	attr string
@@ -15,3 +23,26 @@ func (e CredentialError) Attr() string {
 func (e CredentialError) Error() string {
 	return e.str
 }
+
+// appManagementPolicyErrorCodeSuffix is the common suffix of the error codes returned by Microsoft Graph when a credential
+// is rejected by an app management policy, e.g. `CredentialTypeNotAllowedAsPerAppPolicy`
+const appManagementPolicyErrorCodeSuffix = "AsPerAppPolicy"
+
+// IsAppManagementPolicyViolation returns whether the API rejected a credential because it does not comply with an app
+// management policy applying to the application or service principal
+func IsAppManagementPolicyViolation(o *odata.OData) bool {
+	if o == nil {
+		return false
+	}
+	for e := o.Error; e != nil; e = e.InnerError {
+		if strings.HasSuffix(pointer.From(e.Code), appManagementPolicyErrorCodeSuffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// AppManagementPolicyViolationError returns an error explaining that a credential was rejected by an app management policy
+func AppManagementPolicyViolationError(o *odata.OData) error {
+	return fmt.Errorf("%s\n\nThe requested credential does not comply with an app management policy. Check the tenant default app management policy and any app management policy assigned to this application or service principal, and ensure that the credential type is permitted and that its lifetime does not exceed the maximum allowed.", o.Error)
+}
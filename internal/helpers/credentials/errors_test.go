@@ -0,0 +1,54 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package credentials
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+func TestIsAppManagementPolicyViolation(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Body     string
+		Expected bool
+	}{
+		{
+			Name:     "credential type not allowed",
+			Body:     `{"error":{"code":"CredentialTypeNotAllowedAsPerAppPolicy","message":"Credential type not allowed as per assigned policy.","innerError":{"date":"2025-03-04T10:15:02","request-id":"2b1c5a4e-1f0d-4c1e-9d55-5d0f7c2f8e11","client-request-id":"2b1c5a4e-1f0d-4c1e-9d55-5d0f7c2f8e11"}}}`,
+			Expected: true,
+		},
+		{
+			Name:     "credential lifetime exceeded",
+			Body:     `{"error":{"code":"CredentialLifetimeExceedsMaxAllowedAsPerAppPolicy","message":"Credential lifetime exceeds the max value allowed as per assigned policy.","innerError":{"date":"2025-03-04T10:15:02","request-id":"7d3e9c20-6a4b-4b8f-a1e2-0c9f3d5b6a77","client-request-id":"7d3e9c20-6a4b-4b8f-a1e2-0c9f3d5b6a77"}}}`,
+			Expected: true,
+		},
+		{
+			Name:     "unrelated error mentioning a policy",
+			Body:     `{"error":{"code":"Request_BadRequest","message":"Invalid value specified for property 'endDateTime' as per assigned policy.","innerError":{"date":"2025-03-04T10:15:02","request-id":"0f8e4d2a-93c1-4f6b-8a5e-3b2d1c0e9f44","client-request-id":"0f8e4d2a-93c1-4f6b-8a5e-3b2d1c0e9f44"}}}`,
+			Expected: false,
+		},
+		{
+			Name:     "no error",
+			Body:     `{"@odata.context":"https://graph.microsoft.com/v1.0/$metadata#microsoft.graph.passwordCredential"}`,
+			Expected: false,
+		},
+	}
+
+	for _, tc := range cases {
+		var o odata.OData
+		if err := json.Unmarshal([]byte(tc.Body), &o); err != nil {
+			t.Fatalf("%s: unmarshaling error payload: %v", tc.Name, err)
+		}
+		if actual := IsAppManagementPolicyViolation(&o); actual != tc.Expected {
+			t.Fatalf("%s: expected IsAppManagementPolicyViolation to return %t, got %t", tc.Name, tc.Expected, actual)
+		}
+	}
+
+	if IsAppManagementPolicyViolation(nil) {
+		t.Fatalf("expected IsAppManagementPolicyViolation to return false for a nil response")
+	}
+}
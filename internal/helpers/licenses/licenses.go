@@ -0,0 +1,127 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package licenses
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/msgraph"
+)
+
+// ListSubscribedSkus retrieves the commercial subscriptions acquired by the tenant from GET /subscribedSkus, for which
+// there is no package in the SDK.
+func ListSubscribedSkus(ctx context.Context, c *msgraph.Client) (*[]stable.SubscribedSku, error) {
+	opts := client.RequestOptions{
+		ContentType:         "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{http.StatusOK},
+		HttpMethod:          http.MethodGet,
+		Path:                "/subscribedSkus",
+	}
+
+	req, err := c.NewRequest(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var values struct {
+		Values *[]stable.SubscribedSku `json:"value"`
+	}
+	if err = resp.Unmarshal(&values); err != nil {
+		return nil, err
+	}
+
+	return values.Values, nil
+}
+
+// FindSubscribedSku returns the subscribed SKU matching either the specified SKU ID or SKU part number, e.g. `ENTERPRISEPACK`
+func FindSubscribedSku(ctx context.Context, c *msgraph.Client, skuId, skuPartNumber string) (*stable.SubscribedSku, error) {
+	skus, err := ListSubscribedSkus(ctx, c)
+	if err != nil {
+		return nil, fmt.Errorf("listing subscribed SKUs: %+v", err)
+	}
+	if skus == nil {
+		return nil, fmt.Errorf("listing subscribed SKUs: model was nil")
+	}
+
+	partNumbers := make([]string, 0)
+	for _, sku := range *skus {
+		if skuId != "" && strings.EqualFold(sku.SkuId.GetOrZero(), skuId) {
+			return &sku, nil
+		}
+		if skuPartNumber != "" && strings.EqualFold(sku.SkuPartNumber.GetOrZero(), skuPartNumber) {
+			return &sku, nil
+		}
+		partNumbers = append(partNumbers, sku.SkuPartNumber.GetOrZero())
+	}
+
+	sort.Strings(partNumbers)
+
+	if skuId != "" {
+		return nil, fmt.Errorf("no subscribed SKU was found with the ID %q, subscribed SKUs are: %s", skuId, strings.Join(partNumbers, ", "))
+	}
+	return nil, fmt.Errorf("no subscribed SKU was found with the part number %q, subscribed SKUs are: %s", skuPartNumber, strings.Join(partNumbers, ", "))
+}
+
+// ExpandServicePlans resolves a list of service plan names for the specified SKU into a list of service plan IDs
+func ExpandServicePlans(sku stable.SubscribedSku, names []string) (*[]string, error) {
+	plans := make(map[string]string)
+	available := make([]string, 0)
+	for _, plan := range pointer.From(sku.ServicePlans) {
+		plans[strings.ToLower(plan.ServicePlanName.GetOrZero())] = plan.ServicePlanId.GetOrZero()
+		available = append(available, plan.ServicePlanName.GetOrZero())
+	}
+	sort.Strings(available)
+
+	result := make([]string, 0)
+	for _, name := range names {
+		id, ok := plans[strings.ToLower(name)]
+		if !ok {
+			return nil, fmt.Errorf("the service plan %q is not included in the SKU %q, available service plans are: %s", name, sku.SkuPartNumber.GetOrZero(), strings.Join(available, ", "))
+		}
+		result = append(result, id)
+	}
+
+	return &result, nil
+}
+
+// FlattenServicePlans resolves a list of service plan IDs for the specified SKU into a list of service plan names. Any
+// service plans not found in the SKU are returned as their ID. Names matching one of the configured names are returned
+// with the configured casing, since service plan names are matched case-insensitively.
+func FlattenServicePlans(sku stable.SubscribedSku, ids *[]string, configured []string) []string {
+	plans := make(map[string]string)
+	for _, plan := range pointer.From(sku.ServicePlans) {
+		plans[strings.ToLower(plan.ServicePlanId.GetOrZero())] = plan.ServicePlanName.GetOrZero()
+	}
+
+	configuredNames := make(map[string]string)
+	for _, name := range configured {
+		configuredNames[strings.ToLower(name)] = name
+	}
+
+	result := make([]string, 0)
+	for _, id := range pointer.From(ids) {
+		if name, ok := plans[strings.ToLower(id)]; ok {
+			if configuredName, ok := configuredNames[strings.ToLower(name)]; ok {
+				name = configuredName
+			}
+			result = append(result, name)
+			continue
+		}
+		result = append(result, id)
+	}
+
+	return result
+}
@@ -0,0 +1,148 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package lookup
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+const (
+	// MaxFilterValues is the maximum number of values supported by Microsoft Graph in a single `in` filter clause
+	MaxFilterValues = 15
+
+	// DefaultConcurrency is the number of chunked requests that are made concurrently
+	DefaultConcurrency = 5
+)
+
+// ChunkFunc retrieves the objects matching a single chunk of values
+type ChunkFunc[T any] func(ctx context.Context, chunk []string) ([]T, error)
+
+// InChunks de-duplicates the provided values, splits them into chunks of at most chunkSize values, and invokes f for
+// each chunk using at most concurrency workers. The combined results are returned in the same order as the chunks,
+// so that callers can correlate results with their inputs. The first error encountered cancels any remaining chunks.
+func InChunks[T any](ctx context.Context, values []string, chunkSize, concurrency int, f ChunkFunc[T]) ([]T, error) {
+	if chunkSize < 1 {
+		return nil, fmt.Errorf("chunkSize must be at least 1, got %d", chunkSize)
+	}
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	chunks := Chunk(Unique(values), chunkSize)
+	results := make([][]T, len(chunks))
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	var once sync.Once
+	var firstErr error
+	semaphore := make(chan struct{}, concurrency)
+
+	for i, chunk := range chunks {
+		select {
+		case semaphore <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(i int, chunk []string) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			result, err := f(ctx, chunk)
+			if err != nil {
+				once.Do(func() {
+					firstErr = err
+					cancel()
+				})
+				return
+			}
+			results[i] = result
+		}(i, chunk)
+	}
+
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	out := make([]T, 0)
+	for _, r := range results {
+		out = append(out, r...)
+	}
+
+	return out, nil
+}
+
+// ListFunc retrieves the objects matching the provided OData filter expression
+type ListFunc[T any] func(ctx context.Context, filter string) ([]T, error)
+
+// ByProperty retrieves objects where property matches any of the provided values. Values are combined into chunked
+// `in` filters which are requested concurrently using list, and the results are keyed by the lower-cased value
+// returned by key, so that callers can correlate them with the provided values.
+func ByProperty[T any](ctx context.Context, property string, values []string, list ListFunc[T], key func(T) string) (map[string][]T, error) {
+	objects, err := InChunks(ctx, values, MaxFilterValues, DefaultConcurrency, func(ctx context.Context, chunk []string) ([]T, error) {
+		return list(ctx, InFilter(property, chunk))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string][]T)
+	for _, o := range objects {
+		k := strings.ToLower(key(o))
+		result[k] = append(result[k], o)
+	}
+
+	return result, nil
+}
+
+// Chunk splits values into consecutive slices of at most size values
+func Chunk(values []string, size int) [][]string {
+	chunks := make([][]string, 0, (len(values)+size-1)/size)
+	for size < len(values) {
+		values, chunks = values[size:], append(chunks, values[0:size:size])
+	}
+	if len(values) > 0 {
+		chunks = append(chunks, values)
+	}
+	return chunks
+}
+
+// Unique returns the provided values with case-insensitive duplicates removed, retaining the first occurrence of each
+func Unique(values []string) []string {
+	seen := make(map[string]struct{}, len(values))
+	out := make([]string, 0, len(values))
+	for _, v := range values {
+		k := strings.ToLower(v)
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+		out = append(out, v)
+	}
+	return out
+}
+
+// InFilter returns an OData filter expression matching objects where property is equal to any of the provided values
+func InFilter(property string, values []string) string {
+	quoted := make([]string, 0, len(values))
+	for _, v := range values {
+		quoted = append(quoted, fmt.Sprintf("'%s'", odata.EscapeSingleQuote(v)))
+	}
+	return fmt.Sprintf("%s in (%s)", property, strings.Join(quoted, ", "))
+}
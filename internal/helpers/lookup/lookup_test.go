@@ -0,0 +1,171 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package lookup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestChunk(t *testing.T) {
+	cases := []struct {
+		Values   []string
+		Size     int
+		Expected [][]string
+	}{
+		{
+			Values:   []string{},
+			Size:     2,
+			Expected: [][]string{},
+		},
+		{
+			Values:   []string{"a", "b", "c"},
+			Size:     2,
+			Expected: [][]string{{"a", "b"}, {"c"}},
+		},
+		{
+			Values:   []string{"a", "b", "c", "d"},
+			Size:     2,
+			Expected: [][]string{{"a", "b"}, {"c", "d"}},
+		},
+		{
+			Values:   []string{"a"},
+			Size:     15,
+			Expected: [][]string{{"a"}},
+		},
+	}
+
+	for _, tc := range cases {
+		if actual := Chunk(tc.Values, tc.Size); !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("Expected Chunk(%v, %d) to return %v, got %v", tc.Values, tc.Size, tc.Expected, actual)
+		}
+	}
+}
+
+func TestUnique(t *testing.T) {
+	actual := Unique([]string{"Kat", "byte", "kat", "Byte", "lucky"})
+	expected := []string{"Kat", "byte", "lucky"}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %v, got %v", expected, actual)
+	}
+}
+
+func TestInFilter(t *testing.T) {
+	actual := InFilter("userPrincipalName", []string{"kat@example.com", "o'brien@example.com"})
+	expected := "userPrincipalName in ('kat@example.com', 'o''brien@example.com')"
+	if actual != expected {
+		t.Fatalf("Expected %q, got %q", expected, actual)
+	}
+}
+
+func TestInChunks(t *testing.T) {
+	values := make([]string, 0)
+	for i := 0; i < 100; i++ {
+		values = append(values, fmt.Sprintf("value-%03d", i))
+	}
+	values = append(values, "VALUE-000")
+
+	var inFlight, maxInFlight int32
+	results, err := InChunks(context.Background(), values, 7, 3, func(ctx context.Context, chunk []string) ([]string, error) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			m := atomic.LoadInt32(&maxInFlight)
+			if n <= m || atomic.CompareAndSwapInt32(&maxInFlight, m, n) {
+				break
+			}
+		}
+
+		if len(chunk) > 7 {
+			return nil, fmt.Errorf("chunk too large: %d", len(chunk))
+		}
+
+		// Finish chunks out of order to verify that results are still returned in order
+		time.Sleep(time.Duration(len(chunk)%3) * time.Millisecond)
+
+		out := make([]string, 0, len(chunk))
+		for _, v := range chunk {
+			out = append(out, strings.ToUpper(v))
+		}
+		return out, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(results) != 100 {
+		t.Fatalf("Expected 100 results, got %d", len(results))
+	}
+	for i, r := range results {
+		if expected := fmt.Sprintf("VALUE-%03d", i); r != expected {
+			t.Fatalf("Expected result %d to be %q, got %q", i, expected, r)
+		}
+	}
+	if maxInFlight > 3 {
+		t.Fatalf("Expected at most 3 concurrent requests, got %d", maxInFlight)
+	}
+}
+
+func TestInChunksError(t *testing.T) {
+	values := []string{"a", "b", "c", "d", "e", "f"}
+
+	var calls int32
+	_, err := InChunks(context.Background(), values, 1, 1, func(ctx context.Context, chunk []string) ([]string, error) {
+		atomic.AddInt32(&calls, 1)
+		if chunk[0] == "b" {
+			return nil, errors.New("failed")
+		}
+		return chunk, nil
+	})
+	if err == nil || err.Error() != "failed" {
+		t.Fatalf("Expected error %q, got %v", "failed", err)
+	}
+	if calls != 2 {
+		t.Fatalf("Expected remaining chunks to be cancelled after 2 calls, got %d", calls)
+	}
+}
+
+func TestByProperty(t *testing.T) {
+	values := []string{"Kat@example.com", "byte@example.com", "missing@example.com"}
+	objects := map[string]string{
+		"kat@example.com":  "kat",
+		"byte@example.com": "byte",
+	}
+
+	var filters []string
+	results, err := ByProperty(context.Background(), "mail", values, func(ctx context.Context, filter string) ([]string, error) {
+		filters = append(filters, filter)
+		out := make([]string, 0)
+		for mail := range objects {
+			if strings.Contains(strings.ToLower(filter), "'"+mail+"'") {
+				out = append(out, mail)
+			}
+		}
+		return out, nil
+	}, func(mail string) string {
+		return strings.ToUpper(mail)
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expectedFilters := []string{"mail in ('Kat@example.com', 'byte@example.com', 'missing@example.com')"}
+	if !reflect.DeepEqual(filters, expectedFilters) {
+		t.Fatalf("Expected filters %v, got %v", expectedFilters, filters)
+	}
+	if len(results) != 2 {
+		t.Fatalf("Expected 2 results, got %d", len(results))
+	}
+	for mail := range objects {
+		if r := results[mail]; len(r) != 1 || r[0] != mail {
+			t.Fatalf("Expected result for %q to be [%q], got %v", mail, mail, r)
+		}
+	}
+}
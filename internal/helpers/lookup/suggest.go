@@ -0,0 +1,74 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package lookup
+
+import (
+	"sort"
+	"strings"
+)
+
+// Suggest returns up to limit candidates that closely resemble value, ordered from the closest match, for use in
+// "did you mean" error messages. Candidates are compared case-insensitively, and are considered to resemble value
+// when they differ by only a few characters, or when either contains the other.
+func Suggest(value string, candidates []string, limit int) []string {
+	type suggestion struct {
+		candidate string
+		distance  int
+	}
+
+	needle := strings.ToLower(value)
+	threshold := max(2, len(needle)/3)
+
+	suggestions := make([]suggestion, 0)
+	for _, candidate := range candidates {
+		c := strings.ToLower(candidate)
+		distance := editDistance(needle, c)
+		if distance > threshold && !strings.Contains(c, needle) && !strings.Contains(needle, c) {
+			continue
+		}
+		suggestions = append(suggestions, suggestion{candidate: candidate, distance: distance})
+	}
+
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		if suggestions[i].distance != suggestions[j].distance {
+			return suggestions[i].distance < suggestions[j].distance
+		}
+		return suggestions[i].candidate < suggestions[j].candidate
+	})
+
+	result := make([]string, 0)
+	for _, s := range suggestions {
+		if len(result) == limit {
+			break
+		}
+		result = append(result, s.candidate)
+	}
+
+	return result
+}
+
+// editDistance returns the Levenshtein distance between a and b
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+
+	previous := make([]int, len(rb)+1)
+	current := make([]int, len(rb)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		current[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+
+	return previous[len(rb)]
+}
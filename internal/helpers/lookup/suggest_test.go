@@ -0,0 +1,46 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package lookup
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSuggest(t *testing.T) {
+	candidates := []string{"User.Read", "User.Read.All", "User.ReadWrite.All", "Group.Read.All", "Mail.Send"}
+
+	cases := []struct {
+		Value    string
+		Limit    int
+		Expected []string
+	}{
+		{
+			Value:    "user.read.all",
+			Limit:    3,
+			Expected: []string{"User.Read.All", "User.Read"},
+		},
+		{
+			Value:    "User.Reed.All",
+			Limit:    1,
+			Expected: []string{"User.Read.All"},
+		},
+		{
+			Value:    "Mail",
+			Limit:    5,
+			Expected: []string{"Mail.Send"},
+		},
+		{
+			Value:    "Directory.AccessAsUser.All",
+			Limit:    5,
+			Expected: []string{},
+		},
+	}
+
+	for _, tc := range cases {
+		if result := Suggest(tc.Value, candidates, tc.Limit); !reflect.DeepEqual(result, tc.Expected) {
+			t.Fatalf("Suggest(%q): expected %v, got %v", tc.Value, tc.Expected, result)
+		}
+	}
+}
@@ -0,0 +1,25 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package pluginsdk
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+// GetWriteOnlyString returns the value of a write-only string attribute. Write-only attributes are never persisted to
+// state, so must be read from the raw configuration. An empty string is returned when the attribute is not set.
+func GetWriteOnlyString(d *ResourceData, configFieldName string) (string, error) {
+	v, diags := d.GetRawConfigAt(cty.GetAttrPath(configFieldName))
+	if diags.HasError() {
+		return "", fmt.Errorf("retrieving write-only attribute %q from configuration", configFieldName)
+	}
+
+	if v.IsNull() || !v.IsKnown() || !v.Type().Equals(cty.String) {
+		return "", nil
+	}
+
+	return v.AsString(), nil
+}
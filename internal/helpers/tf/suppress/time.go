@@ -0,0 +1,22 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package suppress
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+)
+
+func RFC3339Time(_, old, new string, _ *pluginsdk.ResourceData) bool {
+	ot, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+	nt, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+	return nt.Equal(ot)
+}
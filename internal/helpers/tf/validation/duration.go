@@ -0,0 +1,24 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package validation
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// ISO8601Duration validates that the given string is a valid ISO8601 duration (P90D, PT12H)
+func ISO8601Duration(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected a string value for %q", k)}
+	}
+
+	regExIsISO8601Duration := regexp.MustCompile(`^P(\d+Y)?(\d+M)?(\d+W)?(\d+D)?(T(\d+H)?(\d+M)?(\d+(\.\d+)?S)?)?$`)
+	if v == "P" || !regExIsISO8601Duration.MatchString(v) || v[len(v)-1] == 'T' {
+		return nil, []error{fmt.Errorf("value must be a valid ISO8601 duration for %q, e.g. P90D or PT12H", k)}
+	}
+
+	return
+}
@@ -0,0 +1,57 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package validation
+
+import (
+	"testing"
+)
+
+func TestISO8601Duration(t *testing.T) {
+	cases := []struct {
+		Value    string
+		TestName string
+		ErrCount int
+	}{
+		{
+			Value:    "P90D",
+			TestName: "Valid_Days",
+			ErrCount: 0,
+		},
+		{
+			Value:    "P1Y2M3DT4H5M6S",
+			TestName: "Valid_AllComponents",
+			ErrCount: 0,
+		},
+		{
+			Value:    "PT30M",
+			TestName: "Valid_TimeOnly",
+			ErrCount: 0,
+		},
+		{
+			Value:    "P",
+			TestName: "Invalid_Empty",
+			ErrCount: 1,
+		},
+		{
+			Value:    "P1DT",
+			TestName: "Invalid_TrailingTimeDesignator",
+			ErrCount: 1,
+		},
+		{
+			Value:    "90 days",
+			TestName: "Invalid_Text",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			_, errs := ISO8601Duration(tc.Value, "test")
+
+			if len(errs) != tc.ErrCount {
+				t.Fatalf("Expected ISO8601Duration to have %d not %d errors for %q", tc.ErrCount, len(errs), tc.TestName)
+			}
+		})
+	}
+}
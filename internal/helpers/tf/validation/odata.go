@@ -0,0 +1,418 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package validation
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// ODataFilter returns a SchemaValidateFunc which tests that the provided value is a syntactically valid OData $filter
+// expression, referencing only the specified properties. Directory extension properties (`extension_<appId>_<name>`)
+// are always permitted.
+func ODataFilter(properties []string) func(interface{}, string) ([]string, []error) {
+	return func(i interface{}, k string) (warnings []string, errors []error) {
+		v, ok := i.(string)
+		if !ok {
+			return nil, []error{fmt.Errorf("expected a string value for %q", k)}
+		}
+
+		if err := ParseODataFilter(v, properties); err != nil {
+			return nil, []error{fmt.Errorf("invalid OData filter for %q: %+v", k, err)}
+		}
+
+		return
+	}
+}
+
+// ODataSearch returns a SchemaValidateFunc which tests that the provided value is a valid OData $search expression,
+// consisting of one or more double-quoted `"property:value"` clauses joined with AND or OR, and referencing only the
+// specified properties.
+func ODataSearch(properties []string) func(interface{}, string) ([]string, []error) {
+	clause := regexp.MustCompile(`^"([A-Za-z0-9_]+):([^"]*)"$`)
+	operator := regexp.MustCompile(`\s+(AND|OR)\s+`)
+
+	return func(i interface{}, k string) (warnings []string, errors []error) {
+		v, ok := i.(string)
+		if !ok {
+			return nil, []error{fmt.Errorf("expected a string value for %q", k)}
+		}
+
+		if strings.TrimSpace(v) == "" {
+			return nil, []error{fmt.Errorf("value must not be empty for %q", k)}
+		}
+
+		for _, c := range operator.Split(strings.TrimSpace(v), -1) {
+			m := clause.FindStringSubmatch(c)
+			if m == nil {
+				errors = append(errors, fmt.Errorf("invalid search clause %s for %q, expected a double-quoted clause in the form \"property:value\"", c, k))
+				continue
+			}
+			if !odataPropertySupported(m[1], properties) {
+				errors = append(errors, fmt.Errorf("unsupported property %q in search for %q, expected one of: %s", m[1], k, strings.Join(properties, ", ")))
+			}
+		}
+
+		return
+	}
+}
+
+// ParseODataFilter checks that filter is a syntactically valid OData $filter expression, and that any property
+// referenced in the expression is one of the specified properties.
+func ParseODataFilter(filter string, properties []string) error {
+	tokens, err := tokenizeODataFilter(filter)
+	if err != nil {
+		return err
+	}
+	if len(tokens) == 0 {
+		return fmt.Errorf("filter must not be empty")
+	}
+
+	p := &odataFilterParser{
+		tokens:     tokens,
+		properties: properties,
+	}
+	if err = p.parseExpression(); err != nil {
+		return err
+	}
+	if p.pos < len(p.tokens) {
+		return fmt.Errorf("unexpected %q at position %d", p.tokens[p.pos].value, p.tokens[p.pos].offset)
+	}
+
+	return nil
+}
+
+type odataTokenKind int
+
+const (
+	odataTokenWord odataTokenKind = iota
+	odataTokenString
+	odataTokenPunctuation
+)
+
+type odataToken struct {
+	kind   odataTokenKind
+	value  string
+	offset int
+}
+
+func tokenizeODataFilter(filter string) ([]odataToken, error) {
+	tokens := make([]odataToken, 0)
+	runes := []rune(filter)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+
+		case strings.ContainsRune("(),:/", r):
+			tokens = append(tokens, odataToken{kind: odataTokenPunctuation, value: string(r), offset: i})
+			i++
+
+		case r == '\'':
+			// String literals are single-quoted, with embedded quotes escaped by doubling them
+			start := i
+			i++
+			for {
+				if i >= len(runes) {
+					return nil, fmt.Errorf("unterminated string literal at position %d", start)
+				}
+				if runes[i] == '\'' {
+					if i+1 < len(runes) && runes[i+1] == '\'' {
+						i += 2
+						continue
+					}
+					break
+				}
+				i++
+			}
+			tokens = append(tokens, odataToken{kind: odataTokenString, value: string(runes[start : i+1]), offset: start})
+			i++
+
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || strings.ContainsRune("_$-.", r):
+			// Words starting with a digit may be date/time literals, which contain colons and offsets
+			start := i
+			numeric := unicode.IsDigit(r) || r == '-'
+			for i < len(runes) {
+				c := runes[i]
+				if unicode.IsLetter(c) || unicode.IsDigit(c) || strings.ContainsRune("_$-.", c) || (numeric && strings.ContainsRune(":+", c)) {
+					i++
+					continue
+				}
+				break
+			}
+			tokens = append(tokens, odataToken{kind: odataTokenWord, value: string(runes[start:i]), offset: start})
+
+		default:
+			return nil, fmt.Errorf("unexpected character %q at position %d", r, i)
+		}
+	}
+
+	return tokens, nil
+}
+
+var (
+	odataComparisonOperators = []string{"eq", "ne", "gt", "ge", "lt", "le"}
+	odataStringFunctions     = []string{"startswith", "endswith", "contains"}
+	odataLambdaOperators     = []string{"any", "all"}
+	odataIdentifier          = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	odataLiteral             = regexp.MustCompile(`^(true|false|null|-?[0-9]+(\.[0-9]+)?|[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}|[0-9]{4}-[0-9]{2}-[0-9]{2}(T[0-9]{2}:[0-9]{2}(:[0-9]{2}(\.[0-9]+)?)?(Z|[+-][0-9]{2}:[0-9]{2})?)?)$`)
+)
+
+type odataFilterParser struct {
+	tokens     []odataToken
+	pos        int
+	properties []string
+
+	// lambdaVariable is the range variable of the lambda expression currently being parsed, if any
+	lambdaVariable string
+}
+
+func (p *odataFilterParser) peek() *odataToken {
+	if p.pos < len(p.tokens) {
+		return &p.tokens[p.pos]
+	}
+	return nil
+}
+
+func (p *odataFilterParser) peekKeyword(keywords ...string) bool {
+	t := p.peek()
+	if t == nil || t.kind != odataTokenWord {
+		return false
+	}
+	for _, k := range keywords {
+		if strings.EqualFold(t.value, k) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *odataFilterParser) expectPunctuation(value string) error {
+	t := p.peek()
+	if t == nil {
+		return fmt.Errorf("expected %q but reached end of filter", value)
+	}
+	if t.kind != odataTokenPunctuation || t.value != value {
+		return fmt.Errorf("expected %q but found %q at position %d", value, t.value, t.offset)
+	}
+	p.pos++
+	return nil
+}
+
+// parseExpression parses a sequence of boolean terms joined with `and` or `or`
+func (p *odataFilterParser) parseExpression() error {
+	if err := p.parseUnary(); err != nil {
+		return err
+	}
+	for p.peekKeyword("and", "or") {
+		p.pos++
+		if err := p.parseUnary(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *odataFilterParser) parseUnary() error {
+	if p.peekKeyword("not") {
+		p.pos++
+		return p.parseUnary()
+	}
+
+	t := p.peek()
+	if t == nil {
+		return fmt.Errorf("unexpected end of filter")
+	}
+
+	if t.kind == odataTokenPunctuation && t.value == "(" {
+		p.pos++
+		if err := p.parseExpression(); err != nil {
+			return err
+		}
+		return p.expectPunctuation(")")
+	}
+
+	if t.kind == odataTokenWord && p.pos+1 < len(p.tokens) && p.tokens[p.pos+1].value == "(" {
+		for _, f := range odataStringFunctions {
+			if strings.EqualFold(t.value, f) {
+				return p.parseFunction()
+			}
+		}
+		return fmt.Errorf("unsupported function %q at position %d", t.value, t.offset)
+	}
+
+	return p.parseComparison()
+}
+
+// parseFunction parses a string function call, e.g. `startsWith(displayName, 'foo')`
+func (p *odataFilterParser) parseFunction() error {
+	p.pos++
+	if err := p.expectPunctuation("("); err != nil {
+		return err
+	}
+	if _, err := p.parseOperand(); err != nil {
+		return err
+	}
+	if err := p.expectPunctuation(","); err != nil {
+		return err
+	}
+	if err := p.parseLiteral(); err != nil {
+		return err
+	}
+	return p.expectPunctuation(")")
+}
+
+// parseComparison parses a comparison, an `in` expression, or a lambda expression such as `tags/any(t:t eq 'foo')`
+func (p *odataFilterParser) parseComparison() error {
+	lambda, err := p.parseOperand()
+	if err != nil {
+		return err
+	}
+	if lambda {
+		return nil
+	}
+
+	t := p.peek()
+	if t == nil {
+		return fmt.Errorf("expected an operator but reached end of filter")
+	}
+
+	if p.peekKeyword("in") {
+		p.pos++
+		if err = p.expectPunctuation("("); err != nil {
+			return err
+		}
+		for {
+			if err = p.parseLiteral(); err != nil {
+				return err
+			}
+			if next := p.peek(); next != nil && next.value == "," {
+				p.pos++
+				continue
+			}
+			break
+		}
+		return p.expectPunctuation(")")
+	}
+
+	if !p.peekKeyword(odataComparisonOperators...) {
+		return fmt.Errorf("expected a comparison operator but found %q at position %d", t.value, t.offset)
+	}
+	p.pos++
+
+	return p.parseLiteral()
+}
+
+// parseOperand parses a property path, returning true when the path was terminated by a lambda expression
+func (p *odataFilterParser) parseOperand() (bool, error) {
+	t := p.peek()
+	if t == nil {
+		return false, fmt.Errorf("expected a property but reached end of filter")
+	}
+	if t.kind != odataTokenWord || !odataIdentifier.MatchString(t.value) {
+		return false, fmt.Errorf("expected a property but found %q at position %d", t.value, t.offset)
+	}
+
+	if p.lambdaVariable == "" || t.value != p.lambdaVariable {
+		if !odataPropertySupported(t.value, p.properties) {
+			return false, fmt.Errorf("unsupported property %q at position %d, expected one of: %s", t.value, t.offset, strings.Join(p.properties, ", "))
+		}
+	}
+	p.pos++
+
+	for {
+		next := p.peek()
+		if next == nil || next.kind != odataTokenPunctuation || next.value != "/" {
+			return false, nil
+		}
+		p.pos++
+
+		segment := p.peek()
+		if segment == nil || segment.kind != odataTokenWord {
+			return false, fmt.Errorf("expected a property segment after \"/\"")
+		}
+		p.pos++
+
+		switch {
+		case segment.value == "$count":
+			return false, nil
+
+		case p.peekPunctuation("(") && p.isLambdaOperator(segment.value):
+			return true, p.parseLambda()
+
+		case !odataIdentifier.MatchString(segment.value):
+			return false, fmt.Errorf("invalid property segment %q at position %d", segment.value, segment.offset)
+		}
+	}
+}
+
+func (p *odataFilterParser) peekPunctuation(value string) bool {
+	t := p.peek()
+	return t != nil && t.kind == odataTokenPunctuation && t.value == value
+}
+
+func (p *odataFilterParser) isLambdaOperator(value string) bool {
+	for _, op := range odataLambdaOperators {
+		if strings.EqualFold(value, op) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseLambda parses the body of a lambda expression, e.g. `(t:t eq 'foo')`
+func (p *odataFilterParser) parseLambda() error {
+	if p.lambdaVariable != "" {
+		return fmt.Errorf("nested lambda expressions are not supported")
+	}
+	if err := p.expectPunctuation("("); err != nil {
+		return err
+	}
+
+	v := p.peek()
+	if v == nil || v.kind != odataTokenWord || !odataIdentifier.MatchString(v.value) {
+		return fmt.Errorf("expected a lambda range variable")
+	}
+	p.pos++
+	if err := p.expectPunctuation(":"); err != nil {
+		return err
+	}
+
+	p.lambdaVariable = v.value
+	defer func() { p.lambdaVariable = "" }()
+
+	if err := p.parseExpression(); err != nil {
+		return err
+	}
+
+	return p.expectPunctuation(")")
+}
+
+func (p *odataFilterParser) parseLiteral() error {
+	t := p.peek()
+	if t == nil {
+		return fmt.Errorf("expected a value but reached end of filter")
+	}
+	if t.kind == odataTokenString || (t.kind == odataTokenWord && odataLiteral.MatchString(t.value)) {
+		p.pos++
+		return nil
+	}
+	return fmt.Errorf("expected a value but found %q at position %d", t.value, t.offset)
+}
+
+func odataPropertySupported(property string, properties []string) bool {
+	if strings.HasPrefix(strings.ToLower(property), "extension_") {
+		return true
+	}
+	for _, p := range properties {
+		if strings.EqualFold(property, p) {
+			return true
+		}
+	}
+	return false
+}
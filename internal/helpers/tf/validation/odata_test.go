@@ -0,0 +1,160 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package validation
+
+import (
+	"testing"
+)
+
+func TestODataFilter(t *testing.T) {
+	properties := []string{"accountEnabled", "assignedLicenses", "createdDateTime", "department", "displayName", "onPremisesSyncEnabled", "tags"}
+
+	cases := []struct {
+		Value    string
+		TestName string
+		ErrCount int
+	}{
+		{
+			Value:    "department eq 'Finance'",
+			TestName: "StringEquality",
+			ErrCount: 0,
+		},
+		{
+			Value:    "onPremisesSyncEnabled eq true",
+			TestName: "BooleanEquality",
+			ErrCount: 0,
+		},
+		{
+			Value:    "accountEnabled eq false and (department eq 'O''Brien' or department ne null)",
+			TestName: "GroupedLogical",
+			ErrCount: 0,
+		},
+		{
+			Value:    "startsWith(displayName, 'acctest') and not(department in ('Sales', 'Marketing'))",
+			TestName: "FunctionAndIn",
+			ErrCount: 0,
+		},
+		{
+			Value:    "tags/any(t:t eq 'WindowsAzureActiveDirectoryIntegratedApp')",
+			TestName: "Lambda",
+			ErrCount: 0,
+		},
+		{
+			Value:    "assignedLicenses/any(x:x/skuId eq 6fd2c87f-b296-42f0-b197-1e91e994b900)",
+			TestName: "LambdaWithGuid",
+			ErrCount: 0,
+		},
+		{
+			Value:    "assignedLicenses/$count eq 0",
+			TestName: "Count",
+			ErrCount: 0,
+		},
+		{
+			Value:    "createdDateTime ge 2024-01-01T00:00:00Z",
+			TestName: "DateTime",
+			ErrCount: 0,
+		},
+		{
+			Value:    "extension_b7d8e648520f41d3b9c0fdeb91768a0a_jobGroup eq 'Marketing'",
+			TestName: "DirectoryExtension",
+			ErrCount: 0,
+		},
+		{
+			Value:    "",
+			TestName: "Empty",
+			ErrCount: 1,
+		},
+		{
+			Value:    "jobTitle eq 'Manager'",
+			TestName: "UnsupportedProperty",
+			ErrCount: 1,
+		},
+		{
+			Value:    "department eq 'Finance",
+			TestName: "UnterminatedString",
+			ErrCount: 1,
+		},
+		{
+			Value:    "department equals 'Finance'",
+			TestName: "UnknownOperator",
+			ErrCount: 1,
+		},
+		{
+			Value:    "department eq Finance",
+			TestName: "UnquotedString",
+			ErrCount: 1,
+		},
+		{
+			Value:    "(department eq 'Finance'",
+			TestName: "UnbalancedParentheses",
+			ErrCount: 1,
+		},
+		{
+			Value:    "department eq 'Finance' and",
+			TestName: "TrailingOperator",
+			ErrCount: 1,
+		},
+		{
+			Value:    "substringof('Fin', department)",
+			TestName: "UnsupportedFunction",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			_, errors := ODataFilter(properties)(tc.Value, "filter")
+
+			if len(errors) != tc.ErrCount {
+				t.Fatalf("Expected ODataFilter to have %d not %d errors for %q: %v", tc.ErrCount, len(errors), tc.Value, errors)
+			}
+		})
+	}
+}
+
+func TestODataSearch(t *testing.T) {
+	properties := []string{"description", "displayName"}
+
+	cases := []struct {
+		Value    string
+		TestName string
+		ErrCount int
+	}{
+		{
+			Value:    `"displayName:acctest"`,
+			TestName: "Single",
+			ErrCount: 0,
+		},
+		{
+			Value:    `"displayName:acctest" OR "description:finance team"`,
+			TestName: "Multiple",
+			ErrCount: 0,
+		},
+		{
+			Value:    `displayName:acctest`,
+			TestName: "Unquoted",
+			ErrCount: 1,
+		},
+		{
+			Value:    `"mail:acctest"`,
+			TestName: "UnsupportedProperty",
+			ErrCount: 1,
+		},
+		{
+			Value:    `"acctest"`,
+			TestName: "MissingProperty",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			_, errors := ODataSearch(properties)(tc.Value, "search")
+
+			if len(errors) != tc.ErrCount {
+				t.Fatalf("Expected ODataSearch to have %d not %d errors for %q: %v", tc.ErrCount, len(errors), tc.Value, errors)
+			}
+		})
+	}
+}
@@ -8,12 +8,14 @@ import (
 	"github.com/hashicorp/terraform-provider-azuread/internal/services/administrativeunits"
 	"github.com/hashicorp/terraform-provider-azuread/internal/services/applications"
 	"github.com/hashicorp/terraform-provider-azuread/internal/services/approleassignments"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/authenticationevents"
 	"github.com/hashicorp/terraform-provider-azuread/internal/services/conditionalaccess"
 	"github.com/hashicorp/terraform-provider-azuread/internal/services/directoryobjects"
 	"github.com/hashicorp/terraform-provider-azuread/internal/services/directoryroles"
 	"github.com/hashicorp/terraform-provider-azuread/internal/services/domains"
 	"github.com/hashicorp/terraform-provider-azuread/internal/services/groups"
 	"github.com/hashicorp/terraform-provider-azuread/internal/services/identitygovernance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/identityproviders"
 	"github.com/hashicorp/terraform-provider-azuread/internal/services/invitations"
 	"github.com/hashicorp/terraform-provider-azuread/internal/services/policies"
 	"github.com/hashicorp/terraform-provider-azuread/internal/services/serviceprincipals"
@@ -27,11 +29,14 @@ import (
 func SupportedTypedServices() []sdk.TypedServiceRegistration {
 	return []sdk.TypedServiceRegistration{
 		applications.Registration{},
+		approleassignments.Registration{},
 		directoryroles.Registration{},
 		domains.Registration{},
+		groups.Registration{},
 		policies.Registration{},
 		identitygovernance.Registration{},
 		serviceprincipals.Registration{},
+		users.Registration{},
 	}
 }
 
@@ -40,12 +45,14 @@ func SupportedUntypedServices() []sdk.UntypedServiceRegistration {
 		administrativeunits.Registration{},
 		applications.Registration{},
 		approleassignments.Registration{},
+		authenticationevents.Registration{},
 		conditionalaccess.Registration{},
 		directoryobjects.Registration{},
 		directoryroles.Registration{},
 		domains.Registration{},
 		groups.Registration{},
 		identitygovernance.Registration{},
+		identityproviders.Registration{},
 		invitations.Registration{},
 		policies.Registration{},
 		serviceprincipals.Registration{},
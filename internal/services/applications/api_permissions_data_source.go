@@ -0,0 +1,392 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package applications
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/serviceprincipal"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/applications"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/lookup"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/hashicorp/terraform-provider-azuread/internal/sdk"
+)
+
+// apiPermissionsSuggestionLimit is the maximum number of similar names to suggest when a name cannot be found
+const apiPermissionsSuggestionLimit = 5
+
+type ApiPermissionsId string
+
+func (id ApiPermissionsId) ID() string {
+	return string(id)
+}
+
+func (ApiPermissionsId) String() string {
+	return "API Permissions"
+}
+
+type ApiPermissionsDataSourceModel struct {
+	AppRoleIds               map[string]string                    `tfschema:"app_role_ids"`
+	AppRoles                 []ApiPermissionsAppRoleModel         `tfschema:"app_roles"`
+	ClientId                 string                               `tfschema:"client_id"`
+	DisplayName              string                               `tfschema:"display_name"`
+	OAuth2PermissionScopeIds map[string]string                    `tfschema:"oauth2_permission_scope_ids"`
+	OAuth2PermissionScopes   []ApiPermissionsPermissionScopeModel `tfschema:"oauth2_permission_scopes"`
+	ObjectId                 string                               `tfschema:"object_id"`
+	PublishedAppName         string                               `tfschema:"published_app_name"`
+	RequiredAppRoles         []string                             `tfschema:"required_app_roles"`
+	RequiredScopes           []string                             `tfschema:"required_scopes"`
+}
+
+type ApiPermissionsAppRoleModel struct {
+	AllowedMemberTypes []string `tfschema:"allowed_member_types"`
+	Description        string   `tfschema:"description"`
+	DisplayName        string   `tfschema:"display_name"`
+	Enabled            bool     `tfschema:"enabled"`
+	Id                 string   `tfschema:"id"`
+	Value              string   `tfschema:"value"`
+}
+
+type ApiPermissionsPermissionScopeModel struct {
+	AdminConsentDescription string `tfschema:"admin_consent_description"`
+	AdminConsentDisplayName string `tfschema:"admin_consent_display_name"`
+	Enabled                 bool   `tfschema:"enabled"`
+	Id                      string `tfschema:"id"`
+	Type                    string `tfschema:"type"`
+	UserConsentDescription  string `tfschema:"user_consent_description"`
+	UserConsentDisplayName  string `tfschema:"user_consent_display_name"`
+	Value                   string `tfschema:"value"`
+}
+
+type ApiPermissionsDataSource struct{}
+
+var _ sdk.DataSource = ApiPermissionsDataSource{}
+
+func (r ApiPermissionsDataSource) ResourceType() string {
+	return "azuread_api_permissions"
+}
+
+func (r ApiPermissionsDataSource) ModelObject() interface{} {
+	return &ApiPermissionsDataSourceModel{}
+}
+
+func (r ApiPermissionsDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"client_id": {
+			Description:  "The client ID of the application publishing the API",
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ExactlyOneOf: []string{"client_id", "published_app_name"},
+			ValidateFunc: validation.IsUUID,
+		},
+
+		"published_app_name": {
+			Description:  "The name of a well-known API published by Microsoft, as listed by the `azuread_application_published_app_ids` data source",
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ExactlyOneOf: []string{"client_id", "published_app_name"},
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"required_app_roles": {
+			Description: "A list of app role values which must be published by the API",
+			Type:        pluginsdk.TypeList,
+			Optional:    true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"required_scopes": {
+			Description: "A list of delegated permission scope values which must be published by the API",
+			Type:        pluginsdk.TypeList,
+			Optional:    true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func (r ApiPermissionsDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"app_role_ids": {
+			Description: "Mapping of app role values to UUIDs",
+			Type:        pluginsdk.TypeMap,
+			Computed:    true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"app_roles": {
+			Description: "The app roles published by the API, which can be requested as application permissions",
+			Type:        pluginsdk.TypeList,
+			Computed:    true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"allowed_member_types": {
+						Description: "Specifies whether this app role can be assigned to users and groups, or to other applications. Possible values are `User` or `Application`, or both",
+						Type:        pluginsdk.TypeList,
+						Computed:    true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+
+					"description": {
+						Description: "Description of the app role that appears when the role is being assigned and during the consent experiences",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"display_name": {
+						Description: "Display name for the app role that appears during app role assignment and in consent experiences",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"enabled": {
+						Description: "Determines if the app role is enabled",
+						Type:        pluginsdk.TypeBool,
+						Computed:    true,
+					},
+
+					"id": {
+						Description: "The unique identifier of the app role",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"value": {
+						Description: "The value that is used for the `roles` claim in access tokens",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+				},
+			},
+		},
+
+		"display_name": {
+			Description: "The display name of the service principal for the API",
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+		},
+
+		"oauth2_permission_scope_ids": {
+			Description: "Mapping of delegated permission scope values to UUIDs",
+			Type:        pluginsdk.TypeMap,
+			Computed:    true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"oauth2_permission_scopes": {
+			Description: "The delegated permission scopes published by the API",
+			Type:        pluginsdk.TypeList,
+			Computed:    true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"admin_consent_description": {
+						Description: "Delegated permission description that appears in all tenant-wide admin consent experiences",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"admin_consent_display_name": {
+						Description: "Display name for the delegated permission, intended to be read by an administrator granting the permission on behalf of all users",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"enabled": {
+						Description: "Determines if the permission scope is enabled",
+						Type:        pluginsdk.TypeBool,
+						Computed:    true,
+					},
+
+					"id": {
+						Description: "The unique identifier of the delegated permission",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"type": {
+						Description: "Whether this delegated permission can be consented to by users on their own behalf, or requires an administrator. Possible values are `User` or `Admin`",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"user_consent_description": {
+						Description: "Delegated permission description that appears in the end user consent experience",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"user_consent_display_name": {
+						Description: "Display name for the delegated permission that appears in the end user consent experience",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"value": {
+						Description: "The value that is used for the `scp` claim in access tokens",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+				},
+			},
+		},
+
+		"object_id": {
+			Description: "The object ID of the service principal for the API",
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+		},
+	}
+}
+
+func (r ApiPermissionsDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Applications.ServicePrincipalClient
+			tenantId := metadata.Client.TenantID
+
+			var state ApiPermissionsDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			clientId := state.ClientId
+			if state.PublishedAppName != "" {
+				var ok bool
+				if clientId, ok = environments.PublishedApis[state.PublishedAppName]; !ok {
+					names := make([]string, 0, len(environments.PublishedApis))
+					for name := range environments.PublishedApis {
+						names = append(names, name)
+					}
+					return fmt.Errorf("unknown published app name %q%s", state.PublishedAppName, apiPermissionsDidYouMean(state.PublishedAppName, names))
+				}
+			}
+
+			options := serviceprincipal.ListServicePrincipalsOperationOptions{
+				Filter: pointer.To(fmt.Sprintf("appId eq '%s'", odata.EscapeSingleQuote(clientId))),
+				Select: pointer.To([]string{"appId", "appRoles", "displayName", "id", "oauth2PermissionScopes"}),
+			}
+
+			resp, err := client.ListServicePrincipals(ctx, options)
+			if err != nil {
+				return fmt.Errorf("listing service principals for API %q: %+v", clientId, err)
+			}
+
+			var servicePrincipal *stable.ServicePrincipal
+			for _, sp := range pointer.From(resp.Model) {
+				if strings.EqualFold(sp.AppId.GetOrZero(), clientId) {
+					servicePrincipal = &sp
+					break
+				}
+			}
+			if servicePrincipal == nil {
+				return fmt.Errorf("no service principal was found for API %q, a service principal must exist in the tenant for the API", clientId)
+			}
+
+			state.ClientId = servicePrincipal.AppId.GetOrZero()
+			state.DisplayName = servicePrincipal.DisplayName.GetOrZero()
+			state.ObjectId = pointer.From(servicePrincipal.Id)
+			state.AppRoleIds = applications.FlattenAppRoleIDs(servicePrincipal.AppRoles)
+			state.OAuth2PermissionScopeIds = applications.FlattenOAuth2PermissionScopeIDs(servicePrincipal.OAuth2PermissionScopes)
+
+			state.AppRoles = make([]ApiPermissionsAppRoleModel, 0)
+			for _, role := range pointer.From(servicePrincipal.AppRoles) {
+				state.AppRoles = append(state.AppRoles, ApiPermissionsAppRoleModel{
+					AllowedMemberTypes: pointer.From(role.AllowedMemberTypes),
+					Description:        role.Description.GetOrZero(),
+					DisplayName:        role.DisplayName.GetOrZero(),
+					Enabled:            pointer.From(role.IsEnabled),
+					Id:                 pointer.From(role.Id),
+					Value:              role.Value.GetOrZero(),
+				})
+			}
+			sort.Slice(state.AppRoles, func(i, j int) bool {
+				return state.AppRoles[i].Value < state.AppRoles[j].Value
+			})
+
+			state.OAuth2PermissionScopes = make([]ApiPermissionsPermissionScopeModel, 0)
+			for _, scope := range pointer.From(servicePrincipal.OAuth2PermissionScopes) {
+				state.OAuth2PermissionScopes = append(state.OAuth2PermissionScopes, ApiPermissionsPermissionScopeModel{
+					AdminConsentDescription: scope.AdminConsentDescription.GetOrZero(),
+					AdminConsentDisplayName: scope.AdminConsentDisplayName.GetOrZero(),
+					Enabled:                 pointer.From(scope.IsEnabled),
+					Id:                      pointer.From(scope.Id),
+					Type:                    scope.Type.GetOrZero(),
+					UserConsentDescription:  scope.UserConsentDescription.GetOrZero(),
+					UserConsentDisplayName:  scope.UserConsentDisplayName.GetOrZero(),
+					Value:                   scope.Value.GetOrZero(),
+				})
+			}
+			sort.Slice(state.OAuth2PermissionScopes, func(i, j int) bool {
+				return state.OAuth2PermissionScopes[i].Value < state.OAuth2PermissionScopes[j].Value
+			})
+
+			// Validate that all required permissions are published, so that misspelled names fail the plan
+			missing := make([]string, 0)
+			missing = append(missing, apiPermissionsMissing("app role", state.RequiredAppRoles, state.AppRoleIds)...)
+			missing = append(missing, apiPermissionsMissing("delegated permission scope", state.RequiredScopes, state.OAuth2PermissionScopeIds)...)
+			if len(missing) > 0 {
+				return fmt.Errorf("the following permissions were not found for API %q (%s):\n\n%s", state.DisplayName, state.ClientId, strings.Join(missing, "\n"))
+			}
+
+			metadata.SetID(ApiPermissionsId(fmt.Sprintf("apiPermissions#%s#%s", tenantId, state.ClientId)))
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+// apiPermissionsMissing returns a description for each of the required values that is not a key of published,
+// including any similar published values
+func apiPermissionsMissing(kind string, required []string, published map[string]string) []string {
+	values := make([]string, 0, len(published))
+	for value := range published {
+		values = append(values, value)
+	}
+
+	result := make([]string, 0)
+	for _, value := range required {
+		if _, ok := published[value]; ok {
+			continue
+		}
+		result = append(result, fmt.Sprintf("  - %s %q%s", kind, value, apiPermissionsDidYouMean(value, values)))
+	}
+
+	return result
+}
+
+func apiPermissionsDidYouMean(value string, candidates []string) string {
+	suggestions := lookup.Suggest(value, candidates, apiPermissionsSuggestionLimit)
+	if len(suggestions) == 0 {
+		return ""
+	}
+
+	quoted := make([]string, 0, len(suggestions))
+	for _, s := range suggestions {
+		quoted = append(quoted, fmt.Sprintf("%q", s))
+	}
+
+	return fmt.Sprintf(", did you mean %s?", strings.Join(quoted, " or "))
+}
@@ -0,0 +1,88 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package applications_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type ApiPermissionsDataSource struct{}
+
+func TestAccApiPermissionsDataSource_byPublishedAppName(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_api_permissions", "test")
+	r := ApiPermissionsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.byPublishedAppName(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("client_id").HasValue("00000003-0000-0000-c000-000000000000"),
+				check.That(data.ResourceName).Key("object_id").IsUuid(),
+				check.That(data.ResourceName).Key("app_role_ids.User.Read.All").HasValue("df021288-bdef-4463-88db-98f22de89214"),
+				check.That(data.ResourceName).Key("oauth2_permission_scope_ids.User.Read").HasValue("e1fe6dd8-ba31-4d61-89e7-88639da4683d"),
+				check.That(data.ResourceName).Key("app_roles.#").Exists(),
+				check.That(data.ResourceName).Key("oauth2_permission_scopes.#").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccApiPermissionsDataSource_byClientId(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_api_permissions", "test")
+	r := ApiPermissionsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.byClientId(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("client_id").HasValue("00000003-0000-0000-c000-000000000000"),
+				check.That(data.ResourceName).Key("display_name").Exists(),
+				check.That(data.ResourceName).Key("app_role_ids.Group.Read.All").HasValue("5b567255-7703-4780-807c-7be8301ae99b"),
+			),
+		},
+	})
+}
+
+func TestAccApiPermissionsDataSource_missingPermission(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_api_permissions", "test")
+	r := ApiPermissionsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config:      r.missingPermission(),
+			ExpectError: regexp.MustCompile(`did you mean "User.Read.All"`),
+		},
+	})
+}
+
+func (ApiPermissionsDataSource) byPublishedAppName() string {
+	return `
+data "azuread_api_permissions" "test" {
+  published_app_name = "MicrosoftGraph"
+  required_app_roles = ["User.Read.All"]
+  required_scopes    = ["User.Read"]
+}
+`
+}
+
+func (ApiPermissionsDataSource) byClientId() string {
+	return `
+data "azuread_api_permissions" "test" {
+  client_id = "00000003-0000-0000-c000-000000000000"
+}
+`
+}
+
+func (ApiPermissionsDataSource) missingPermission() string {
+	return `
+data "azuread_api_permissions" "test" {
+  published_app_name = "MicrosoftGraph"
+  required_app_roles = ["User.Reed.All"]
+}
+`
+}
@@ -0,0 +1,828 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package applications
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/applications/stable/application"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/oauth2permissiongrants/stable/oauth2permissiongrant"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/approleassignment"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/serviceprincipal"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/consistency"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/applications/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/applications/parse"
+)
+
+type ApplicationAdminConsentModel struct {
+	ApplicationId               string                                            `tfschema:"application_id"`
+	AppRoleAssignments          []ApplicationAdminConsentAppRoleAssignmentModel   `tfschema:"app_role_assignment"`
+	AppRoleAssignmentIds        []string                                          `tfschema:"app_role_assignment_ids"`
+	DelegatedPermissionGrants   []ApplicationAdminConsentDelegatedPermissionModel `tfschema:"delegated_permission_grant"`
+	DelegatedPermissionGrantIds []string                                          `tfschema:"delegated_permission_grant_ids"`
+	ServicePrincipalObjectId    string                                            `tfschema:"service_principal_object_id"`
+	Triggers                    map[string]string                                 `tfschema:"triggers"`
+}
+
+type ApplicationAdminConsentAppRoleAssignmentModel struct {
+	AppRoleId        string `tfschema:"app_role_id"`
+	ResourceObjectId string `tfschema:"resource_object_id"`
+}
+
+func (m ApplicationAdminConsentAppRoleAssignmentModel) key() string {
+	return strings.ToLower(fmt.Sprintf("%s/%s", m.ResourceObjectId, m.AppRoleId))
+}
+
+type ApplicationAdminConsentDelegatedPermissionModel struct {
+	ClaimValues      []string `tfschema:"claim_values"`
+	ResourceObjectId string   `tfschema:"resource_object_id"`
+}
+
+var _ sdk.ResourceWithUpdate = ApplicationAdminConsentResource{}
+var _ sdk.ResourceWithCustomizeDiff = ApplicationAdminConsentResource{}
+
+type ApplicationAdminConsentResource struct{}
+
+func (r ApplicationAdminConsentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return parse.ValidateAdminConsentID
+}
+
+func (r ApplicationAdminConsentResource) ResourceType() string {
+	return "azuread_application_admin_consent"
+}
+
+func (r ApplicationAdminConsentResource) ModelObject() interface{} {
+	return &ApplicationAdminConsentModel{}
+}
+
+func (r ApplicationAdminConsentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"application_id": {
+			Description:  "The resource ID of the application for which to grant admin consent",
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: stable.ValidateApplicationID,
+		},
+
+		"triggers": {
+			Description: "Map of arbitrary keys and values that, when changed, will cause admin consent to be re-evaluated for the application",
+			Type:        pluginsdk.TypeMap,
+			Optional:    true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r ApplicationAdminConsentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"app_role_assignment": {
+			Description: "The app roles assigned to the service principal for the application, for each application permission in `required_resource_access`",
+			Type:        pluginsdk.TypeSet,
+			Computed:    true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"app_role_id": {
+						Description: "The ID of the assigned app role",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"resource_object_id": {
+						Description: "The object ID of the service principal representing the resource",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+				},
+			},
+		},
+
+		"app_role_assignment_ids": {
+			Description: "The IDs of the app role assignments created by this resource",
+			Type:        pluginsdk.TypeSet,
+			Computed:    true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"delegated_permission_grant": {
+			Description: "The tenant-wide delegated permission grants for the service principal for the application, for each delegated permission in `required_resource_access`",
+			Type:        pluginsdk.TypeSet,
+			Computed:    true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"claim_values": {
+						Description: "The claim values of the granted delegated permission scopes",
+						Type:        pluginsdk.TypeSet,
+						Computed:    true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+
+					"resource_object_id": {
+						Description: "The object ID of the service principal representing the resource",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+				},
+			},
+		},
+
+		"delegated_permission_grant_ids": {
+			Description: "The IDs of the delegated permission grants created or updated by this resource",
+			Type:        pluginsdk.TypeSet,
+			Computed:    true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"service_principal_object_id": {
+			Description: "The object ID of the service principal for the application",
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+		},
+	}
+}
+
+func (r ApplicationAdminConsentResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Applications
+
+			// For a new resource, the granted permissions are computed during creation. For an existing resource,
+			// the permissions are derived from the application as it currently exists, so that any permissions
+			// added to or removed from `required_resource_access` cause the consent to be updated.
+			if metadata.ResourceDiff.Id() == "" || metadata.ResourceDiff.HasChange("application_id") {
+				return nil
+			}
+
+			// When `triggers` are changing or not yet known, the application's permissions are likely being changed in
+			// the same apply, so the consent can only be determined once the application has been updated.
+			if !metadata.ResourceDiff.NewValueKnown("triggers") || metadata.ResourceDiff.HasChange("triggers") {
+				for _, key := range []string{"app_role_assignment", "app_role_assignment_ids", "delegated_permission_grant", "delegated_permission_grant_ids"} {
+					if err := metadata.ResourceDiff.SetNewComputed(key); err != nil {
+						return fmt.Errorf("setting `%s`: %+v", key, err)
+					}
+				}
+				return nil
+			}
+
+			applicationId, err := stable.ParseApplicationID(metadata.ResourceDiff.Get("application_id").(string))
+			if err != nil {
+				return err
+			}
+
+			expected, err := expectedAdminConsent(ctx, client, *applicationId)
+			if err != nil {
+				return err
+			}
+
+			if err = metadata.ResourceDiff.SetNew("app_role_assignment", flattenAdminConsentAppRoleAssignments(expected.AppRoleAssignments)); err != nil {
+				return fmt.Errorf("setting `app_role_assignment`: %+v", err)
+			}
+			if err = metadata.ResourceDiff.SetNew("delegated_permission_grant", flattenAdminConsentDelegatedPermissionGrants(expected.DelegatedPermissionGrants)); err != nil {
+				return fmt.Errorf("setting `delegated_permission_grant`: %+v", err)
+			}
+
+			// The IDs of the managed assignments and grants are only known once the consent has been updated
+			if metadata.ResourceDiff.HasChange("app_role_assignment") {
+				if err = metadata.ResourceDiff.SetNewComputed("app_role_assignment_ids"); err != nil {
+					return fmt.Errorf("setting `app_role_assignment_ids`: %+v", err)
+				}
+			}
+			if metadata.ResourceDiff.HasChange("delegated_permission_grant") {
+				if err = metadata.ResourceDiff.SetNewComputed("delegated_permission_grant_ids"); err != nil {
+					return fmt.Errorf("setting `delegated_permission_grant_ids`: %+v", err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApplicationAdminConsentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 10 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Applications
+
+			var model ApplicationAdminConsentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			applicationId, err := stable.ParseApplicationID(model.ApplicationId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewAdminConsentID(applicationId.ApplicationId)
+
+			expected, err := expectedAdminConsent(ctx, client, *applicationId)
+			if err != nil {
+				return err
+			}
+
+			if err = grantAdminConsent(ctx, client, expected, nil, nil); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			model.AppRoleAssignments = expected.AppRoleAssignments
+			model.AppRoleAssignmentIds = expected.AppRoleAssignmentIds
+			model.DelegatedPermissionGrants = expected.DelegatedPermissionGrants
+			model.DelegatedPermissionGrantIds = expected.DelegatedPermissionGrantIds
+			model.ServicePrincipalObjectId = expected.ServicePrincipalObjectId
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r ApplicationAdminConsentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Applications
+
+			id, err := parse.ParseAdminConsentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			applicationId := stable.NewApplicationID(id.ApplicationId)
+
+			resp, err := client.ApplicationClient.GetApplication(ctx, applicationId, application.DefaultGetApplicationOperationOptions())
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", applicationId, err)
+			}
+
+			app := resp.Model
+			if app == nil {
+				return fmt.Errorf("retrieving %s: model was nil", applicationId)
+			}
+
+			servicePrincipal, err := findServicePrincipalByAppId(ctx, client.ServicePrincipalClient, app.AppId.GetOrZero())
+			if err != nil {
+				return fmt.Errorf("retrieving service principal for %s: %+v", applicationId, err)
+			}
+			if servicePrincipal == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			servicePrincipalId := pointer.From(servicePrincipal.Id)
+
+			assignments, grants, err := listAdminConsent(ctx, client, servicePrincipalId)
+			if err != nil {
+				return err
+			}
+
+			var model ApplicationAdminConsentModel
+			if err = metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state := ApplicationAdminConsentModel{
+				ApplicationId:               applicationId.ID(),
+				AppRoleAssignments:          make([]ApplicationAdminConsentAppRoleAssignmentModel, 0),
+				AppRoleAssignmentIds:        make([]string, 0),
+				DelegatedPermissionGrants:   make([]ApplicationAdminConsentDelegatedPermissionModel, 0),
+				DelegatedPermissionGrantIds: make([]string, 0),
+				ServicePrincipalObjectId:    servicePrincipalId,
+				Triggers:                    model.Triggers,
+			}
+
+			// When importing, the assignments and grants derived from `required_resource_access` are assumed to be
+			// managed by this resource
+			if model.ServicePrincipalObjectId == "" {
+				expected, err := expectedAdminConsent(ctx, client, applicationId)
+				if err != nil {
+					return err
+				}
+
+				model.AppRoleAssignments = expected.AppRoleAssignments
+				model.DelegatedPermissionGrants = expected.DelegatedPermissionGrants
+
+				for _, assignment := range assignments {
+					for _, expectedAssignment := range expected.AppRoleAssignments {
+						if adminConsentAppRoleAssignmentKey(assignment) == expectedAssignment.key() {
+							model.AppRoleAssignmentIds = append(model.AppRoleAssignmentIds, pointer.From(assignment.Id))
+						}
+					}
+				}
+				for _, grant := range grants {
+					for _, expectedGrant := range expected.DelegatedPermissionGrants {
+						if strings.EqualFold(pointer.From(grant.ResourceId), expectedGrant.ResourceObjectId) {
+							model.DelegatedPermissionGrantIds = append(model.DelegatedPermissionGrantIds, pointer.From(grant.Id))
+						}
+					}
+				}
+			}
+
+			// Only the assignments and grants created by this resource, or those already recorded in state, are
+			// reported. Any others are managed elsewhere, for example with the `azuread_app_role_assignment` resource.
+			managedAssignmentIds := make(map[string]bool)
+			for _, assignmentId := range model.AppRoleAssignmentIds {
+				managedAssignmentIds[strings.ToLower(assignmentId)] = true
+			}
+			knownAssignments := make(map[string]bool)
+			for _, assignment := range model.AppRoleAssignments {
+				knownAssignments[assignment.key()] = true
+			}
+
+			for _, assignment := range assignments {
+				managed := managedAssignmentIds[strings.ToLower(pointer.From(assignment.Id))]
+				if !managed && !knownAssignments[adminConsentAppRoleAssignmentKey(assignment)] {
+					continue
+				}
+				if managed {
+					state.AppRoleAssignmentIds = append(state.AppRoleAssignmentIds, pointer.From(assignment.Id))
+				}
+				state.AppRoleAssignments = append(state.AppRoleAssignments, ApplicationAdminConsentAppRoleAssignmentModel{
+					AppRoleId:        pointer.From(assignment.AppRoleId),
+					ResourceObjectId: assignment.ResourceId.GetOrZero(),
+				})
+			}
+
+			managedGrantIds := make(map[string]bool)
+			for _, grantId := range model.DelegatedPermissionGrantIds {
+				managedGrantIds[grantId] = true
+			}
+			knownGrants := make(map[string]bool)
+			for _, grant := range model.DelegatedPermissionGrants {
+				knownGrants[strings.ToLower(grant.ResourceObjectId)] = true
+			}
+
+			for _, grant := range grants {
+				managed := managedGrantIds[pointer.From(grant.Id)]
+				if !managed && !knownGrants[strings.ToLower(pointer.From(grant.ResourceId))] {
+					continue
+				}
+				if managed {
+					state.DelegatedPermissionGrantIds = append(state.DelegatedPermissionGrantIds, pointer.From(grant.Id))
+				}
+				state.DelegatedPermissionGrants = append(state.DelegatedPermissionGrants, ApplicationAdminConsentDelegatedPermissionModel{
+					ClaimValues:      strings.Fields(grant.Scope.GetOrZero()),
+					ResourceObjectId: pointer.From(grant.ResourceId),
+				})
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApplicationAdminConsentResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 10 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Applications
+
+			id, err := parse.ParseAdminConsentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApplicationAdminConsentModel
+			if err = metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// The planned values for the IDs are unknown, so the IDs recorded in state are used
+			oldAssignmentIds, _ := metadata.ResourceData.GetChange("app_role_assignment_ids")
+			oldGrantIds, _ := metadata.ResourceData.GetChange("delegated_permission_grant_ids")
+
+			expected, err := expectedAdminConsent(ctx, client, stable.NewApplicationID(id.ApplicationId))
+			if err != nil {
+				return err
+			}
+
+			if err = grantAdminConsent(ctx, client, expected, tf.ExpandStringSlice(oldAssignmentIds.(*pluginsdk.Set).List()), tf.ExpandStringSlice(oldGrantIds.(*pluginsdk.Set).List())); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			model.AppRoleAssignments = expected.AppRoleAssignments
+			model.AppRoleAssignmentIds = expected.AppRoleAssignmentIds
+			model.DelegatedPermissionGrants = expected.DelegatedPermissionGrants
+			model.DelegatedPermissionGrantIds = expected.DelegatedPermissionGrantIds
+			model.ServicePrincipalObjectId = expected.ServicePrincipalObjectId
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r ApplicationAdminConsentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 10 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Applications
+
+			id, err := parse.ParseAdminConsentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApplicationAdminConsentModel
+			if err = metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			assignments, grants, err := listAdminConsent(ctx, client, model.ServicePrincipalObjectId)
+			if err != nil {
+				return err
+			}
+
+			// Only revoke the assignments and grants created by this resource, any others are managed elsewhere
+			managedAssignments := make(map[string]bool)
+			for _, assignmentId := range model.AppRoleAssignmentIds {
+				managedAssignments[strings.ToLower(assignmentId)] = true
+			}
+
+			for _, assignment := range assignments {
+				if !managedAssignments[strings.ToLower(pointer.From(assignment.Id))] {
+					continue
+				}
+				assignmentId := stable.NewServicePrincipalIdAppRoleAssignmentID(model.ServicePrincipalObjectId, pointer.From(assignment.Id))
+				if resp, err := client.ServicePrincipalAppRoleAssignmentClient.DeleteAppRoleAssignment(ctx, assignmentId, approleassignment.DefaultDeleteAppRoleAssignmentOperationOptions()); err != nil && !response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("deleting %s: %+v", assignmentId, err)
+				}
+			}
+
+			managedGrants := make(map[string]bool)
+			for _, grantId := range model.DelegatedPermissionGrantIds {
+				managedGrants[grantId] = true
+			}
+
+			for _, grant := range grants {
+				if !managedGrants[pointer.From(grant.Id)] {
+					continue
+				}
+				grantId := stable.NewOAuth2PermissionGrantID(pointer.From(grant.Id))
+				if resp, err := client.OAuth2PermissionGrantClient.DeleteOAuth2PermissionGrant(ctx, grantId, oauth2permissiongrant.DefaultDeleteOAuth2PermissionGrantOperationOptions()); err != nil && !response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("deleting %s: %+v", grantId, err)
+				}
+			}
+
+			metadata.Logger.Infof("Revoked %s", id)
+			return nil
+		},
+	}
+}
+
+// expectedAdminConsent derives the app role assignments and tenant-wide delegated permission grants that constitute
+// admin consent for the `required_resource_access` of an application, resolving each API to its service principal.
+func expectedAdminConsent(ctx context.Context, client *client.Client, applicationId stable.ApplicationId) (*ApplicationAdminConsentModel, error) {
+	resp, err := client.ApplicationClient.GetApplication(ctx, applicationId, application.DefaultGetApplicationOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", applicationId, err)
+	}
+
+	app := resp.Model
+	if app == nil {
+		return nil, fmt.Errorf("retrieving %s: model was nil", applicationId)
+	}
+
+	servicePrincipal, err := findServicePrincipalByAppId(ctx, client.ServicePrincipalClient, app.AppId.GetOrZero())
+	if err != nil {
+		return nil, fmt.Errorf("retrieving service principal for %s: %+v", applicationId, err)
+	}
+
+	// A newly created service principal may not yet be returned when listing, so wait for it to become available
+	if servicePrincipal == nil {
+		if _, err = consistency.WaitForUpdateWithTimeout(ctx, 2*time.Minute, func(ctx context.Context) (*bool, error) {
+			servicePrincipal, err = findServicePrincipalByAppId(ctx, client.ServicePrincipalClient, app.AppId.GetOrZero())
+			if err != nil {
+				return nil, err
+			}
+			return pointer.To(servicePrincipal != nil), nil
+		}); err != nil || servicePrincipal == nil {
+			return nil, fmt.Errorf("no service principal was found for %s, a service principal must exist before admin consent can be granted", applicationId)
+		}
+	}
+
+	result := ApplicationAdminConsentModel{
+		ApplicationId:             applicationId.ID(),
+		AppRoleAssignments:        make([]ApplicationAdminConsentAppRoleAssignmentModel, 0),
+		DelegatedPermissionGrants: make([]ApplicationAdminConsentDelegatedPermissionModel, 0),
+		ServicePrincipalObjectId:  pointer.From(servicePrincipal.Id),
+	}
+
+	for _, api := range pointer.From(app.RequiredResourceAccess) {
+		apiClientId := pointer.From(api.ResourceAppId)
+
+		resource, err := findServicePrincipalByAppId(ctx, client.ServicePrincipalClient, apiClientId)
+		if err != nil {
+			return nil, fmt.Errorf("retrieving service principal for API %q: %+v", apiClientId, err)
+		}
+		if resource == nil {
+			return nil, fmt.Errorf("no service principal was found for API %q required by %s", apiClientId, applicationId)
+		}
+
+		resourceObjectId := pointer.From(resource.Id)
+		claimValues := make([]string, 0)
+
+		for _, access := range pointer.From(api.ResourceAccess) {
+			permissionId := pointer.From(access.Id)
+
+			switch access.Type.GetOrZero() {
+			case ResourceAccessTypeRole:
+				found := false
+				for _, role := range pointer.From(resource.AppRoles) {
+					if strings.EqualFold(pointer.From(role.Id), permissionId) {
+						found = true
+						break
+					}
+				}
+				if !found {
+					return nil, fmt.Errorf("app role %q was not found for API %q", permissionId, apiClientId)
+				}
+
+				result.AppRoleAssignments = append(result.AppRoleAssignments, ApplicationAdminConsentAppRoleAssignmentModel{
+					AppRoleId:        permissionId,
+					ResourceObjectId: resourceObjectId,
+				})
+
+			case ResourceAccessTypeScope:
+				value := ""
+				for _, scope := range pointer.From(resource.OAuth2PermissionScopes) {
+					if strings.EqualFold(pointer.From(scope.Id), permissionId) {
+						value = scope.Value.GetOrZero()
+						break
+					}
+				}
+				if value == "" {
+					return nil, fmt.Errorf("delegated permission scope %q was not found for API %q", permissionId, apiClientId)
+				}
+
+				if !slices.Contains(claimValues, value) {
+					claimValues = append(claimValues, value)
+				}
+			}
+		}
+
+		if len(claimValues) > 0 {
+			sort.Strings(claimValues)
+			result.DelegatedPermissionGrants = append(result.DelegatedPermissionGrants, ApplicationAdminConsentDelegatedPermissionModel{
+				ClaimValues:      claimValues,
+				ResourceObjectId: resourceObjectId,
+			})
+		}
+	}
+
+	return &result, nil
+}
+
+// grantAdminConsent reconciles the app role assignments and tenant-wide delegated permission grants for a service
+// principal, so that those in the expected model exist. Assignments and grants that are no longer expected are only
+// removed when their IDs are among those previously created by this resource, so that any managed elsewhere are left
+// unchanged. The IDs of the assignments and grants managed by this resource are recorded in the expected model.
+func grantAdminConsent(ctx context.Context, client *client.Client, expected *ApplicationAdminConsentModel, managedAssignmentIds, managedGrantIds []string) error {
+	servicePrincipalId := stable.NewServicePrincipalID(expected.ServicePrincipalObjectId)
+
+	assignments, grants, err := listAdminConsent(ctx, client, expected.ServicePrincipalObjectId)
+	if err != nil {
+		return err
+	}
+
+	managedAssignments := make(map[string]bool)
+	for _, assignmentId := range managedAssignmentIds {
+		managedAssignments[strings.ToLower(assignmentId)] = true
+	}
+
+	existingAssignments := make(map[string]stable.AppRoleAssignment)
+	for _, assignment := range assignments {
+		existingAssignments[adminConsentAppRoleAssignmentKey(assignment)] = assignment
+	}
+
+	expected.AppRoleAssignmentIds = make([]string, 0)
+	expectedAssignments := make(map[string]bool)
+	for _, assignment := range expected.AppRoleAssignments {
+		expectedAssignments[assignment.key()] = true
+		if existing, ok := existingAssignments[assignment.key()]; ok {
+			if managedAssignments[strings.ToLower(pointer.From(existing.Id))] {
+				expected.AppRoleAssignmentIds = append(expected.AppRoleAssignmentIds, pointer.From(existing.Id))
+			}
+			continue
+		}
+
+		properties := stable.AppRoleAssignment{
+			AppRoleId:   pointer.To(assignment.AppRoleId),
+			PrincipalId: nullable.Value(expected.ServicePrincipalObjectId),
+			ResourceId:  nullable.Value(assignment.ResourceObjectId),
+		}
+
+		options := approleassignment.CreateAppRoleAssignmentOperationOptions{
+			RetryFunc: func(resp *http.Response, o *odata.OData) (bool, error) {
+				if response.WasNotFound(resp) {
+					return true, nil
+				} else if response.WasBadRequest(resp) && o != nil && o.Error != nil {
+					return o.Error.Match("Not a valid reference update"), nil
+				}
+				return false, nil
+			},
+		}
+
+		resp, err := client.ServicePrincipalAppRoleAssignmentClient.CreateAppRoleAssignment(ctx, servicePrincipalId, properties, options)
+		if err != nil {
+			return fmt.Errorf("assigning app role %q for resource %q to %s: %+v", assignment.AppRoleId, assignment.ResourceObjectId, servicePrincipalId, err)
+		}
+		if resp.Model == nil || resp.Model.Id == nil {
+			return fmt.Errorf("assigning app role %q for resource %q to %s: API returned app role assignment with nil ID", assignment.AppRoleId, assignment.ResourceObjectId, servicePrincipalId)
+		}
+		expected.AppRoleAssignmentIds = append(expected.AppRoleAssignmentIds, *resp.Model.Id)
+	}
+
+	for _, assignment := range assignments {
+		if expectedAssignments[adminConsentAppRoleAssignmentKey(assignment)] || !managedAssignments[strings.ToLower(pointer.From(assignment.Id))] {
+			continue
+		}
+		assignmentId := stable.NewServicePrincipalIdAppRoleAssignmentID(expected.ServicePrincipalObjectId, pointer.From(assignment.Id))
+		if resp, err := client.ServicePrincipalAppRoleAssignmentClient.DeleteAppRoleAssignment(ctx, assignmentId, approleassignment.DefaultDeleteAppRoleAssignmentOperationOptions()); err != nil && !response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("deleting %s: %+v", assignmentId, err)
+		}
+	}
+
+	managedGrants := make(map[string]bool)
+	for _, grantId := range managedGrantIds {
+		managedGrants[grantId] = true
+	}
+
+	existingGrants := make(map[string]stable.OAuth2PermissionGrant)
+	for _, grant := range grants {
+		existingGrants[strings.ToLower(pointer.From(grant.ResourceId))] = grant
+	}
+
+	expected.DelegatedPermissionGrantIds = make([]string, 0)
+	expectedGrants := make(map[string]bool)
+	for _, grant := range expected.DelegatedPermissionGrants {
+		resourceKey := strings.ToLower(grant.ResourceObjectId)
+		expectedGrants[resourceKey] = true
+		scope := strings.Join(grant.ClaimValues, " ")
+
+		if existing, ok := existingGrants[resourceKey]; ok {
+			grantId := stable.NewOAuth2PermissionGrantID(pointer.From(existing.Id))
+			managed := managedGrants[grantId.OAuth2PermissionGrantId]
+
+			existingClaimValues := strings.Fields(existing.Scope.GetOrZero())
+			sort.Strings(existingClaimValues)
+			if slices.Equal(existingClaimValues, grant.ClaimValues) {
+				if managed {
+					expected.DelegatedPermissionGrantIds = append(expected.DelegatedPermissionGrantIds, grantId.OAuth2PermissionGrantId)
+				}
+				continue
+			}
+
+			// Only one tenant-wide grant can exist for each resource, so a grant managed elsewhere cannot be changed
+			if !managed {
+				return fmt.Errorf("%s for resource %q already exists with the scope %q and is not managed by this resource, it must be removed or updated to the scope %q", grantId, grant.ResourceObjectId, existing.Scope.GetOrZero(), scope)
+			}
+
+			properties := stable.OAuth2PermissionGrant{
+				Scope: nullable.NoZero(scope),
+			}
+			if _, err = client.OAuth2PermissionGrantClient.UpdateOAuth2PermissionGrant(ctx, grantId, properties, oauth2permissiongrant.DefaultUpdateOAuth2PermissionGrantOperationOptions()); err != nil {
+				return fmt.Errorf("updating %s: %+v", grantId, err)
+			}
+			expected.DelegatedPermissionGrantIds = append(expected.DelegatedPermissionGrantIds, grantId.OAuth2PermissionGrantId)
+			continue
+		}
+
+		properties := stable.OAuth2PermissionGrant{
+			ClientId:    pointer.To(expected.ServicePrincipalObjectId),
+			ConsentType: nullable.Value(DelegatedPermissionGrantConsentTypeAllPrincipals),
+			ResourceId:  pointer.To(grant.ResourceObjectId),
+			Scope:       nullable.NoZero(scope),
+		}
+
+		options := oauth2permissiongrant.CreateOAuth2PermissionGrantOperationOptions{
+			RetryFunc: func(resp *http.Response, o *odata.OData) (bool, error) {
+				if response.WasNotFound(resp) {
+					return true, nil
+				} else if response.WasBadRequest(resp) && o != nil && o.Error != nil {
+					return o.Error.Match("does not exist or one of its queried reference-property objects are not present"), nil
+				}
+				return false, nil
+			},
+		}
+
+		resp, err := client.OAuth2PermissionGrantClient.CreateOAuth2PermissionGrant(ctx, properties, options)
+		if err != nil {
+			return fmt.Errorf("creating delegated permission grant for resource %q to %s: %+v", grant.ResourceObjectId, servicePrincipalId, err)
+		}
+		if resp.Model == nil || resp.Model.Id == nil {
+			return fmt.Errorf("creating delegated permission grant for resource %q to %s: API returned delegated permission grant with nil ID", grant.ResourceObjectId, servicePrincipalId)
+		}
+		expected.DelegatedPermissionGrantIds = append(expected.DelegatedPermissionGrantIds, *resp.Model.Id)
+	}
+
+	for resourceKey, grant := range existingGrants {
+		if expectedGrants[resourceKey] || !managedGrants[pointer.From(grant.Id)] {
+			continue
+		}
+		grantId := stable.NewOAuth2PermissionGrantID(pointer.From(grant.Id))
+		if resp, err := client.OAuth2PermissionGrantClient.DeleteOAuth2PermissionGrant(ctx, grantId, oauth2permissiongrant.DefaultDeleteOAuth2PermissionGrantOperationOptions()); err != nil && !response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("deleting %s: %+v", grantId, err)
+		}
+	}
+
+	return nil
+}
+
+// listAdminConsent returns the app role assignments and tenant-wide delegated permission grants for a service principal
+func listAdminConsent(ctx context.Context, client *client.Client, servicePrincipalObjectId string) ([]stable.AppRoleAssignment, []stable.OAuth2PermissionGrant, error) {
+	servicePrincipalId := stable.NewServicePrincipalID(servicePrincipalObjectId)
+
+	assignmentsResp, err := client.ServicePrincipalAppRoleAssignmentClient.ListAppRoleAssignments(ctx, servicePrincipalId, approleassignment.DefaultListAppRoleAssignmentsOperationOptions())
+	if err != nil {
+		return nil, nil, fmt.Errorf("listing app role assignments for %s: %+v", servicePrincipalId, err)
+	}
+
+	options := oauth2permissiongrant.ListOAuth2PermissionGrantsOperationOptions{
+		Filter: pointer.To(fmt.Sprintf("clientId eq '%s' and consentType eq '%s'", odata.EscapeSingleQuote(servicePrincipalObjectId), DelegatedPermissionGrantConsentTypeAllPrincipals)),
+	}
+	grantsResp, err := client.OAuth2PermissionGrantClient.ListOAuth2PermissionGrants(ctx, options)
+	if err != nil {
+		return nil, nil, fmt.Errorf("listing delegated permission grants for %s: %+v", servicePrincipalId, err)
+	}
+
+	return pointer.From(assignmentsResp.Model), pointer.From(grantsResp.Model), nil
+}
+
+// findServicePrincipalByAppId returns the service principal for the specified client ID, or nil when none exists
+func findServicePrincipalByAppId(ctx context.Context, client *serviceprincipal.ServicePrincipalClient, appId string) (*stable.ServicePrincipal, error) {
+	options := serviceprincipal.ListServicePrincipalsOperationOptions{
+		Filter: pointer.To(fmt.Sprintf("appId eq '%s'", odata.EscapeSingleQuote(appId))),
+		Select: pointer.To([]string{"appId", "appRoles", "id", "oauth2PermissionScopes"}),
+	}
+
+	resp, err := client.ListServicePrincipals(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, servicePrincipal := range pointer.From(resp.Model) {
+		if strings.EqualFold(servicePrincipal.AppId.GetOrZero(), appId) {
+			return &servicePrincipal, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func adminConsentAppRoleAssignmentKey(assignment stable.AppRoleAssignment) string {
+	return ApplicationAdminConsentAppRoleAssignmentModel{
+		AppRoleId:        pointer.From(assignment.AppRoleId),
+		ResourceObjectId: assignment.ResourceId.GetOrZero(),
+	}.key()
+}
+
+func flattenAdminConsentAppRoleAssignments(input []ApplicationAdminConsentAppRoleAssignmentModel) []interface{} {
+	result := make([]interface{}, 0)
+	for _, assignment := range input {
+		result = append(result, map[string]interface{}{
+			"app_role_id":        assignment.AppRoleId,
+			"resource_object_id": assignment.ResourceObjectId,
+		})
+	}
+	return result
+}
+
+func flattenAdminConsentDelegatedPermissionGrants(input []ApplicationAdminConsentDelegatedPermissionModel) []interface{} {
+	result := make([]interface{}, 0)
+	for _, grant := range input {
+		claimValues := make([]interface{}, 0)
+		for _, v := range grant.ClaimValues {
+			claimValues = append(claimValues, v)
+		}
+		result = append(result, map[string]interface{}{
+			"claim_values":       claimValues,
+			"resource_object_id": grant.ResourceObjectId,
+		})
+	}
+	return result
+}
@@ -0,0 +1,223 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package applications_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/applications/stable/application"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/applications/parse"
+)
+
+type ApplicationAdminConsentResource struct{}
+
+func TestAccApplicationAdminConsent_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_admin_consent", "test")
+	r := ApplicationAdminConsentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("service_principal_object_id").MatchesOtherKey(check.That("azuread_service_principal.test").Key("object_id")),
+				check.That(data.ResourceName).Key("app_role_assignment.#").HasValue("1"),
+				check.That(data.ResourceName).Key("delegated_permission_grant.#").HasValue("1"),
+				check.That(data.ResourceName).Key("delegated_permission_grant.0.claim_values.#").HasValue("1"),
+				check.That(data.ResourceName).Key("app_role_assignment_ids.#").HasValue("1"),
+				check.That(data.ResourceName).Key("delegated_permission_grant_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationAdminConsent_separatelyManaged(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_admin_consent", "test")
+	r := ApplicationAdminConsentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.separatelyManaged(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("app_role_assignment.#").HasValue("1"),
+				check.That(data.ResourceName).Key("app_role_assignment_ids.#").HasValue("1"),
+				check.That("azuread_app_role_assignment.test").Key("id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationAdminConsent_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_admin_consent", "test")
+	r := ApplicationAdminConsentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("app_role_assignment.#").HasValue("1"),
+				check.That(data.ResourceName).Key("delegated_permission_grant.0.claim_values.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("app_role_assignment.#").HasValue("2"),
+				check.That(data.ResourceName).Key("delegated_permission_grant.#").HasValue("1"),
+				check.That(data.ResourceName).Key("delegated_permission_grant.0.claim_values.#").HasValue("2"),
+			),
+		},
+		data.ImportStep("triggers"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("app_role_assignment.#").HasValue("1"),
+				check.That(data.ResourceName).Key("delegated_permission_grant.0.claim_values.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ApplicationAdminConsentResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Applications.ApplicationClient
+
+	id, err := parse.ParseAdminConsentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	applicationId := stable.NewApplicationID(id.ApplicationId)
+
+	resp, err := client.GetApplication(ctx, applicationId, application.DefaultGetApplicationOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to retrieve %s: %+v", applicationId, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (ApplicationAdminConsentResource) template(data acceptance.TestData, requiredResourceAccess string) string {
+	return fmt.Sprintf(`
+data "azuread_application_published_app_ids" "well_known" {}
+
+data "azuread_service_principal" "msgraph" {
+  client_id = data.azuread_application_published_app_ids.well_known.result["MicrosoftGraph"]
+}
+
+resource "azuread_application" "test" {
+  display_name = "acctest-AdminConsent-%[1]d"
+
+  required_resource_access {
+    resource_app_id = data.azuread_application_published_app_ids.well_known.result["MicrosoftGraph"]
+
+%[2]s
+  }
+}
+
+resource "azuread_service_principal" "test" {
+  client_id = azuread_application.test.client_id
+}
+`, data.RandomInteger, requiredResourceAccess)
+}
+
+func (r ApplicationAdminConsentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application_admin_consent" "test" {
+  application_id = azuread_application.test.id
+
+  depends_on = [azuread_service_principal.test]
+}
+`, r.template(data, `
+    resource_access {
+      id   = data.azuread_service_principal.msgraph.app_role_ids["User.Read.All"]
+      type = "Role"
+    }
+
+    resource_access {
+      id   = data.azuread_service_principal.msgraph.oauth2_permission_scope_ids["User.Read"]
+      type = "Scope"
+    }
+`))
+}
+
+func (r ApplicationAdminConsentResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application_admin_consent" "test" {
+  application_id = azuread_application.test.id
+
+  triggers = {
+    required_resource_access = jsonencode(azuread_application.test.required_resource_access)
+  }
+
+  depends_on = [azuread_service_principal.test]
+}
+`, r.template(data, `
+    resource_access {
+      id   = data.azuread_service_principal.msgraph.app_role_ids["User.Read.All"]
+      type = "Role"
+    }
+
+    resource_access {
+      id   = data.azuread_service_principal.msgraph.app_role_ids["Group.Read.All"]
+      type = "Role"
+    }
+
+    resource_access {
+      id   = data.azuread_service_principal.msgraph.oauth2_permission_scope_ids["User.Read"]
+      type = "Scope"
+    }
+
+    resource_access {
+      id   = data.azuread_service_principal.msgraph.oauth2_permission_scope_ids["Group.Read.All"]
+      type = "Scope"
+    }
+`))
+}
+
+func (r ApplicationAdminConsentResource) separatelyManaged(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_app_role_assignment" "test" {
+  app_role_id         = data.azuread_service_principal.msgraph.app_role_ids["Group.Read.All"]
+  principal_object_id = azuread_service_principal.test.object_id
+  resource_object_id  = data.azuread_service_principal.msgraph.object_id
+}
+
+resource "azuread_application_admin_consent" "test" {
+  application_id = azuread_application.test.id
+
+  depends_on = [azuread_app_role_assignment.test]
+}
+`, r.template(data, `
+    resource_access {
+      id   = data.azuread_service_principal.msgraph.app_role_ids["User.Read.All"]
+      type = "Role"
+    }
+`))
+}
@@ -160,6 +160,9 @@ func applicationPasswordResourceCreate(ctx context.Context, d *pluginsdk.Resourc
 	}
 	addPasswordResp, err := client.AddPassword(ctx, *applicationId, request, application.DefaultAddPasswordOperationOptions())
 	if err != nil {
+		if credentials.IsAppManagementPolicyViolation(addPasswordResp.OData) {
+			return tf.ErrorDiagF(credentials.AppManagementPolicyViolationError(addPasswordResp.OData), "Adding password for %s is not permitted by an app management policy", applicationId)
+		}
 		return tf.ErrorDiagF(err, "Adding password for %s", applicationId)
 	}
 
@@ -12,6 +12,8 @@ import (
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/applications/stable/owner"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/applicationtemplates/stable/applicationtemplate"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/directoryobjects/stable/directoryobject"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/oauth2permissiongrants/stable/oauth2permissiongrant"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/approleassignment"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/serviceprincipal"
 	"github.com/hashicorp/terraform-provider-azuread/internal/common"
 )
@@ -24,6 +26,8 @@ type Client struct {
 	ApplicationFederatedIdentityCredential         *federatedidentitycredential.FederatedIdentityCredentialClient
 	ApplicationFlexibleFederatedIdentityCredential *flexibleFederatedIdentityCredential.FederatedIdentityCredentialClient
 	ApplicationTemplateClient                      *applicationtemplate.ApplicationTemplateClient
+	OAuth2PermissionGrantClient                    *oauth2permissiongrant.OAuth2PermissionGrantClient
+	ServicePrincipalAppRoleAssignmentClient        *approleassignment.AppRoleAssignmentClient
 	ServicePrincipalClient                         *serviceprincipal.ServicePrincipalClient
 }
 
@@ -77,6 +81,18 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(directoryObjectClient.Client)
 
+	oAuth2PermissionGrantClient, err := oauth2permissiongrant.NewOAuth2PermissionGrantClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(oAuth2PermissionGrantClient.Client)
+
+	servicePrincipalAppRoleAssignmentClient, err := approleassignment.NewAppRoleAssignmentClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(servicePrincipalAppRoleAssignmentClient.Client)
+
 	servicePrincipalClient, err := serviceprincipal.NewServicePrincipalClientWithBaseURI(o.Environment.MicrosoftGraph)
 	if err != nil {
 		return nil, err
@@ -91,6 +107,8 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		ApplicationFederatedIdentityCredential:         applicationFederatedIdentityCredentialClient,
 		ApplicationFlexibleFederatedIdentityCredential: applicationFlexibleFederatedIdentityCredentialClient,
 		ApplicationTemplateClient:                      applicationTemplateClient,
+		OAuth2PermissionGrantClient:                    oAuth2PermissionGrantClient,
+		ServicePrincipalAppRoleAssignmentClient:        servicePrincipalAppRoleAssignmentClient,
 		ServicePrincipalClient:                         servicePrincipalClient,
 	}, nil
 }
@@ -14,6 +14,10 @@ const (
 
 var possibleValuesForAppRoleAllowedMemberType = []string{AppRoleAllowedMemberTypeApplication, AppRoleAllowedMemberTypeUser}
 
+const (
+	DelegatedPermissionGrantConsentTypeAllPrincipals = "AllPrincipals"
+)
+
 const (
 	GroupMembershipClaimAll              = "All"
 	GroupMembershipClaimNone             = "None"
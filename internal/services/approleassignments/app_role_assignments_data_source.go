@@ -0,0 +1,233 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package approleassignments
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/approleassignedto"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/hashicorp/terraform-provider-azuread/internal/sdk"
+)
+
+type AppRoleAssignmentsId string
+
+func (id AppRoleAssignmentsId) ID() string {
+	return string(id)
+}
+
+func (AppRoleAssignmentsId) String() string {
+	return "App Role Assignments"
+}
+
+type AppRoleAssignmentsDataSourceModel struct {
+	AppRoleAssignments []AppRoleAssignmentModel `tfschema:"app_role_assignments"`
+	PrincipalObjectId  string                   `tfschema:"principal_object_id"`
+	ResourceObjectId   string                   `tfschema:"resource_object_id"`
+}
+
+type AppRoleAssignmentModel struct {
+	AppRoleDisplayName   string `tfschema:"app_role_display_name"`
+	AppRoleId            string `tfschema:"app_role_id"`
+	AppRoleValue         string `tfschema:"app_role_value"`
+	CreatedDateTime      string `tfschema:"created_date_time"`
+	Id                   string `tfschema:"id"`
+	PrincipalDisplayName string `tfschema:"principal_display_name"`
+	PrincipalObjectId    string `tfschema:"principal_object_id"`
+	PrincipalType        string `tfschema:"principal_type"`
+	ResourceDisplayName  string `tfschema:"resource_display_name"`
+	ResourceObjectId     string `tfschema:"resource_object_id"`
+}
+
+type AppRoleAssignmentsDataSource struct{}
+
+var _ sdk.DataSource = AppRoleAssignmentsDataSource{}
+
+func (r AppRoleAssignmentsDataSource) ResourceType() string {
+	return "azuread_app_role_assignments"
+}
+
+func (r AppRoleAssignmentsDataSource) ModelObject() interface{} {
+	return &AppRoleAssignmentsDataSourceModel{}
+}
+
+func (r AppRoleAssignmentsDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"principal_object_id": {
+			Description:  "The object ID of a user, group or service principal, for which to list all assigned app roles",
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ExactlyOneOf: []string{"principal_object_id", "resource_object_id"},
+			ValidateFunc: validation.IsUUID,
+		},
+
+		"resource_object_id": {
+			Description:  "The object ID of a resource service principal, for which to list all app role assignments granted to users, groups and service principals",
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ExactlyOneOf: []string{"principal_object_id", "resource_object_id"},
+			ValidateFunc: validation.IsUUID,
+		},
+	}
+}
+
+func (r AppRoleAssignmentsDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"app_role_assignments": {
+			Description: "A list of app role assignments",
+			Type:        pluginsdk.TypeList,
+			Computed:    true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"app_role_display_name": {
+						Description: "The display name of the assigned app role",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"app_role_id": {
+						Description: "The ID of the assigned app role",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"app_role_value": {
+						Description: "The value of the assigned app role, as included in the `roles` claim of tokens",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"created_date_time": {
+						Description: "The time when the app role assignment was created",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"id": {
+						Description: "The ID of the app role assignment",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"principal_display_name": {
+						Description: "The display name of the principal to which the app role is assigned",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"principal_object_id": {
+						Description: "The object ID of the principal to which the app role is assigned",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"principal_type": {
+						Description: "The object type of the principal to which the app role is assigned",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"resource_display_name": {
+						Description: "The display name of the resource service principal",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"resource_object_id": {
+						Description: "The object ID of the resource service principal",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r AppRoleAssignmentsDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppRoleAssignments
+			tenantId := metadata.Client.TenantID
+
+			var state AppRoleAssignmentsDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			var assignments []stable.AppRoleAssignment
+			var idPrefix string
+
+			if state.PrincipalObjectId != "" {
+				result, err := listPrincipalAppRoleAssignments(ctx, client, state.PrincipalObjectId)
+				if err != nil {
+					return err
+				}
+				assignments = result
+				idPrefix = fmt.Sprintf("principal#%s", state.PrincipalObjectId)
+			} else {
+				id := stable.NewServicePrincipalID(state.ResourceObjectId)
+				resp, err := client.AppRoleAssignedToClient.ListAppRoleAssignedTos(ctx, id, approleassignedto.DefaultListAppRoleAssignedTosOperationOptions())
+				if err != nil {
+					return fmt.Errorf("listing app role assignments for %s: %+v", id, err)
+				}
+				assignments = pointer.From(resp.Model)
+				idPrefix = fmt.Sprintf("resource#%s", state.ResourceObjectId)
+			}
+
+			resourceIds := make([]string, 0)
+			for _, assignment := range assignments {
+				if resourceId := assignment.ResourceId.GetOrZero(); resourceId != "" {
+					resourceIds = append(resourceIds, resourceId)
+				}
+			}
+
+			names, err := resolveAppRoleNames(ctx, client.ServicePrincipalClient, resourceIds)
+			if err != nil {
+				return fmt.Errorf("resolving app role names: %+v", err)
+			}
+
+			assignmentIds := make([]string, 0)
+			state.AppRoleAssignments = make([]AppRoleAssignmentModel, 0)
+			for _, assignment := range assignments {
+				appRoleId := pointer.From(assignment.AppRoleId)
+				resourceId := assignment.ResourceId.GetOrZero()
+				roleNames := names[strings.ToLower(resourceId)][strings.ToLower(appRoleId)]
+
+				assignmentIds = append(assignmentIds, pointer.From(assignment.Id))
+				state.AppRoleAssignments = append(state.AppRoleAssignments, AppRoleAssignmentModel{
+					AppRoleDisplayName:   roleNames.DisplayName,
+					AppRoleId:            appRoleId,
+					AppRoleValue:         roleNames.Value,
+					CreatedDateTime:      assignment.CreatedDateTime.GetOrZero(),
+					Id:                   pointer.From(assignment.Id),
+					PrincipalDisplayName: assignment.PrincipalDisplayName.GetOrZero(),
+					PrincipalObjectId:    assignment.PrincipalId.GetOrZero(),
+					PrincipalType:        assignment.PrincipalType.GetOrZero(),
+					ResourceDisplayName:  assignment.ResourceDisplayName.GetOrZero(),
+					ResourceObjectId:     resourceId,
+				})
+			}
+
+			// Generate a unique ID based on result
+			h := sha1.New()
+			if _, err := h.Write([]byte(strings.Join(assignmentIds, "/"))); err != nil {
+				return fmt.Errorf("unable to compute hash for app role assignment IDs: %+v", err)
+			}
+
+			metadata.SetID(AppRoleAssignmentsId(fmt.Sprintf("appRoleAssignments#%s#%s#%s", tenantId, idPrefix, base64.URLEncoding.EncodeToString(h.Sum(nil)))))
+
+			return metadata.Encode(&state)
+		},
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package approleassignments_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type AppRoleAssignmentsDataSource struct{}
+
+func TestAccAppRoleAssignmentsDataSource_byPrincipal(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_app_role_assignments", "test")
+	r := AppRoleAssignmentsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.byPrincipal(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("app_role_assignments.#").HasValue("1"),
+				check.That(data.ResourceName).Key("app_role_assignments.0.app_role_value").HasValue("Admin.All"),
+				check.That(data.ResourceName).Key("app_role_assignments.0.app_role_display_name").HasValue("Admin"),
+				check.That(data.ResourceName).Key("app_role_assignments.0.principal_type").HasValue("Group"),
+				check.That(data.ResourceName).Key("app_role_assignments.0.resource_object_id").MatchesOtherKey(check.That("azuread_service_principal.internal").Key("object_id")),
+			),
+		},
+	})
+}
+
+func TestAccAppRoleAssignmentsDataSource_byResource(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_app_role_assignments", "test")
+	r := AppRoleAssignmentsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.byResource(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("app_role_assignments.#").HasValue("2"),
+				check.That(data.ResourceName).Key("app_role_assignments.0.principal_object_id").MatchesOtherKey(check.That("azuread_service_principal.test").Key("object_id")),
+				check.That(data.ResourceName).Key("app_role_assignments.0.app_role_value").Exists(),
+				check.That(data.ResourceName).Key("app_role_assignments.1.app_role_value").Exists(),
+			),
+		},
+	})
+}
+
+func (AppRoleAssignmentsDataSource) byPrincipal(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_app_role_assignments" "test" {
+  principal_object_id = azuread_group.test.object_id
+  depends_on          = [azuread_app_role_assignment.test]
+}
+`, AppRoleAssignmentResource{}.groupForTenantApp(data))
+}
+
+func (AppRoleAssignmentsDataSource) byResource(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_app_role_assignments" "test" {
+  resource_object_id = azuread_service_principal.internal.object_id
+  depends_on         = [azuread_app_role_assignment.test_admin, azuread_app_role_assignment.test_query]
+}
+`, AppRoleAssignmentResource{}.servicePrincipalForTenantApp(data))
+}
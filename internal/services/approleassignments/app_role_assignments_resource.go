@@ -0,0 +1,359 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package approleassignments
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/approleassignedto"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/serviceprincipal"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/applications"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/lookup"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/hashicorp/terraform-provider-azuread/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/approleassignments/parse"
+)
+
+type AppRoleAssignmentsModel struct {
+	Assignments      []AppRoleAssignmentsAssignmentModel `tfschema:"assignment"`
+	ResourceObjectId string                              `tfschema:"resource_object_id"`
+}
+
+type AppRoleAssignmentsAssignmentModel struct {
+	AppRoleId         string `tfschema:"app_role_id"`
+	PrincipalObjectId string `tfschema:"principal_object_id"`
+}
+
+func (m AppRoleAssignmentsAssignmentModel) key() string {
+	return fmt.Sprintf("%s/%s", strings.ToLower(m.PrincipalObjectId), strings.ToLower(m.AppRoleId))
+}
+
+var _ sdk.ResourceWithUpdate = AppRoleAssignmentsResource{}
+
+type AppRoleAssignmentsResource struct{}
+
+func (r AppRoleAssignmentsResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return parse.ValidateAppRoleAssignmentsID
+}
+
+func (r AppRoleAssignmentsResource) ResourceType() string {
+	return "azuread_app_role_assignments"
+}
+
+func (r AppRoleAssignmentsResource) ModelObject() interface{} {
+	return &AppRoleAssignmentsModel{}
+}
+
+func (r AppRoleAssignmentsResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"resource_object_id": {
+			Description:  "The object ID of the service principal representing the resource",
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+
+		"assignment": {
+			Description: "The complete set of app role assignments for the resource. Any other assignments will be removed",
+			Type:        pluginsdk.TypeSet,
+			Optional:    true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"app_role_id": {
+						Description:  "The ID of the app role to be assigned",
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.IsUUID,
+					},
+
+					"principal_object_id": {
+						Description:  "The object ID of the user, group or service principal to be assigned the app role",
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.IsUUID,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r AppRoleAssignmentsResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r AppRoleAssignmentsResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 10 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppRoleAssignments
+
+			var model AppRoleAssignmentsModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := parse.NewAppRoleAssignmentsID(model.ResourceObjectId)
+
+			if err := validateAppRoleAssignments(ctx, client.ServicePrincipalClient, model); err != nil {
+				return err
+			}
+
+			if err := applyAppRoleAssignmentsConcurrently(ctx, client.AppRoleAssignedToClient, model); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r AppRoleAssignmentsResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppRoleAssignments.AppRoleAssignedToClient
+
+			id, err := parse.ParseAppRoleAssignmentsID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			servicePrincipalId := stable.NewServicePrincipalID(id.ServicePrincipalId)
+			resp, err := client.ListAppRoleAssignedTos(ctx, servicePrincipalId, approleassignedto.DefaultListAppRoleAssignedTosOperationOptions())
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("listing app role assignments for %s: %+v", servicePrincipalId, err)
+			}
+
+			state := AppRoleAssignmentsModel{
+				Assignments:      make([]AppRoleAssignmentsAssignmentModel, 0),
+				ResourceObjectId: id.ServicePrincipalId,
+			}
+
+			for _, assignment := range pointer.From(resp.Model) {
+				state.Assignments = append(state.Assignments, AppRoleAssignmentsAssignmentModel{
+					AppRoleId:         pointer.From(assignment.AppRoleId),
+					PrincipalObjectId: assignment.PrincipalId.GetOrZero(),
+				})
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r AppRoleAssignmentsResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 10 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppRoleAssignments
+
+			id, err := parse.ParseAppRoleAssignmentsID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model AppRoleAssignmentsModel
+			if err = metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if err = validateAppRoleAssignments(ctx, client.ServicePrincipalClient, model); err != nil {
+				return err
+			}
+
+			if err = applyAppRoleAssignmentsConcurrently(ctx, client.AppRoleAssignedToClient, model); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r AppRoleAssignmentsResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 10 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppRoleAssignments.AppRoleAssignedToClient
+
+			id, err := parse.ParseAppRoleAssignmentsID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model AppRoleAssignmentsModel
+			if err = metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			servicePrincipalId := stable.NewServicePrincipalID(id.ServicePrincipalId)
+			existing, err := listAppRoleAssignmentsByKey(ctx, client, servicePrincipalId)
+			if err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			// Only the assignments known to Terraform are removed
+			toRemove := make(map[string]stable.AppRoleAssignment)
+			for _, assignment := range model.Assignments {
+				if existingAssignment, ok := existing[assignment.key()]; ok {
+					toRemove[assignment.key()] = existingAssignment
+				}
+			}
+
+			if err = removeAppRoleAssignments(ctx, client, servicePrincipalId, toRemove); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// validateAppRoleAssignments checks that each app role to be assigned is published by the resource service principal
+func validateAppRoleAssignments(ctx context.Context, client *serviceprincipal.ServicePrincipalClient, model AppRoleAssignmentsModel) error {
+	id := stable.NewServicePrincipalID(model.ResourceObjectId)
+
+	options := serviceprincipal.GetServicePrincipalOperationOptions{
+		Select: pointer.To([]string{"appRoles", "id"}),
+	}
+	resp, err := client.GetServicePrincipal(ctx, id, options)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("%s was not found", id)
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if resp.Model == nil {
+		return fmt.Errorf("retrieving %s: model was nil", id)
+	}
+
+	appRoleIds := map[string]bool{
+		defaultAppRoleId: true,
+	}
+	for _, roleId := range applications.FlattenAppRoleIDs(resp.Model.AppRoles) {
+		appRoleIds[strings.ToLower(roleId)] = true
+	}
+
+	for _, assignment := range model.Assignments {
+		if !appRoleIds[strings.ToLower(assignment.AppRoleId)] {
+			return fmt.Errorf("app role %q is not published by %s", assignment.AppRoleId, id)
+		}
+	}
+
+	return nil
+}
+
+// applyAppRoleAssignmentsConcurrently computes the app role assignments to be added and removed for the resource
+// service principal, so that the assignments match the model. New assignments are created before any are removed. Each
+// assignment is created or removed with its own request, with at most lookup.DefaultConcurrency requests in flight.
+func applyAppRoleAssignmentsConcurrently(ctx context.Context, client *approleassignedto.AppRoleAssignedToClient, model AppRoleAssignmentsModel) error {
+	servicePrincipalId := stable.NewServicePrincipalID(model.ResourceObjectId)
+
+	existing, err := listAppRoleAssignmentsByKey(ctx, client, servicePrincipalId)
+	if err != nil {
+		return err
+	}
+
+	desired := make(map[string]AppRoleAssignmentsAssignmentModel)
+	toAdd := make([]string, 0)
+	for _, assignment := range model.Assignments {
+		k := assignment.key()
+		desired[k] = assignment
+		if _, ok := existing[k]; !ok {
+			toAdd = append(toAdd, k)
+		}
+	}
+
+	toRemove := make(map[string]stable.AppRoleAssignment)
+	for k, assignment := range existing {
+		if _, ok := desired[k]; !ok {
+			toRemove[k] = assignment
+		}
+	}
+
+	createOptions := approleassignedto.CreateAppRoleAssignedToOperationOptions{
+		RetryFunc: func(resp *http.Response, o *odata.OData) (bool, error) {
+			if response.WasNotFound(resp) {
+				return true, nil
+			} else if response.WasBadRequest(resp) && o != nil && o.Error != nil {
+				return o.Error.Match("Not a valid reference update"), nil
+			}
+			return false, nil
+		},
+	}
+
+	if _, err = lookup.InChunks(ctx, toAdd, 1, lookup.DefaultConcurrency, func(ctx context.Context, chunk []string) ([]string, error) {
+		assignment := desired[chunk[0]]
+		properties := stable.AppRoleAssignment{
+			AppRoleId:   pointer.To(assignment.AppRoleId),
+			PrincipalId: nullable.Value(assignment.PrincipalObjectId),
+			ResourceId:  nullable.Value(model.ResourceObjectId),
+		}
+		if _, err := client.CreateAppRoleAssignedTo(ctx, servicePrincipalId, properties, createOptions); err != nil {
+			return nil, fmt.Errorf("assigning app role %q to principal %q: %+v", assignment.AppRoleId, assignment.PrincipalObjectId, err)
+		}
+		return nil, nil
+	}); err != nil {
+		return err
+	}
+
+	return removeAppRoleAssignments(ctx, client, servicePrincipalId, toRemove)
+}
+
+// listAppRoleAssignmentsByKey retrieves the app role assignments for the resource service principal, keyed by the
+// principal object ID and app role ID
+func listAppRoleAssignmentsByKey(ctx context.Context, client *approleassignedto.AppRoleAssignedToClient, servicePrincipalId stable.ServicePrincipalId) (map[string]stable.AppRoleAssignment, error) {
+	resp, err := client.ListAppRoleAssignedTos(ctx, servicePrincipalId, approleassignedto.DefaultListAppRoleAssignedTosOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("listing existing app role assignments: %+v", err)
+	}
+
+	result := make(map[string]stable.AppRoleAssignment)
+	for _, assignment := range pointer.From(resp.Model) {
+		k := AppRoleAssignmentsAssignmentModel{
+			AppRoleId:         pointer.From(assignment.AppRoleId),
+			PrincipalObjectId: assignment.PrincipalId.GetOrZero(),
+		}.key()
+		result[k] = assignment
+	}
+
+	return result, nil
+}
+
+// removeAppRoleAssignments removes the specified app role assignments, which are keyed by the principal object ID and
+// app role ID, using one request per assignment with at most lookup.DefaultConcurrency requests in flight
+func removeAppRoleAssignments(ctx context.Context, client *approleassignedto.AppRoleAssignedToClient, servicePrincipalId stable.ServicePrincipalId, assignments map[string]stable.AppRoleAssignment) error {
+	keys := make([]string, 0, len(assignments))
+	for k, assignment := range assignments {
+		log.Printf("[DEBUG] Removing app role assignment %q for principal %q (%s) from %s", pointer.From(assignment.AppRoleId), assignment.PrincipalId.GetOrZero(), assignment.PrincipalDisplayName.GetOrZero(), servicePrincipalId)
+		keys = append(keys, k)
+	}
+
+	_, err := lookup.InChunks(ctx, keys, 1, lookup.DefaultConcurrency, func(ctx context.Context, chunk []string) ([]string, error) {
+		id := stable.NewServicePrincipalIdAppRoleAssignedToID(servicePrincipalId.ServicePrincipalId, pointer.From(assignments[chunk[0]].Id))
+		if resp, err := client.DeleteAppRoleAssignedTo(ctx, id, approleassignedto.DefaultDeleteAppRoleAssignedToOperationOptions()); err != nil && !response.WasNotFound(resp.HttpResponse) {
+			return nil, fmt.Errorf("removing %s: %+v", id, err)
+		}
+		return nil, nil
+	})
+
+	return err
+}
@@ -0,0 +1,151 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package approleassignments_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/approleassignedto"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/approleassignments/parse"
+)
+
+type AppRoleAssignmentsResource struct{}
+
+func TestAccAppRoleAssignments_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_app_role_assignments", "test")
+	r := AppRoleAssignmentsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("assignment.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAppRoleAssignments_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_app_role_assignments", "test")
+	r := AppRoleAssignmentsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("assignment.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("assignment.#").HasValue("3"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("assignment.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r AppRoleAssignmentsResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.AppRoleAssignments.AppRoleAssignedToClient
+
+	id, err := parse.ParseAppRoleAssignmentsID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	servicePrincipalId := stable.NewServicePrincipalID(id.ServicePrincipalId)
+	resp, err := client.ListAppRoleAssignedTos(ctx, servicePrincipalId, approleassignedto.DefaultListAppRoleAssignedTosOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to list app role assignments for %s: %+v", servicePrincipalId, err)
+	}
+
+	return pointer.To(len(pointer.From(resp.Model)) > 0), nil
+}
+
+func (AppRoleAssignmentsResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_group" "test" {
+  display_name     = "acctest-appRoleAssignments-%[2]d"
+  security_enabled = true
+}
+
+resource "azuread_user" "test" {
+  display_name        = "acctest-appRoleAssignments-%[2]d"
+  password            = "%[3]s"
+  user_principal_name = "acctest-AppRoleAssignments-%[2]d@${data.azuread_domains.test.domains.0.domain_name}"
+}
+`, AppRoleAssignmentResource{}.tenantAppTemplate(data), data.RandomInteger, data.RandomPassword)
+}
+
+func (r AppRoleAssignmentsResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_app_role_assignments" "test" {
+  resource_object_id = azuread_service_principal.internal.object_id
+
+  assignment {
+    app_role_id         = azuread_service_principal.internal.app_role_ids["Admin.All"]
+    principal_object_id = azuread_group.test.object_id
+  }
+}
+`, r.template(data))
+}
+
+func (r AppRoleAssignmentsResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_app_role_assignments" "test" {
+  resource_object_id = azuread_service_principal.internal.object_id
+
+  assignment {
+    app_role_id         = azuread_service_principal.internal.app_role_ids["Admin.All"]
+    principal_object_id = azuread_group.test.object_id
+  }
+
+  assignment {
+    app_role_id         = azuread_service_principal.internal.app_role_ids["Admin.All"]
+    principal_object_id = azuread_user.test.object_id
+  }
+
+  assignment {
+    app_role_id         = "00000000-0000-0000-0000-000000000000"
+    principal_object_id = azuread_user.test.object_id
+  }
+}
+`, r.template(data))
+}
@@ -0,0 +1,114 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package approleassignments
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/directoryobjects/stable/directoryobject"
+	groupAppRoleAssignment "github.com/hashicorp/go-azure-sdk/microsoft-graph/groups/stable/approleassignment"
+	servicePrincipalAppRoleAssignment "github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/approleassignment"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/serviceprincipal"
+	userAppRoleAssignment "github.com/hashicorp/go-azure-sdk/microsoft-graph/users/stable/approleassignment"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/applications"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/approleassignments/client"
+)
+
+// defaultAppRoleId is the ID used when assigning a principal to a resource that does not declare any app roles
+const defaultAppRoleId = "00000000-0000-0000-0000-000000000000"
+
+// listPrincipalAppRoleAssignments retrieves the app roles assigned to a user, group or service principal
+func listPrincipalAppRoleAssignments(ctx context.Context, c *client.Client, principalId string) ([]stable.AppRoleAssignment, error) {
+	resp, err := c.DirectoryObjectClient.GetDirectoryObject(ctx, stable.NewDirectoryObjectID(principalId), directoryobject.DefaultGetDirectoryObjectOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("retrieving principal %q: %+v", principalId, err)
+	}
+	if resp.Model == nil {
+		return nil, fmt.Errorf("retrieving principal %q: model was nil", principalId)
+	}
+
+	var result *[]stable.AppRoleAssignment
+
+	switch resp.Model.(type) {
+	case stable.User:
+		listResp, err := c.UserAppRoleAssignmentClient.ListAppRoleAssignments(ctx, stable.NewUserID(principalId), userAppRoleAssignment.DefaultListAppRoleAssignmentsOperationOptions())
+		if err != nil {
+			return nil, fmt.Errorf("listing app role assignments for user %q: %+v", principalId, err)
+		}
+		result = listResp.Model
+
+	case stable.Group:
+		listResp, err := c.GroupAppRoleAssignmentClient.ListAppRoleAssignments(ctx, stable.NewGroupID(principalId), groupAppRoleAssignment.DefaultListAppRoleAssignmentsOperationOptions())
+		if err != nil {
+			return nil, fmt.Errorf("listing app role assignments for group %q: %+v", principalId, err)
+		}
+		result = listResp.Model
+
+	case stable.ServicePrincipal:
+		listResp, err := c.ServicePrincipalAppRoleAssignmentClient.ListAppRoleAssignments(ctx, stable.NewServicePrincipalID(principalId), servicePrincipalAppRoleAssignment.DefaultListAppRoleAssignmentsOperationOptions())
+		if err != nil {
+			return nil, fmt.Errorf("listing app role assignments for service principal %q: %+v", principalId, err)
+		}
+		result = listResp.Model
+
+	default:
+		return nil, fmt.Errorf("principal %q has unsupported type %q, expected a user, group or service principal", principalId, pointer.From(resp.Model.DirectoryObject().ODataType))
+	}
+
+	return pointer.From(result), nil
+}
+
+type appRoleNames struct {
+	DisplayName string
+	Value       string
+}
+
+// resolveAppRoleNames retrieves the app roles published by each of the specified resource service principals, and
+// returns a map of resource object ID to app role ID, to the value and display name of each app role
+func resolveAppRoleNames(ctx context.Context, c *serviceprincipal.ServicePrincipalClient, resourceIds []string) (map[string]map[string]appRoleNames, error) {
+	result := make(map[string]map[string]appRoleNames)
+
+	for _, resourceId := range resourceIds {
+		key := strings.ToLower(resourceId)
+		if _, ok := result[key]; ok {
+			continue
+		}
+
+		id := stable.NewServicePrincipalID(resourceId)
+		options := serviceprincipal.GetServicePrincipalOperationOptions{
+			Select: pointer.To([]string{"appRoles", "id"}),
+		}
+		resp, err := c.GetServicePrincipal(ctx, id, options)
+		if err != nil {
+			return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+		if resp.Model == nil {
+			return nil, fmt.Errorf("retrieving %s: model was nil", id)
+		}
+
+		names := map[string]appRoleNames{
+			defaultAppRoleId: {DisplayName: "Default Access"},
+		}
+		for value, roleId := range applications.FlattenAppRoleIDs(resp.Model.AppRoles) {
+			names[strings.ToLower(roleId)] = appRoleNames{Value: value}
+		}
+		for _, role := range pointer.From(resp.Model.AppRoles) {
+			if role.Id == nil {
+				continue
+			}
+			k := strings.ToLower(*role.Id)
+			n := names[k]
+			n.DisplayName = role.DisplayName.GetOrZero()
+			names[k] = n
+		}
+
+		result[key] = names
+	}
+
+	return result, nil
+}
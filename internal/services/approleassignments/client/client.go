@@ -4,14 +4,22 @@
 package client
 
 import (
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/directoryobjects/stable/directoryobject"
+	groupAppRoleAssignment "github.com/hashicorp/go-azure-sdk/microsoft-graph/groups/stable/approleassignment"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/approleassignedto"
+	servicePrincipalAppRoleAssignment "github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/approleassignment"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/serviceprincipal"
+	userAppRoleAssignment "github.com/hashicorp/go-azure-sdk/microsoft-graph/users/stable/approleassignment"
 	"github.com/hashicorp/terraform-provider-azuread/internal/common"
 )
 
 type Client struct {
-	AppRoleAssignedToClient *approleassignedto.AppRoleAssignedToClient
-	ServicePrincipalClient  *serviceprincipal.ServicePrincipalClient
+	AppRoleAssignedToClient                 *approleassignedto.AppRoleAssignedToClient
+	DirectoryObjectClient                   *directoryobject.DirectoryObjectClient
+	GroupAppRoleAssignmentClient            *groupAppRoleAssignment.AppRoleAssignmentClient
+	ServicePrincipalAppRoleAssignmentClient *servicePrincipalAppRoleAssignment.AppRoleAssignmentClient
+	ServicePrincipalClient                  *serviceprincipal.ServicePrincipalClient
+	UserAppRoleAssignmentClient             *userAppRoleAssignment.AppRoleAssignmentClient
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -21,14 +29,42 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(appRoleAssignedToClient.Client)
 
+	directoryObjectClient, err := directoryobject.NewDirectoryObjectClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(directoryObjectClient.Client)
+
+	groupAppRoleAssignmentClient, err := groupAppRoleAssignment.NewAppRoleAssignmentClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(groupAppRoleAssignmentClient.Client)
+
+	servicePrincipalAppRoleAssignmentClient, err := servicePrincipalAppRoleAssignment.NewAppRoleAssignmentClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(servicePrincipalAppRoleAssignmentClient.Client)
+
 	servicePrincipalClient, err := serviceprincipal.NewServicePrincipalClientWithBaseURI(o.Environment.MicrosoftGraph)
 	if err != nil {
 		return nil, err
 	}
 	o.Configure(servicePrincipalClient.Client)
 
+	userAppRoleAssignmentClient, err := userAppRoleAssignment.NewAppRoleAssignmentClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(userAppRoleAssignmentClient.Client)
+
 	return &Client{
-		AppRoleAssignedToClient: appRoleAssignedToClient,
-		ServicePrincipalClient:  servicePrincipalClient,
+		AppRoleAssignedToClient:                 appRoleAssignedToClient,
+		DirectoryObjectClient:                   directoryObjectClient,
+		GroupAppRoleAssignmentClient:            groupAppRoleAssignmentClient,
+		ServicePrincipalAppRoleAssignmentClient: servicePrincipalAppRoleAssignmentClient,
+		ServicePrincipalClient:                  servicePrincipalClient,
+		UserAppRoleAssignmentClient:             userAppRoleAssignmentClient,
 	}, nil
 }
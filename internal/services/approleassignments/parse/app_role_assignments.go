@@ -0,0 +1,81 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = &AppRoleAssignmentsId{}
+
+// AppRoleAssignmentsId is the ID of the complete set of app role assignments granted for a resource service principal
+type AppRoleAssignmentsId struct {
+	ServicePrincipalId string
+}
+
+func NewAppRoleAssignmentsID(servicePrincipalId string) AppRoleAssignmentsId {
+	return AppRoleAssignmentsId{
+		ServicePrincipalId: servicePrincipalId,
+	}
+}
+
+// ParseAppRoleAssignmentsID parses 'input' into an AppRoleAssignmentsId
+func ParseAppRoleAssignmentsID(input string) (*AppRoleAssignmentsId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&AppRoleAssignmentsId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := AppRoleAssignmentsId{}
+	if err = id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+// ValidateAppRoleAssignmentsID checks that 'input' can be parsed as an AppRoleAssignmentsId
+func ValidateAppRoleAssignmentsID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseAppRoleAssignmentsID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+func (id *AppRoleAssignmentsId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.ServicePrincipalId, ok = input.Parsed["servicePrincipalId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "servicePrincipalId", input)
+	}
+
+	return nil
+}
+
+func (id AppRoleAssignmentsId) ID() string {
+	return fmt.Sprintf("/servicePrincipals/%s/appRoleAssignedTo", id.ServicePrincipalId)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this ID
+func (id AppRoleAssignmentsId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("servicePrincipals", "servicePrincipals", "servicePrincipals"),
+		resourceids.UserSpecifiedSegment("servicePrincipalId", "servicePrincipalId"),
+		resourceids.StaticSegment("appRoleAssignedTo", "appRoleAssignedTo", "appRoleAssignedTo"),
+	}
+}
+
+func (id AppRoleAssignmentsId) String() string {
+	return fmt.Sprintf("App Role Assignments (Service Principal: %q)", id.ServicePrincipalId)
+}
@@ -3,7 +3,10 @@
 
 package approleassignments
 
-import "github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+import (
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/sdk"
+)
 
 type Registration struct{}
 
@@ -35,3 +38,17 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azuread_app_role_assignment": appRoleAssignmentResource(),
 	}
 }
+
+// DataSources returns the typed DataSources supported by this service
+func (r Registration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{
+		AppRoleAssignmentsDataSource{},
+	}
+}
+
+// Resources returns the typed Resources supported by this service
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		AppRoleAssignmentsResource{},
+	}
+}
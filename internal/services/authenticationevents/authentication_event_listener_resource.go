@@ -0,0 +1,299 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package authenticationevents
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identity/stable/authenticationeventlistener"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/consistency"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+)
+
+func authenticationEventListenerResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: authenticationEventListenerResourceCreate,
+		ReadContext:   authenticationEventListenerResourceRead,
+		UpdateContext: authenticationEventListenerResourceUpdate,
+		DeleteContext: authenticationEventListenerResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			if _, errs := stable.ValidateIdentityAuthenticationEventListenerID(id, "id"); len(errs) > 0 {
+				out := ""
+				for _, err := range errs {
+					out += err.Error()
+				}
+				return errors.New(out)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"event_type": {
+				Description:  "The authentication event to listen for",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(possibleValuesForEventType(), false),
+			},
+
+			"custom_authentication_extension_id": {
+				Description:  "The resource ID of the custom authentication extension to invoke when the event occurs",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: stable.ValidateIdentityCustomAuthenticationExtensionID,
+			},
+
+			"application_ids": {
+				Description: "The client IDs of the applications for which the listener is invoked",
+				Type:        pluginsdk.TypeSet,
+				Required:    true,
+				ForceNew:    true,
+				MinItems:    1,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.IsUUID,
+				},
+			},
+		},
+	}
+}
+
+func authenticationEventListenerResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).AuthenticationEvents.AuthenticationEventListenerClient
+
+	extensionId, err := stable.ParseIdentityCustomAuthenticationExtensionID(d.Get("custom_authentication_extension_id").(string))
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "custom_authentication_extension_id", "Parsing custom authentication extension ID")
+	}
+
+	includeApplications := make([]stable.AuthenticationConditionApplication, 0)
+	for _, v := range d.Get("application_ids").(*pluginsdk.Set).List() {
+		includeApplications = append(includeApplications, stable.AuthenticationConditionApplication{
+			AppId: pointer.To(v.(string)),
+		})
+	}
+
+	conditions := &stable.AuthenticationConditions{
+		Applications: &stable.AuthenticationConditionsApplications{
+			IncludeApplications: &includeApplications,
+		},
+	}
+
+	properties := expandAuthenticationEventListener(d.Get("event_type").(string), *extensionId, conditions)
+
+	resp, err := client.CreateAuthenticationEventListener(ctx, properties, authenticationeventlistener.DefaultCreateAuthenticationEventListenerOperationOptions())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Creating authentication event listener")
+	}
+
+	if resp.Model == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Creating authentication event listener")
+	}
+
+	listenerId := resp.Model.AuthenticationEventListener().Id
+	if listenerId == nil || *listenerId == "" {
+		return tf.ErrorDiagF(errors.New("API returned authentication event listener with nil ID"), "Bad API Response")
+	}
+
+	id := stable.NewIdentityAuthenticationEventListenerID(*listenerId)
+	d.SetId(id.ID())
+
+	// Now ensure we can retrieve the authentication event listener consistently
+	if err = consistency.WaitForUpdate(ctx, func(ctx context.Context) (*bool, error) {
+		resp, err := client.GetAuthenticationEventListener(ctx, id, authenticationeventlistener.DefaultGetAuthenticationEventListenerOperationOptions())
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return pointer.To(false), err
+		}
+		return pointer.To(resp.Model != nil), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for creation of %s", id)
+	}
+
+	return authenticationEventListenerResourceRead(ctx, d, meta)
+}
+
+func authenticationEventListenerResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).AuthenticationEvents.AuthenticationEventListenerClient
+
+	id, err := stable.ParseIdentityAuthenticationEventListenerID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing ID")
+	}
+
+	extensionId, err := stable.ParseIdentityCustomAuthenticationExtensionID(d.Get("custom_authentication_extension_id").(string))
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "custom_authentication_extension_id", "Parsing custom authentication extension ID")
+	}
+
+	// Included applications cannot be changed by updating the listener, so conditions are omitted
+	properties := expandAuthenticationEventListener(d.Get("event_type").(string), *extensionId, nil)
+
+	if _, err = client.UpdateAuthenticationEventListener(ctx, *id, properties, authenticationeventlistener.DefaultUpdateAuthenticationEventListenerOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Updating %s", id)
+	}
+
+	return authenticationEventListenerResourceRead(ctx, d, meta)
+}
+
+func authenticationEventListenerResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).AuthenticationEvents.AuthenticationEventListenerClient
+
+	id, err := stable.ParseIdentityAuthenticationEventListenerID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing ID")
+	}
+
+	// The event type is needed to expand the custom extension of the handler, so when importing the listener must first
+	// be retrieved without it
+	eventType := d.Get("event_type").(string)
+	if eventType == "" {
+		resp, err := client.GetAuthenticationEventListener(ctx, *id, authenticationeventlistener.DefaultGetAuthenticationEventListenerOperationOptions())
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				log.Printf("[DEBUG] %s was not found - removing from state!", id)
+				d.SetId("")
+				return nil
+			}
+			return tf.ErrorDiagF(err, "Retrieving %s", id)
+		}
+		if resp.Model == nil {
+			return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", id)
+		}
+		eventType = eventTypeForListener(resp.Model)
+	}
+
+	options := authenticationeventlistener.GetAuthenticationEventListenerOperationOptions{
+		Expand: expandForEventType(eventType),
+	}
+
+	resp, err := client.GetAuthenticationEventListener(ctx, *id, options)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", id)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving %s", id)
+	}
+
+	if resp.Model == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", id)
+	}
+
+	var customExtension stable.CustomAuthenticationExtension
+
+	switch listener := resp.Model.(type) {
+	case stable.OnAttributeCollectionStartListener:
+		tf.Set(d, "event_type", eventTypeOnAttributeCollectionStart)
+		if handler, ok := listener.Handler.(stable.OnAttributeCollectionStartCustomExtensionHandler); ok && handler.CustomExtension != nil {
+			customExtension = *handler.CustomExtension
+		}
+	case stable.OnAttributeCollectionSubmitListener:
+		tf.Set(d, "event_type", eventTypeOnAttributeCollectionSubmit)
+		if handler, ok := listener.Handler.(stable.OnAttributeCollectionSubmitCustomExtensionHandler); ok && handler.CustomExtension != nil {
+			customExtension = *handler.CustomExtension
+		}
+	case stable.OnTokenIssuanceStartListener:
+		tf.Set(d, "event_type", eventTypeOnTokenIssuanceStart)
+		if handler, ok := listener.Handler.(stable.OnTokenIssuanceStartCustomExtensionHandler); ok && handler.CustomExtension != nil {
+			customExtension = *handler.CustomExtension
+		}
+	default:
+		return tf.ErrorDiagF(fmt.Errorf("unexpected authentication event listener type %q", pointer.From(resp.Model.AuthenticationEventListener().ODataType)), "Retrieving %s", id)
+	}
+
+	customExtensionId := ""
+	if customExtension != nil && customExtension.CustomAuthenticationExtension().Id != nil {
+		customExtensionId = stable.NewIdentityCustomAuthenticationExtensionID(*customExtension.CustomAuthenticationExtension().Id).ID()
+	}
+	tf.Set(d, "custom_authentication_extension_id", customExtensionId)
+
+	applicationIds := make([]string, 0)
+	if conditions := resp.Model.AuthenticationEventListener().Conditions; conditions != nil && conditions.Applications != nil {
+		for _, application := range pointer.From(conditions.Applications.IncludeApplications) {
+			if application.AppId != nil {
+				applicationIds = append(applicationIds, *application.AppId)
+			}
+		}
+	}
+	tf.Set(d, "application_ids", applicationIds)
+
+	return nil
+}
+
+func authenticationEventListenerResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).AuthenticationEvents.AuthenticationEventListenerClient
+
+	id, err := stable.ParseIdentityAuthenticationEventListenerID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing ID")
+	}
+
+	if _, err := client.DeleteAuthenticationEventListener(ctx, *id, authenticationeventlistener.DefaultDeleteAuthenticationEventListenerOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Deleting %s", id)
+	}
+
+	if err := consistency.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+		if resp, err := client.GetAuthenticationEventListener(ctx, *id, authenticationeventlistener.DefaultGetAuthenticationEventListenerOperationOptions()); err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+		return pointer.To(true), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for deletion of %s", id)
+	}
+
+	return nil
+}
+
+func expandAuthenticationEventListener(eventType string, extensionId stable.IdentityCustomAuthenticationExtensionId, conditions *stable.AuthenticationConditions) stable.AuthenticationEventListener {
+	handler := customExtensionHandler{
+		CustomExtensionId: extensionId.CustomAuthenticationExtensionId,
+	}
+
+	switch eventType {
+	case eventTypeOnAttributeCollectionStart:
+		handler.ODataType = "#microsoft.graph.onAttributeCollectionStartCustomExtensionHandler"
+		return stable.OnAttributeCollectionStartListener{
+			Conditions: conditions,
+			Handler:    handler,
+		}
+
+	case eventTypeOnAttributeCollectionSubmit:
+		handler.ODataType = "#microsoft.graph.onAttributeCollectionSubmitCustomExtensionHandler"
+		return stable.OnAttributeCollectionSubmitListener{
+			Conditions: conditions,
+			Handler:    handler,
+		}
+	}
+
+	handler.ODataType = "#microsoft.graph.onTokenIssuanceStartCustomExtensionHandler"
+	return stable.OnTokenIssuanceStartListener{
+		Conditions: conditions,
+		Handler:    handler,
+	}
+}
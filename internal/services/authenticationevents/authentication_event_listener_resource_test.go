@@ -0,0 +1,130 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package authenticationevents_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identity/stable/authenticationeventlistener"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+)
+
+type AuthenticationEventListenerResource struct{}
+
+func TestAccAuthenticationEventListener_tokenIssuanceStart(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_authentication_event_listener", "test")
+	r := AuthenticationEventListenerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "onTokenIssuanceStart", "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("application_ids.#").HasValue("1"),
+				check.That(data.ResourceName).Key("custom_authentication_extension_id").MatchesOtherKey(check.That("azuread_custom_authentication_extension.first").Key("id")),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, "onTokenIssuanceStart", "second"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("custom_authentication_extension_id").MatchesOtherKey(check.That("azuread_custom_authentication_extension.second").Key("id")),
+			),
+		},
+		data.ImportStep(),
+		{
+			// Ensure the custom authentication extension is read back after importing
+			Config: r.basic(data, "onTokenIssuanceStart", "second"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("custom_authentication_extension_id").MatchesOtherKey(check.That("azuread_custom_authentication_extension.second").Key("id")),
+			),
+		},
+	})
+}
+
+func TestAccAuthenticationEventListener_attributeCollectionSubmit(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_authentication_event_listener", "test")
+	r := AuthenticationEventListenerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "onAttributeCollectionSubmit", "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("custom_authentication_extension_id").MatchesOtherKey(check.That("azuread_custom_authentication_extension.first").Key("id")),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r AuthenticationEventListenerResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.AuthenticationEvents.AuthenticationEventListenerClient
+
+	id, err := stable.ParseIdentityAuthenticationEventListenerID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetAuthenticationEventListener(ctx, *id, authenticationeventlistener.DefaultGetAuthenticationEventListenerOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+
+		return nil, fmt.Errorf("retrieving %s: %v", id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (AuthenticationEventListenerResource) template(data acceptance.TestData, eventType string) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_application" "api" {
+  display_name    = "acctest-AuthEventListenerApi-%[1]d"
+  identifier_uris = ["api://acctest-autheventlistener-%[1]d.example.com"]
+}
+
+resource "azuread_application" "test" {
+  display_name = "acctest-AuthEventListener-%[1]d"
+}
+
+resource "azuread_custom_authentication_extension" "first" {
+  display_name               = "acctest-AuthEventListener-first-%[1]d"
+  event_type                 = "%[2]s"
+  target_url                 = "https://acctest-autheventlistener-%[1]d.example.com/api/first"
+  authentication_resource_id = tolist(azuread_application.api.identifier_uris)[0]
+}
+
+resource "azuread_custom_authentication_extension" "second" {
+  display_name               = "acctest-AuthEventListener-second-%[1]d"
+  event_type                 = "%[2]s"
+  target_url                 = "https://acctest-autheventlistener-%[1]d.example.com/api/second"
+  authentication_resource_id = tolist(azuread_application.api.identifier_uris)[0]
+}
+`, data.RandomInteger, eventType)
+}
+
+func (r AuthenticationEventListenerResource) basic(data acceptance.TestData, eventType, extension string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_authentication_event_listener" "test" {
+  event_type                         = "%[2]s"
+  custom_authentication_extension_id = azuread_custom_authentication_extension.%[3]s.id
+  application_ids                    = [azuread_application.test.client_id]
+}
+`, r.template(data, eventType), eventType, extension)
+}
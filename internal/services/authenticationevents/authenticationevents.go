@@ -0,0 +1,98 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package authenticationevents
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+const (
+	eventTypeOnAttributeCollectionStart  = "onAttributeCollectionStart"
+	eventTypeOnAttributeCollectionSubmit = "onAttributeCollectionSubmit"
+	eventTypeOnTokenIssuanceStart        = "onTokenIssuanceStart"
+)
+
+func possibleValuesForEventType() []string {
+	return []string{
+		eventTypeOnAttributeCollectionStart,
+		eventTypeOnAttributeCollectionSubmit,
+		eventTypeOnTokenIssuanceStart,
+	}
+}
+
+// expandForEventType returns the $expand query needed to retrieve the custom authentication extension referenced by the
+// handler of an authentication event listener, which is a navigation property that is not otherwise returned
+func expandForEventType(eventType string) *odata.Expand {
+	var listenerType, handlerType string
+	switch eventType {
+	case eventTypeOnAttributeCollectionStart:
+		listenerType, handlerType = "onAttributeCollectionStartListener", "onAttributeCollectionStartCustomExtensionHandler"
+	case eventTypeOnAttributeCollectionSubmit:
+		listenerType, handlerType = "onAttributeCollectionSubmitListener", "onAttributeCollectionSubmitCustomExtensionHandler"
+	case eventTypeOnTokenIssuanceStart:
+		listenerType, handlerType = "onTokenIssuanceStartListener", "onTokenIssuanceStartCustomExtensionHandler"
+	default:
+		return nil
+	}
+
+	return &odata.Expand{
+		Relationship: fmt.Sprintf("microsoft.graph.%s/handler/microsoft.graph.%s/customExtension", listenerType, handlerType),
+	}
+}
+
+// eventTypeForListener returns the event type for an authentication event listener, or an empty string when the type of
+// listener is not supported
+func eventTypeForListener(listener stable.AuthenticationEventListener) string {
+	switch listener.(type) {
+	case stable.OnAttributeCollectionStartListener:
+		return eventTypeOnAttributeCollectionStart
+	case stable.OnAttributeCollectionSubmitListener:
+		return eventTypeOnAttributeCollectionSubmit
+	case stable.OnTokenIssuanceStartListener:
+		return eventTypeOnTokenIssuanceStart
+	}
+	return ""
+}
+
+// customExtensionHandler is a handler for an authentication event listener, which references an existing custom
+// authentication extension by ID. The SDK models embed the entire custom extension, which cannot be sent when
+// referencing an existing extension.
+type customExtensionHandler struct {
+	ODataType         string
+	CustomExtensionId string
+}
+
+var (
+	_ stable.OnAttributeCollectionStartHandler  = customExtensionHandler{}
+	_ stable.OnAttributeCollectionSubmitHandler = customExtensionHandler{}
+	_ stable.OnTokenIssuanceStartHandler        = customExtensionHandler{}
+)
+
+func (s customExtensionHandler) OnAttributeCollectionStartHandler() stable.BaseOnAttributeCollectionStartHandlerImpl {
+	return stable.BaseOnAttributeCollectionStartHandlerImpl{ODataType: pointer.To(s.ODataType)}
+}
+
+func (s customExtensionHandler) OnAttributeCollectionSubmitHandler() stable.BaseOnAttributeCollectionSubmitHandlerImpl {
+	return stable.BaseOnAttributeCollectionSubmitHandlerImpl{ODataType: pointer.To(s.ODataType)}
+}
+
+func (s customExtensionHandler) OnTokenIssuanceStartHandler() stable.BaseOnTokenIssuanceStartHandlerImpl {
+	return stable.BaseOnTokenIssuanceStartHandlerImpl{ODataType: pointer.To(s.ODataType)}
+}
+
+var _ json.Marshaler = customExtensionHandler{}
+
+func (s customExtensionHandler) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]interface{}{
+		"@odata.type": s.ODataType,
+		"customExtension": map[string]interface{}{
+			"id": s.CustomExtensionId,
+		},
+	})
+}
@@ -0,0 +1,34 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identity/stable/authenticationeventlistener"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identity/stable/customauthenticationextension"
+	"github.com/hashicorp/terraform-provider-azuread/internal/common"
+)
+
+type Client struct {
+	AuthenticationEventListenerClient   *authenticationeventlistener.AuthenticationEventListenerClient
+	CustomAuthenticationExtensionClient *customauthenticationextension.CustomAuthenticationExtensionClient
+}
+
+func NewClient(o *common.ClientOptions) (*Client, error) {
+	authenticationEventListenerClient, err := authenticationeventlistener.NewAuthenticationEventListenerClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(authenticationEventListenerClient.Client)
+
+	customAuthenticationExtensionClient, err := customauthenticationextension.NewCustomAuthenticationExtensionClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(customAuthenticationExtensionClient.Client)
+
+	return &Client{
+		AuthenticationEventListenerClient:   authenticationEventListenerClient,
+		CustomAuthenticationExtensionClient: customAuthenticationExtensionClient,
+	}, nil
+}
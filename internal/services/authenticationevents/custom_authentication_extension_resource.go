@@ -0,0 +1,335 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package authenticationevents
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identity/stable/customauthenticationextension"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/consistency"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+)
+
+func customAuthenticationExtensionResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: customAuthenticationExtensionResourceCreate,
+		ReadContext:   customAuthenticationExtensionResourceRead,
+		UpdateContext: customAuthenticationExtensionResourceUpdate,
+		DeleteContext: customAuthenticationExtensionResourceDelete,
+
+		CustomizeDiff: customAuthenticationExtensionResourceCustomizeDiff,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			if _, errs := stable.ValidateIdentityCustomAuthenticationExtensionID(id, "id"); len(errs) > 0 {
+				out := ""
+				for _, err := range errs {
+					out += err.Error()
+				}
+				return errors.New(out)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"display_name": {
+				Description:  "The display name of the custom authentication extension",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+
+			"description": {
+				Description: "The description of the custom authentication extension",
+				Type:        pluginsdk.TypeString,
+				Optional:    true,
+			},
+
+			"event_type": {
+				Description:  "The authentication event which the custom authentication extension handles",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(possibleValuesForEventType(), false),
+			},
+
+			"target_url": {
+				Description:  "The URL of the API endpoint to call",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsHttpsUrl,
+			},
+
+			"authentication_resource_id": {
+				Description:  "The application ID URI or client ID of the application registration which protects the API endpoint, used as the resource when acquiring tokens for calling the API",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+
+			"timeout_in_milliseconds": {
+				Description:  "The maximum time to wait for a response from the API endpoint, in milliseconds",
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(200, 2000),
+			},
+
+			"maximum_retries": {
+				Description:  "The maximum number of retries when calling the API endpoint",
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 1),
+			},
+
+			"claims_for_token": {
+				Description: "The names of the claims returned by the API endpoint, which can be included in tokens. Only supported when `event_type` is `onTokenIssuanceStart`",
+				Type:        pluginsdk.TypeList,
+				Optional:    true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+			},
+		},
+	}
+}
+
+func customAuthenticationExtensionResourceCustomizeDiff(_ context.Context, diff *pluginsdk.ResourceDiff, _ interface{}) error {
+	if diff.Get("event_type").(string) != eventTypeOnTokenIssuanceStart && len(diff.Get("claims_for_token").([]interface{})) > 0 {
+		return fmt.Errorf("`claims_for_token` can only be specified when `event_type` is %q", eventTypeOnTokenIssuanceStart)
+	}
+
+	return nil
+}
+
+func customAuthenticationExtensionResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).AuthenticationEvents.CustomAuthenticationExtensionClient
+
+	properties := expandCustomAuthenticationExtension(d)
+
+	resp, err := client.CreateCustomAuthenticationExtension(ctx, properties, customauthenticationextension.DefaultCreateCustomAuthenticationExtensionOperationOptions())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Creating custom authentication extension")
+	}
+
+	if resp.Model == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Creating custom authentication extension")
+	}
+
+	extensionId := resp.Model.CustomAuthenticationExtension().Id
+	if extensionId == nil || *extensionId == "" {
+		return tf.ErrorDiagF(errors.New("API returned custom authentication extension with nil ID"), "Bad API Response")
+	}
+
+	id := stable.NewIdentityCustomAuthenticationExtensionID(*extensionId)
+	d.SetId(id.ID())
+
+	// Now ensure we can retrieve the custom authentication extension consistently
+	if err = consistency.WaitForUpdate(ctx, func(ctx context.Context) (*bool, error) {
+		resp, err := client.GetCustomAuthenticationExtension(ctx, id, customauthenticationextension.DefaultGetCustomAuthenticationExtensionOperationOptions())
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return pointer.To(false), err
+		}
+		return pointer.To(resp.Model != nil), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for creation of %s", id)
+	}
+
+	return customAuthenticationExtensionResourceRead(ctx, d, meta)
+}
+
+func customAuthenticationExtensionResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).AuthenticationEvents.CustomAuthenticationExtensionClient
+
+	id, err := stable.ParseIdentityCustomAuthenticationExtensionID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing ID")
+	}
+
+	properties := expandCustomAuthenticationExtension(d)
+
+	if _, err = client.UpdateCustomAuthenticationExtension(ctx, *id, properties, customauthenticationextension.DefaultUpdateCustomAuthenticationExtensionOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Updating %s", id)
+	}
+
+	return customAuthenticationExtensionResourceRead(ctx, d, meta)
+}
+
+func customAuthenticationExtensionResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).AuthenticationEvents.CustomAuthenticationExtensionClient
+
+	id, err := stable.ParseIdentityCustomAuthenticationExtensionID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing ID")
+	}
+
+	resp, err := client.GetCustomAuthenticationExtension(ctx, *id, customauthenticationextension.DefaultGetCustomAuthenticationExtensionOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", id)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving %s", id)
+	}
+
+	if resp.Model == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", id)
+	}
+
+	claimsForToken := make([]string, 0)
+
+	switch extension := resp.Model.(type) {
+	case stable.OnAttributeCollectionStartCustomExtension:
+		tf.Set(d, "event_type", eventTypeOnAttributeCollectionStart)
+	case stable.OnAttributeCollectionSubmitCustomExtension:
+		tf.Set(d, "event_type", eventTypeOnAttributeCollectionSubmit)
+	case stable.OnTokenIssuanceStartCustomExtension:
+		tf.Set(d, "event_type", eventTypeOnTokenIssuanceStart)
+		for _, claim := range pointer.From(extension.ClaimsForTokenConfiguration) {
+			claimsForToken = append(claimsForToken, claim.ClaimIdInApiResponse.GetOrZero())
+		}
+	default:
+		return tf.ErrorDiagF(fmt.Errorf("unexpected custom authentication extension type %q", pointer.From(resp.Model.CustomAuthenticationExtension().ODataType)), "Retrieving %s", id)
+	}
+
+	extension := resp.Model.CustomAuthenticationExtension()
+
+	tf.Set(d, "claims_for_token", claimsForToken)
+	tf.Set(d, "description", extension.Description.GetOrZero())
+	tf.Set(d, "display_name", extension.DisplayName.GetOrZero())
+
+	authenticationResourceId := ""
+	if authentication, ok := extension.AuthenticationConfiguration.(stable.AzureAdTokenAuthentication); ok {
+		authenticationResourceId = authentication.ResourceId.GetOrZero()
+	}
+	tf.Set(d, "authentication_resource_id", authenticationResourceId)
+
+	targetUrl := ""
+	if endpoint, ok := extension.EndpointConfiguration.(stable.HttpRequestEndpoint); ok {
+		targetUrl = endpoint.TargetUrl.GetOrZero()
+	}
+	tf.Set(d, "target_url", targetUrl)
+
+	maximumRetries, timeoutInMilliseconds := int64(0), int64(0)
+	if extension.ClientConfiguration != nil {
+		maximumRetries = extension.ClientConfiguration.MaximumRetries.GetOrZero()
+		timeoutInMilliseconds = extension.ClientConfiguration.TimeoutInMilliseconds.GetOrZero()
+	}
+	tf.Set(d, "maximum_retries", maximumRetries)
+	tf.Set(d, "timeout_in_milliseconds", timeoutInMilliseconds)
+
+	return nil
+}
+
+func customAuthenticationExtensionResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).AuthenticationEvents.CustomAuthenticationExtensionClient
+
+	id, err := stable.ParseIdentityCustomAuthenticationExtensionID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing ID")
+	}
+
+	if _, err := client.DeleteCustomAuthenticationExtension(ctx, *id, customauthenticationextension.DefaultDeleteCustomAuthenticationExtensionOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Deleting %s", id)
+	}
+
+	if err := consistency.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+		if resp, err := client.GetCustomAuthenticationExtension(ctx, *id, customauthenticationextension.DefaultGetCustomAuthenticationExtensionOperationOptions()); err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+		return pointer.To(true), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for deletion of %s", id)
+	}
+
+	return nil
+}
+
+func expandCustomAuthenticationExtension(d *pluginsdk.ResourceData) stable.CustomAuthenticationExtension {
+	authenticationConfiguration := stable.AzureAdTokenAuthentication{
+		ResourceId: nullable.Value(d.Get("authentication_resource_id").(string)),
+	}
+
+	endpointConfiguration := stable.HttpRequestEndpoint{
+		TargetUrl: nullable.Value(d.Get("target_url").(string)),
+	}
+
+	var clientConfiguration *stable.CustomExtensionClientConfiguration
+	if v, ok := d.GetOk("timeout_in_milliseconds"); ok {
+		clientConfiguration = &stable.CustomExtensionClientConfiguration{
+			TimeoutInMilliseconds: nullable.Value(int64(v.(int))),
+		}
+	}
+	if v, ok := d.GetOkExists("maximum_retries"); ok { //nolint:staticcheck // needed to detect an explicit value of 0
+		if clientConfiguration == nil {
+			clientConfiguration = &stable.CustomExtensionClientConfiguration{}
+		}
+		clientConfiguration.MaximumRetries = nullable.Value(int64(v.(int)))
+	}
+
+	description := nullable.NoZero(d.Get("description").(string))
+	displayName := nullable.Value(d.Get("display_name").(string))
+
+	switch d.Get("event_type").(string) {
+	case eventTypeOnAttributeCollectionStart:
+		return stable.OnAttributeCollectionStartCustomExtension{
+			AuthenticationConfiguration: authenticationConfiguration,
+			ClientConfiguration:         clientConfiguration,
+			Description:                 description,
+			DisplayName:                 displayName,
+			EndpointConfiguration:       endpointConfiguration,
+		}
+
+	case eventTypeOnAttributeCollectionSubmit:
+		return stable.OnAttributeCollectionSubmitCustomExtension{
+			AuthenticationConfiguration: authenticationConfiguration,
+			ClientConfiguration:         clientConfiguration,
+			Description:                 description,
+			DisplayName:                 displayName,
+			EndpointConfiguration:       endpointConfiguration,
+		}
+	}
+
+	claimsForToken := make([]stable.OnTokenIssuanceStartReturnClaim, 0)
+	for _, claim := range d.Get("claims_for_token").([]interface{}) {
+		claimsForToken = append(claimsForToken, stable.OnTokenIssuanceStartReturnClaim{
+			ClaimIdInApiResponse: nullable.Value(claim.(string)),
+		})
+	}
+
+	return stable.OnTokenIssuanceStartCustomExtension{
+		AuthenticationConfiguration: authenticationConfiguration,
+		ClaimsForTokenConfiguration: &claimsForToken,
+		ClientConfiguration:         clientConfiguration,
+		Description:                 description,
+		DisplayName:                 displayName,
+		EndpointConfiguration:       endpointConfiguration,
+	}
+}
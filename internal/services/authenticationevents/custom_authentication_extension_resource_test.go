@@ -0,0 +1,196 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package authenticationevents_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identity/stable/customauthenticationextension"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+)
+
+type CustomAuthenticationExtensionResource struct{}
+
+func TestAccCustomAuthenticationExtension_tokenIssuanceStart(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_custom_authentication_extension", "test")
+	r := CustomAuthenticationExtensionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.tokenIssuanceStart(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("claims_for_token.#").HasValue("2"),
+				check.That(data.ResourceName).Key("event_type").HasValue("onTokenIssuanceStart"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccCustomAuthenticationExtension_attributeCollection(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_custom_authentication_extension", "test")
+	r := CustomAuthenticationExtensionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.attributeCollection(data, "onAttributeCollectionStart"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("event_type").HasValue("onAttributeCollectionStart"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.attributeCollection(data, "onAttributeCollectionSubmit"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("event_type").HasValue("onAttributeCollectionSubmit"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccCustomAuthenticationExtension_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_custom_authentication_extension", "test")
+	r := CustomAuthenticationExtensionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.tokenIssuanceStart(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.tokenIssuanceStartUpdate(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("claims_for_token.#").HasValue("3"),
+				check.That(data.ResourceName).Key("maximum_retries").HasValue("0"),
+				check.That(data.ResourceName).Key("timeout_in_milliseconds").HasValue("1500"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.tokenIssuanceStart(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccCustomAuthenticationExtension_claimsNotSupported(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_custom_authentication_extension", "test")
+	r := CustomAuthenticationExtensionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.claimsNotSupported(data),
+			ExpectError: regexp.MustCompile("`claims_for_token` can only be specified"),
+		},
+	})
+}
+
+func (r CustomAuthenticationExtensionResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.AuthenticationEvents.CustomAuthenticationExtensionClient
+
+	id, err := stable.ParseIdentityCustomAuthenticationExtensionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetCustomAuthenticationExtension(ctx, *id, customauthenticationextension.DefaultGetCustomAuthenticationExtensionOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+
+		return nil, fmt.Errorf("retrieving %s: %v", id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (CustomAuthenticationExtensionResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_application" "api" {
+  display_name    = "acctest-CustomAuthExt-%[1]d"
+  identifier_uris = ["api://acctest-customauthext-%[1]d.example.com"]
+}
+`, data.RandomInteger)
+}
+
+func (r CustomAuthenticationExtensionResource) tokenIssuanceStart(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_custom_authentication_extension" "test" {
+  display_name               = "acctest-CustomAuthExt-%[2]d"
+  event_type                 = "onTokenIssuanceStart"
+  target_url                 = "https://acctest-customauthext-%[2]d.example.com/api/claims"
+  authentication_resource_id = tolist(azuread_application.api.identifier_uris)[0]
+  claims_for_token           = ["DateOfBirth", "CustomRoles"]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r CustomAuthenticationExtensionResource) tokenIssuanceStartUpdate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_custom_authentication_extension" "test" {
+  display_name               = "acctest-CustomAuthExt-updated-%[2]d"
+  description                = "Enriches tokens with claims from an external API"
+  event_type                 = "onTokenIssuanceStart"
+  target_url                 = "https://acctest-customauthext-%[2]d.example.com/api/v2/claims"
+  authentication_resource_id = tolist(azuread_application.api.identifier_uris)[0]
+  timeout_in_milliseconds    = 1500
+  maximum_retries            = 0
+  claims_for_token           = ["DateOfBirth", "CustomRoles", "ApiVersion"]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r CustomAuthenticationExtensionResource) attributeCollection(data acceptance.TestData, eventType string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_custom_authentication_extension" "test" {
+  display_name               = "acctest-CustomAuthExt-%[2]d"
+  event_type                 = "%[3]s"
+  target_url                 = "https://acctest-customauthext-%[2]d.example.com/api/attributes"
+  authentication_resource_id = tolist(azuread_application.api.identifier_uris)[0]
+}
+`, r.template(data), data.RandomInteger, eventType)
+}
+
+func (r CustomAuthenticationExtensionResource) claimsNotSupported(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_custom_authentication_extension" "test" {
+  display_name               = "acctest-CustomAuthExt-%[1]d"
+  event_type                 = "onAttributeCollectionSubmit"
+  target_url                 = "https://acctest-customauthext-%[1]d.example.com/api/attributes"
+  authentication_resource_id = "api://acctest-customauthext-%[1]d.example.com"
+  claims_for_token           = ["DateOfBirth"]
+}
+`, data.RandomInteger)
+}
@@ -5,11 +5,15 @@ package client
 
 import (
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/domains/stable/domain"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/domains/stable/federationconfiguration"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/domains/stable/verificationdnsrecord"
 	"github.com/hashicorp/terraform-provider-azuread/internal/common"
 )
 
 type Client struct {
-	DomainClient *domain.DomainClient
+	DomainClient                  *domain.DomainClient
+	FederationConfigurationClient *federationconfiguration.FederationConfigurationClient
+	VerificationDnsRecordClient   *verificationdnsrecord.VerificationDnsRecordClient
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -19,7 +23,21 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(domainClient.Client)
 
+	federationConfigurationClient, err := federationconfiguration.NewFederationConfigurationClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(federationConfigurationClient.Client)
+
+	verificationDnsRecordClient, err := verificationdnsrecord.NewVerificationDnsRecordClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(verificationDnsRecordClient.Client)
+
 	return &Client{
-		DomainClient: domainClient,
+		DomainClient:                  domainClient,
+		FederationConfigurationClient: federationConfigurationClient,
+		VerificationDnsRecordClient:   verificationDnsRecordClient,
 	}, nil
 }
@@ -0,0 +1,35 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package domains
+
+const (
+	DomainSupportedServiceEmail                      = "Email"
+	DomainSupportedServiceEmailInternalRelayOnly     = "EmailInternalRelayOnly"
+	DomainSupportedServiceFullRedelegation           = "FullRedelegation"
+	DomainSupportedServiceIntune                     = "Intune"
+	DomainSupportedServiceOfficeCommunicationsOnline = "OfficeCommunicationsOnline"
+	DomainSupportedServiceOrgIdAuthentication        = "OrgIdAuthentication"
+	DomainSupportedServiceSharepoint                 = "Sharepoint"
+	DomainSupportedServiceSharePointDefaultDomain    = "SharePointDefaultDomain"
+	DomainSupportedServiceSharePointPublic           = "SharePointPublic"
+	DomainSupportedServiceYammer                     = "Yammer"
+)
+
+var possibleValuesForDomainSupportedService = []string{
+	DomainSupportedServiceEmail,
+	DomainSupportedServiceEmailInternalRelayOnly,
+	DomainSupportedServiceFullRedelegation,
+	DomainSupportedServiceIntune,
+	DomainSupportedServiceOfficeCommunicationsOnline,
+	DomainSupportedServiceOrgIdAuthentication,
+	DomainSupportedServiceSharepoint,
+	DomainSupportedServiceSharePointDefaultDomain,
+	DomainSupportedServiceSharePointPublic,
+	DomainSupportedServiceYammer,
+}
+
+const (
+	// domainPasswordNeverExpires is the password validity period which indicates that passwords never expire
+	domainPasswordNeverExpires = 2147483647
+)
@@ -0,0 +1,392 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package domains
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/domains/stable/federationconfiguration"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/consistency"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/credentials"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/hashicorp/terraform-provider-azuread/internal/sdk"
+)
+
+type DomainFederationConfigurationResourceModel struct {
+	ActiveSignInUri                     string `tfschema:"active_sign_in_uri"`
+	DisplayName                         string `tfschema:"display_name"`
+	DomainId                            string `tfschema:"domain_id"`
+	FederatedIdpMfaBehavior             string `tfschema:"federated_idp_mfa_behavior"`
+	IssuerUri                           string `tfschema:"issuer_uri"`
+	MetadataExchangeUri                 string `tfschema:"metadata_exchange_uri"`
+	NextSigningCertificate              string `tfschema:"next_signing_certificate"`
+	PassiveSignInUri                    string `tfschema:"passive_sign_in_uri"`
+	PasswordResetUri                    string `tfschema:"password_reset_uri"`
+	PreferredAuthenticationProtocol     string `tfschema:"preferred_authentication_protocol"`
+	PromptLoginBehavior                 string `tfschema:"prompt_login_behavior"`
+	SignOutUri                          string `tfschema:"sign_out_uri"`
+	SignedAuthenticationRequestRequired bool   `tfschema:"signed_authentication_request_required"`
+	SigningCertificate                  string `tfschema:"signing_certificate"`
+	SigningCertificateThumbprint        string `tfschema:"signing_certificate_thumbprint"`
+}
+
+var _ sdk.ResourceWithUpdate = DomainFederationConfigurationResource{}
+
+type DomainFederationConfigurationResource struct{}
+
+func (r DomainFederationConfigurationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return stable.ValidateDomainIdFederationConfigurationID
+}
+
+func (r DomainFederationConfigurationResource) ResourceType() string {
+	return "azuread_domain_federation_configuration"
+}
+
+func (r DomainFederationConfigurationResource) ModelObject() interface{} {
+	return &DomainFederationConfigurationResourceModel{}
+}
+
+func (r DomainFederationConfigurationResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"domain_id": {
+			Description:  "The resource ID of the domain to federate",
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: stable.ValidateDomainID,
+		},
+
+		"display_name": {
+			Description:  "The display name of the federated identity provider",
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotWhiteSpace,
+		},
+
+		"issuer_uri": {
+			Description:  "The issuer URI of the federation server",
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotWhiteSpace,
+		},
+
+		"passive_sign_in_uri": {
+			Description:  "The URI that web-based clients are directed to when signing in to Microsoft Entra services",
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsURLWithHTTPS,
+		},
+
+		"signing_certificate": {
+			Description:      "The current certificate used to sign tokens passed to the Microsoft identity platform, either PEM-encoded or as base64-encoded DER",
+			Type:             pluginsdk.TypeString,
+			Required:         true,
+			ValidateFunc:     credentials.ValidateCertificate,
+			DiffSuppressFunc: credentials.CertificateDiffSuppress,
+		},
+
+		"active_sign_in_uri": {
+			Description:  "The URI used by active clients when authenticating with the federation server, such as the WS-Trust endpoint",
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithHTTPS,
+		},
+
+		"federated_idp_mfa_behavior": {
+			Description:  "Whether Microsoft Entra ID accepts MFA performed by the federated identity provider, or always performs MFA itself",
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice(stable.PossibleValuesForFederatedIdpMfaBehavior(), false),
+		},
+
+		"metadata_exchange_uri": {
+			Description:  "The URI of the metadata exchange endpoint used for authentication from rich client applications",
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithHTTPS,
+		},
+
+		"next_signing_certificate": {
+			Description:      "The certificate that will be used to sign tokens once the current signing certificate expires, either PEM-encoded or as base64-encoded DER",
+			Type:             pluginsdk.TypeString,
+			Optional:         true,
+			ValidateFunc:     credentials.ValidateCertificate,
+			DiffSuppressFunc: credentials.CertificateDiffSuppress,
+		},
+
+		"password_reset_uri": {
+			Description:  "The URI that users are directed to when resetting their password",
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithHTTPS,
+		},
+
+		"preferred_authentication_protocol": {
+			Description:  "The preferred authentication protocol",
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(stable.AuthenticationProtocol_WsFed),
+			ValidateFunc: validation.StringInSlice(stable.PossibleValuesForAuthenticationProtocol(), false),
+		},
+
+		"prompt_login_behavior": {
+			Description:  "How `prompt=login` requests are handled when sent to the federated identity provider",
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice(stable.PossibleValuesForPromptLoginBehavior(), false),
+		},
+
+		"sign_out_uri": {
+			Description:  "The URI that clients are redirected to when signing out of Microsoft Entra services",
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithHTTPS,
+		},
+
+		"signed_authentication_request_required": {
+			Description: "Whether authentication requests sent to the federated identity provider must be signed",
+			Type:        pluginsdk.TypeBool,
+			Optional:    true,
+		},
+	}
+}
+
+func (r DomainFederationConfigurationResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"signing_certificate_thumbprint": {
+			Description: "The SHA-1 thumbprint of the current signing certificate",
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+		},
+	}
+}
+
+func (r DomainFederationConfigurationResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 10 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Domains.FederationConfigurationClient
+
+			var model DomainFederationConfigurationResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			domainId, err := stable.ParseDomainID(model.DomainId)
+			if err != nil {
+				return err
+			}
+
+			// A domain can only have a single federation configuration
+			existing, err := client.ListFederationConfigurations(ctx, *domainId, federationconfiguration.DefaultListFederationConfigurationsOperationOptions())
+			if err != nil {
+				return fmt.Errorf("checking for presence of existing federation configuration for %s: %+v", domainId, err)
+			}
+			if existing.Model != nil {
+				for _, config := range *existing.Model {
+					if config.Id != nil {
+						return metadata.ResourceRequiresImport(r.ResourceType(), stable.NewDomainIdFederationConfigurationID(domainId.DomainId, *config.Id))
+					}
+				}
+			}
+
+			properties, err := expandDomainFederationConfiguration(model)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.CreateFederationConfiguration(ctx, *domainId, *properties, federationconfiguration.DefaultCreateFederationConfigurationOperationOptions())
+			if err != nil {
+				return fmt.Errorf("creating federation configuration for %s: %+v", domainId, err)
+			}
+
+			if resp.Model == nil || resp.Model.Id == nil {
+				return fmt.Errorf("creating federation configuration for %s: ID was nil", domainId)
+			}
+
+			id := stable.NewDomainIdFederationConfigurationID(domainId.DomainId, *resp.Model.Id)
+
+			// Wait for the federation configuration to be consistently available
+			if err = consistency.WaitForUpdate(ctx, func(ctx context.Context) (*bool, error) {
+				resp, err := client.GetFederationConfiguration(ctx, id, federationconfiguration.DefaultGetFederationConfigurationOperationOptions())
+				if err != nil {
+					if response.WasNotFound(resp.HttpResponse) {
+						return pointer.To(false), nil
+					}
+					return nil, err
+				}
+				return pointer.To(resp.Model != nil), nil
+			}); err != nil {
+				return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r DomainFederationConfigurationResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Domains.FederationConfigurationClient
+
+			id, err := stable.ParseDomainIdFederationConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.GetFederationConfiguration(ctx, *id, federationconfiguration.DefaultGetFederationConfigurationOperationOptions())
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			config := resp.Model
+			if config == nil {
+				return fmt.Errorf("retrieving %s: model was nil", id)
+			}
+
+			state := DomainFederationConfigurationResourceModel{
+				ActiveSignInUri:                     config.ActiveSignInUri.GetOrZero(),
+				DisplayName:                         config.DisplayName.GetOrZero(),
+				DomainId:                            stable.NewDomainID(id.DomainId).ID(),
+				FederatedIdpMfaBehavior:             string(pointer.From(config.FederatedIdpMfaBehavior)),
+				IssuerUri:                           config.IssuerUri.GetOrZero(),
+				MetadataExchangeUri:                 config.MetadataExchangeUri.GetOrZero(),
+				NextSigningCertificate:              config.NextSigningCertificate.GetOrZero(),
+				PassiveSignInUri:                    config.PassiveSignInUri.GetOrZero(),
+				PasswordResetUri:                    config.PasswordResetUri.GetOrZero(),
+				PreferredAuthenticationProtocol:     string(pointer.From(config.PreferredAuthenticationProtocol)),
+				PromptLoginBehavior:                 string(pointer.From(config.PromptLoginBehavior)),
+				SignOutUri:                          config.SignOutUri.GetOrZero(),
+				SignedAuthenticationRequestRequired: config.IsSignedAuthenticationRequestRequired.GetOrZero(),
+				SigningCertificate:                  config.SigningCertificate.GetOrZero(),
+			}
+
+			if state.SigningCertificate != "" {
+				if thumbprint, err := credentials.GetTokenSigningCertificateThumbprint([]byte(state.SigningCertificate)); err == nil {
+					state.SigningCertificateThumbprint = thumbprint
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r DomainFederationConfigurationResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 10 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Domains.FederationConfigurationClient
+
+			id, err := stable.ParseDomainIdFederationConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model DomainFederationConfigurationResourceModel
+			if err = metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			properties, err := expandDomainFederationConfiguration(model)
+			if err != nil {
+				return err
+			}
+
+			if _, err = client.UpdateFederationConfiguration(ctx, *id, *properties, federationconfiguration.DefaultUpdateFederationConfigurationOperationOptions()); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r DomainFederationConfigurationResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 10 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Domains.FederationConfigurationClient
+
+			id, err := stable.ParseDomainIdFederationConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if resp, err := client.DeleteFederationConfiguration(ctx, *id, federationconfiguration.DefaultDeleteFederationConfigurationOperationOptions()); err != nil {
+				if !response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("deleting %s: %+v", id, err)
+				}
+			}
+
+			// Wait for the federation configuration to be fully deleted
+			if err = consistency.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+				resp, err := client.GetFederationConfiguration(ctx, *id, federationconfiguration.DefaultGetFederationConfigurationOperationOptions())
+				if err != nil {
+					if response.WasNotFound(resp.HttpResponse) {
+						return pointer.To(false), nil
+					}
+					return nil, err
+				}
+				return pointer.To(true), nil
+			}); err != nil {
+				return fmt.Errorf("waiting for deletion of %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandDomainFederationConfiguration(model DomainFederationConfigurationResourceModel) (*stable.InternalDomainFederation, error) {
+	signingCertificate, err := credentials.NormalizeCertificate(model.SigningCertificate)
+	if err != nil {
+		return nil, fmt.Errorf("parsing `signing_certificate`: %+v", err)
+	}
+
+	var nextSigningCertificate string
+	if model.NextSigningCertificate != "" {
+		if nextSigningCertificate, err = credentials.NormalizeCertificate(model.NextSigningCertificate); err != nil {
+			return nil, fmt.Errorf("parsing `next_signing_certificate`: %+v", err)
+		}
+	}
+
+	properties := stable.InternalDomainFederation{
+		ActiveSignInUri:                       nullable.NoZero(model.ActiveSignInUri),
+		DisplayName:                           nullable.Value(model.DisplayName),
+		IsSignedAuthenticationRequestRequired: nullable.Value(model.SignedAuthenticationRequestRequired),
+		IssuerUri:                             nullable.Value(model.IssuerUri),
+		MetadataExchangeUri:                   nullable.NoZero(model.MetadataExchangeUri),
+		NextSigningCertificate:                nullable.NoZero(nextSigningCertificate),
+		PassiveSignInUri:                      nullable.Value(model.PassiveSignInUri),
+		PasswordResetUri:                      nullable.NoZero(model.PasswordResetUri),
+		PreferredAuthenticationProtocol:       pointer.To(stable.AuthenticationProtocol(model.PreferredAuthenticationProtocol)),
+		SignOutUri:                            nullable.NoZero(model.SignOutUri),
+		SigningCertificate:                    nullable.Value(signingCertificate),
+	}
+
+	if model.FederatedIdpMfaBehavior != "" {
+		properties.FederatedIdpMfaBehavior = pointer.To(stable.FederatedIdpMfaBehavior(model.FederatedIdpMfaBehavior))
+	}
+	if model.PromptLoginBehavior != "" {
+		properties.PromptLoginBehavior = pointer.To(stable.PromptLoginBehavior(model.PromptLoginBehavior))
+	}
+
+	return &properties, nil
+}
@@ -0,0 +1,203 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package domains_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/domains/stable/federationconfiguration"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+)
+
+// To generate a suitable certificate for testing:
+// openssl req -subj '/CN=hashicorptest/O=HashiCorp, Inc./ST=CA/C=US' -new -newkey rsa:2048 -sha256 -days 3650 -nodes -x509 -keyout server.key -out server.crt
+
+// The following certificate will expire on March 7, 2031
+const domainFederationCertificatePem string = `-----BEGIN CERTIFICATE-----
+MIIDFDCCAfwCCQCvHp+vopfOOTANBgkqhkiG9w0BAQsFADBMMRYwFAYDVQQDDA1o
+YXNoaWNvcnB0ZXN0MRgwFgYDVQQKDA9IYXNoaUNvcnAsIEluYy4xCzAJBgNVBAgM
+AkNBMQswCQYDVQQGEwJVUzAeFw0yMTAzMDkxMTAyMTNaFw0zMTAzMDcxMTAyMTNa
+MEwxFjAUBgNVBAMMDWhhc2hpY29ycHRlc3QxGDAWBgNVBAoMD0hhc2hpQ29ycCwg
+SW5jLjELMAkGA1UECAwCQ0ExCzAJBgNVBAYTAlVTMIIBIjANBgkqhkiG9w0BAQEF
+AAOCAQ8AMIIBCgKCAQEAlVmb5pmoASvZ5pxD6CEBiPYqADb7teCHV54RRwv1aJjS
+eiPUW/1WNQooIQF0M0yzFdHmwx3HSoxCkQwwxVMAPsuqFJVabs/eAr41NpxQCncb
+i+vKlbmaAWbaIdidxeUe1jXB2N0YXRCg7Ps8IGA0UochvRGypfciy4k6/xEfrrQP
+FlrPeDeaurNUjJ4IotTBLzWNAX9nT1HKzvljYNg4A0PwuzPNOmgxUSpAeiPbDoQo
+D/YcQUKWzBlW8qt9ZnuRMGNi6V2fnQeTLblfsheaavXyP11syJ9owz6mDffZELHd
+SYC7j2EOqG+Pndd55MLOac8cF4D9Y91PkLKKjNIrWwIDAQABMA0GCSqGSIb3DQEB
+CwUAA4IBAQBlVJLn17BFmigbqS8JIx0/RTbGokRoLKdg7SZAQJWn20jDtunSo+sp
+ZzuZ4uS8WbgZ+SFD1rrQy3s0F9HssZFBwDGyn31z/sGjkwWpoAP65v1DCaNzmAsz
+xMNijhYlShv61g2IEO9Q98bgBW9LNwmJRGnGxz0ufzeZuUr9IV9EjeoJCKPIbwJC
+lab0Ty/kRC13JgNhHtNFwYVwK6NDt46IRsjxqWQ6bVakrEROlfuoY8sxUjunj+hB
+2vZTkZKaPc0sFvUQjNHxHX4jMeTwCopQCo+qF3lPde+G7C1MNf30kDZlks++GLNs
+0/0Ayfjh6JllWqW482dIIqMErl6s5DuK
+-----END CERTIFICATE-----`
+
+type DomainFederationConfigurationResource struct{}
+
+// Federating a domain requires a verified domain which is not the default domain for the tenant, specified using the
+// `ARM_TEST_FEDERATED_DOMAIN` environment variable
+func federatedDomainName(t *testing.T) string {
+	domainName := os.Getenv("ARM_TEST_FEDERATED_DOMAIN")
+	if domainName == "" {
+		t.Skip("`ARM_TEST_FEDERATED_DOMAIN` must be set to run domain federation acceptance tests")
+	}
+	return domainName
+}
+
+func TestAccDomainFederationConfiguration_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_domain_federation_configuration", "test")
+	r := DomainFederationConfigurationResource{}
+	domainName := federatedDomainName(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, domainName),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("preferred_authentication_protocol").HasValue("wsFed"),
+				check.That(data.ResourceName).Key("federated_idp_mfa_behavior").Exists(),
+				check.That(data.ResourceName).Key("signing_certificate_thumbprint").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDomainFederationConfiguration_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_domain_federation_configuration", "test")
+	r := DomainFederationConfigurationResource{}
+	domainName := federatedDomainName(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data, domainName),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("preferred_authentication_protocol").HasValue("saml"),
+				check.That(data.ResourceName).Key("federated_idp_mfa_behavior").HasValue("enforceMfaByFederatedIdp"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDomainFederationConfiguration_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_domain_federation_configuration", "test")
+	r := DomainFederationConfigurationResource{}
+	domainName := federatedDomainName(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, domainName),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data, domainName),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, domainName),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDomainFederationConfiguration_invalidCertificate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_domain_federation_configuration", "test")
+	r := DomainFederationConfigurationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.invalidCertificate(data),
+			ExpectError: regexp.MustCompile("must be a PEM-encoded or base64-encoded DER X.509 certificate"),
+		},
+	})
+}
+
+func (r DomainFederationConfigurationResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Domains.FederationConfigurationClient
+
+	id, err := stable.ParseDomainIdFederationConfigurationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetFederationConfiguration(ctx, *id, federationconfiguration.DefaultGetFederationConfigurationOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to retrieve %s: %+v", id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (DomainFederationConfigurationResource) basic(data acceptance.TestData, domainName string) string {
+	return fmt.Sprintf(`
+resource "azuread_domain_federation_configuration" "test" {
+  domain_id           = "/domains/%[2]s"
+  display_name        = "acctest-Federation-%[1]d"
+  issuer_uri          = "http://%[2]s/adfs/services/trust"
+  passive_sign_in_uri = "https://sts.%[2]s/adfs/ls/"
+  signing_certificate = <<EOT
+%[3]s
+EOT
+}
+`, data.RandomInteger, domainName, domainFederationCertificatePem)
+}
+
+func (DomainFederationConfigurationResource) complete(data acceptance.TestData, domainName string) string {
+	return fmt.Sprintf(`
+resource "azuread_domain_federation_configuration" "test" {
+  domain_id                              = "/domains/%[2]s"
+  display_name                           = "acctest-Federation-complete-%[1]d"
+  issuer_uri                             = "http://%[2]s/adfs/services/trust"
+  passive_sign_in_uri                    = "https://sts.%[2]s/adfs/ls/"
+  active_sign_in_uri                     = "https://sts.%[2]s/adfs/services/trust/2005/usernamemixed"
+  sign_out_uri                           = "https://sts.%[2]s/adfs/ls/?wa=wsignout1.0"
+  metadata_exchange_uri                  = "https://sts.%[2]s/adfs/services/trust/mex"
+  password_reset_uri                     = "https://sts.%[2]s/adfs/portal/updatepassword/"
+  preferred_authentication_protocol      = "saml"
+  federated_idp_mfa_behavior             = "enforceMfaByFederatedIdp"
+  prompt_login_behavior                  = "nativeSupport"
+  signed_authentication_request_required = true
+  signing_certificate = <<EOT
+%[3]s
+EOT
+}
+`, data.RandomInteger, domainName, domainFederationCertificatePem)
+}
+
+func (DomainFederationConfigurationResource) invalidCertificate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_domain_federation_configuration" "test" {
+  domain_id           = "/domains/acctest%[1]d.hashicorptest.com"
+  display_name        = "acctest-Federation-%[1]d"
+  issuer_uri          = "http://acctest%[1]d.hashicorptest.com/adfs/services/trust"
+  passive_sign_in_uri = "https://sts.acctest%[1]d.hashicorptest.com/adfs/ls/"
+  signing_certificate = "bm90IGEgY2VydGlmaWNhdGU="
+}
+`, data.RandomInteger)
+}
@@ -0,0 +1,482 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package domains
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/domains/stable/domain"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/domains/stable/verificationdnsrecord"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/consistency"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/suppress"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/hashicorp/terraform-provider-azuread/internal/sdk"
+)
+
+type DomainResourceModel struct {
+	AdminManaged                     bool                               `tfschema:"admin_managed"`
+	AuthenticationType               string                             `tfschema:"authentication_type"`
+	Default                          bool                               `tfschema:"default"`
+	DomainName                       string                             `tfschema:"domain_name"`
+	Initial                          bool                               `tfschema:"initial"`
+	PasswordNotificationWindowInDays int64                              `tfschema:"password_notification_window_in_days"`
+	PasswordValidityPeriodInDays     int64                              `tfschema:"password_validity_period_in_days"`
+	Root                             bool                               `tfschema:"root"`
+	SupportedServices                []string                           `tfschema:"supported_services"`
+	VerificationDnsRecords           []DomainVerificationDnsRecordModel `tfschema:"verification_dns_records"`
+	Verified                         bool                               `tfschema:"verified"`
+	Verify                           bool                               `tfschema:"verify"`
+}
+
+type DomainVerificationDnsRecordModel struct {
+	Label        string `tfschema:"label"`
+	MailExchange string `tfschema:"mail_exchange"`
+	Preference   int64  `tfschema:"preference"`
+	RecordType   string `tfschema:"record_type"`
+	Text         string `tfschema:"text"`
+	Ttl          int64  `tfschema:"ttl"`
+}
+
+var _ sdk.ResourceWithUpdate = DomainResource{}
+
+type DomainResource struct{}
+
+func (r DomainResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return stable.ValidateDomainID
+}
+
+func (r DomainResource) ResourceType() string {
+	return "azuread_domain"
+}
+
+func (r DomainResource) ModelObject() interface{} {
+	return &DomainResourceModel{}
+}
+
+func (r DomainResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"domain_name": {
+			Description:      "The fully qualified name of the domain",
+			Type:             pluginsdk.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			ValidateFunc:     validation.StringIsNotWhiteSpace,
+			DiffSuppressFunc: suppress.CaseDifference,
+		},
+
+		"password_notification_window_in_days": {
+			Description:  "The number of days before a user receives notification that their password will expire. Can only be set for a verified domain",
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntBetween(1, 30),
+		},
+
+		"password_validity_period_in_days": {
+			Description:  "The number of days that a password is valid before it must be changed, or `2147483647` for passwords that never expire. Can only be set for a verified domain",
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.Any(validation.IntBetween(14, 730), validation.IntInSlice([]int{domainPasswordNeverExpires})),
+		},
+
+		"supported_services": {
+			Description: "A set of capabilities / services supported by the domain. Can only be set for a verified domain",
+			Type:        pluginsdk.TypeSet,
+			Optional:    true,
+			Computed:    true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringInSlice(possibleValuesForDomainSupportedService, false),
+			},
+		},
+
+		"verify": {
+			Description: "Whether to verify the domain, retrying until the verification DNS record can be found",
+			Type:        pluginsdk.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+	}
+}
+
+func (r DomainResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"admin_managed": {
+			Description: "Whether the DNS for the domain is managed by Microsoft 365",
+			Type:        pluginsdk.TypeBool,
+			Computed:    true,
+		},
+
+		"authentication_type": {
+			Description: "The authentication type of the domain. Possible values include `Managed` or `Federated`",
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+		},
+
+		"default": {
+			Description: "Whether this is the default domain that is used for user creation",
+			Type:        pluginsdk.TypeBool,
+			Computed:    true,
+		},
+
+		"initial": {
+			Description: "Whether this is the initial domain created by Azure Active Directory",
+			Type:        pluginsdk.TypeBool,
+			Computed:    true,
+		},
+
+		"root": {
+			Description: "Whether the domain is a verified root domain (not a subdomain)",
+			Type:        pluginsdk.TypeBool,
+			Computed:    true,
+		},
+
+		"verification_dns_records": {
+			Description: "The DNS records to be added at the DNS host in order to verify ownership of the domain",
+			Type:        pluginsdk.TypeList,
+			Computed:    true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"label": {
+						Description: "The name of the DNS record",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"mail_exchange": {
+						Description: "The value of the mail exchange, for `Mx` records",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"preference": {
+						Description: "The preference of the mail exchange, for `Mx` records",
+						Type:        pluginsdk.TypeInt,
+						Computed:    true,
+					},
+
+					"record_type": {
+						Description: "The type of the DNS record, either `Txt` or `Mx`",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"text": {
+						Description: "The value of the text property, for `Txt` records",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"ttl": {
+						Description: "The time-to-live of the DNS record",
+						Type:        pluginsdk.TypeInt,
+						Computed:    true,
+					},
+				},
+			},
+		},
+
+		"verified": {
+			Description: "Whether the domain has completed domain ownership verification",
+			Type:        pluginsdk.TypeBool,
+			Computed:    true,
+		},
+	}
+}
+
+func (r DomainResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Domains.DomainClient
+
+			var model DomainResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := stable.NewDomainID(model.DomainName)
+
+			if !model.Verify && domainHasSettings(model) {
+				return fmt.Errorf("`supported_services`, `password_notification_window_in_days` and `password_validity_period_in_days` can only be set for a verified domain, set `verify` to `true` once the verification DNS record has been created")
+			}
+
+			existing, err := client.GetDomain(ctx, id, domain.DefaultGetDomainOperationOptions())
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			properties := stable.Domain{
+				Id: pointer.To(model.DomainName),
+			}
+
+			if _, err = client.CreateDomain(ctx, properties, domain.DefaultCreateDomainOperationOptions()); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			// Wait for the domain to be consistently available
+			if err = consistency.WaitForUpdate(ctx, func(ctx context.Context) (*bool, error) {
+				resp, err := client.GetDomain(ctx, id, domain.DefaultGetDomainOperationOptions())
+				if err != nil {
+					if response.WasNotFound(resp.HttpResponse) {
+						return pointer.To(false), nil
+					}
+					return nil, err
+				}
+				return pointer.To(resp.Model != nil), nil
+			}); err != nil {
+				return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			if model.Verify {
+				if err = verifyDomain(ctx, client, id); err != nil {
+					return err
+				}
+
+				if domainHasSettings(model) {
+					if _, err = client.UpdateDomain(ctx, id, expandDomainSettings(model), domain.DefaultUpdateDomainOperationOptions()); err != nil {
+						return fmt.Errorf("configuring %s: %+v", id, err)
+					}
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r DomainResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Domains
+
+			id, err := stable.ParseDomainID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state DomainResourceModel
+			if err = metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			resp, err := client.DomainClient.GetDomain(ctx, *id, domain.DefaultGetDomainOperationOptions())
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			d := resp.Model
+			if d == nil {
+				return fmt.Errorf("retrieving %s: model was nil", id)
+			}
+
+			state.AdminManaged = pointer.From(d.IsAdminManaged)
+			state.AuthenticationType = pointer.From(d.AuthenticationType)
+			state.Default = pointer.From(d.IsDefault)
+			state.DomainName = pointer.From(d.Id)
+			state.Initial = pointer.From(d.IsInitial)
+			state.PasswordNotificationWindowInDays = d.PasswordNotificationWindowInDays.GetOrZero()
+			state.PasswordValidityPeriodInDays = d.PasswordValidityPeriodInDays.GetOrZero()
+			state.Root = pointer.From(d.IsRoot)
+			state.SupportedServices = pointer.From(d.SupportedServices)
+			state.Verified = pointer.From(d.IsVerified)
+
+			recordsResp, err := client.VerificationDnsRecordClient.ListVerificationDnsRecords(ctx, *id, verificationdnsrecord.DefaultListVerificationDnsRecordsOperationOptions())
+			if err != nil {
+				// Verification records are not always available once a domain has been verified, in which case the
+				// records from the last successful refresh are retained
+				if !state.Verified {
+					return fmt.Errorf("retrieving verification DNS records for %s: %+v", id, err)
+				}
+				log.Printf("[DEBUG] Unable to retrieve verification DNS records for verified %s: %+v", id, err)
+			} else {
+				state.VerificationDnsRecords = flattenDomainVerificationDnsRecords(pointer.From(recordsResp.Model))
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r DomainResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Domains.DomainClient
+			rd := metadata.ResourceData
+
+			id, err := stable.ParseDomainID(rd.Id())
+			if err != nil {
+				return err
+			}
+
+			var model DomainResourceModel
+			if err = metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			verified := rd.Get("verified").(bool)
+
+			if rd.HasChange("verify") && model.Verify && !verified {
+				if err = verifyDomain(ctx, client, *id); err != nil {
+					return err
+				}
+				verified = true
+			}
+
+			if rd.HasChanges("password_notification_window_in_days", "password_validity_period_in_days", "supported_services") {
+				if !verified {
+					return fmt.Errorf("`supported_services`, `password_notification_window_in_days` and `password_validity_period_in_days` can only be set for a verified domain, set `verify` to `true` once the verification DNS record has been created")
+				}
+
+				if _, err = client.UpdateDomain(ctx, *id, expandDomainSettings(model), domain.DefaultUpdateDomainOperationOptions()); err != nil {
+					return fmt.Errorf("updating %s: %+v", id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r DomainResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Domains.DomainClient
+
+			id, err := stable.ParseDomainID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if resp, err := client.DeleteDomain(ctx, *id, domain.DefaultDeleteDomainOperationOptions()); err != nil {
+				if !response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("deleting %s: %+v", id, err)
+				}
+			}
+
+			// Domain deletion is asynchronous, and can take some time when there are objects referencing the domain
+			if err = consistency.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+				resp, err := client.GetDomain(ctx, *id, domain.DefaultGetDomainOperationOptions())
+				if err != nil {
+					if response.WasNotFound(resp.HttpResponse) {
+						return pointer.To(false), nil
+					}
+					return nil, err
+				}
+				return pointer.To(true), nil
+			}); err != nil {
+				return fmt.Errorf("waiting for deletion of %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// verifyDomain attempts to verify ownership of a domain, retrying until the verification DNS record has propagated
+// or the context deadline is reached
+func verifyDomain(ctx context.Context, client *domain.DomainClient, id stable.DomainId) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("context has no deadline")
+	}
+
+	var lastErr error
+	_, err := (&pluginsdk.StateChangeConf{ //nolint:staticcheck
+		Pending:    []string{"Waiting"},
+		Target:     []string{"Verified"},
+		Timeout:    time.Until(deadline),
+		MinTimeout: 30 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.CreateVerify(ctx, id, domain.DefaultCreateVerifyOperationOptions())
+			if err != nil {
+				// The API returns a 400 while the verification DNS record cannot be found
+				if response.WasBadRequest(resp.HttpResponse) {
+					lastErr = err
+					return "stub", "Waiting", nil
+				}
+				return nil, "Error", err
+			}
+			if resp.Model == nil || !pointer.From(resp.Model.IsVerified) {
+				return "stub", "Waiting", nil
+			}
+			return "stub", "Verified", nil
+		},
+	}).WaitForStateContext(ctx)
+	if err != nil {
+		if lastErr != nil {
+			return fmt.Errorf("verifying %s: %+v (last error: %+v)", id, err, lastErr)
+		}
+		return fmt.Errorf("verifying %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func domainHasSettings(model DomainResourceModel) bool {
+	return len(model.SupportedServices) > 0 || model.PasswordNotificationWindowInDays > 0 || model.PasswordValidityPeriodInDays > 0
+}
+
+func expandDomainSettings(model DomainResourceModel) stable.Domain {
+	properties := stable.Domain{}
+
+	if len(model.SupportedServices) > 0 {
+		properties.SupportedServices = pointer.To(model.SupportedServices)
+	}
+	if model.PasswordNotificationWindowInDays > 0 {
+		properties.PasswordNotificationWindowInDays = nullable.Value(model.PasswordNotificationWindowInDays)
+	}
+	if model.PasswordValidityPeriodInDays > 0 {
+		properties.PasswordValidityPeriodInDays = nullable.Value(model.PasswordValidityPeriodInDays)
+	}
+
+	return properties
+}
+
+func flattenDomainVerificationDnsRecords(input []stable.DomainDnsRecord) []DomainVerificationDnsRecordModel {
+	result := make([]DomainVerificationDnsRecordModel, 0)
+
+	for _, record := range input {
+		base := record.DomainDnsRecord()
+		model := DomainVerificationDnsRecordModel{
+			Label:      pointer.From(base.Label),
+			RecordType: base.RecordType.GetOrZero(),
+			Ttl:        pointer.From(base.Ttl),
+		}
+
+		switch r := record.(type) {
+		case stable.DomainDnsMxRecord:
+			model.MailExchange = pointer.From(r.MailExchange)
+			model.Preference = r.Preference.GetOrZero()
+		case stable.DomainDnsTxtRecord:
+			model.Text = pointer.From(r.Text)
+		}
+
+		result = append(result, model)
+	}
+
+	return result
+}
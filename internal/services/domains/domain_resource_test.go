@@ -0,0 +1,92 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package domains_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/domains/stable/domain"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+)
+
+type DomainResource struct{}
+
+func TestAccDomain_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_domain", "test")
+	r := DomainResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("verified").HasValue("false"),
+				check.That(data.ResourceName).Key("authentication_type").HasValue("Managed"),
+				check.That(data.ResourceName).Key("verification_dns_records.#").Exists(),
+				check.That(data.ResourceName).Key("verification_dns_records.0.label").Exists(),
+			),
+		},
+		data.ImportStep("verify"),
+	})
+}
+
+func TestAccDomain_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_domain", "test")
+	r := DomainResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport(data)),
+	})
+}
+
+func (r DomainResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Domains.DomainClient
+
+	id, err := stable.ParseDomainID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetDomain(ctx, *id, domain.DefaultGetDomainOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to retrieve %s: %+v", id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (DomainResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_domain" "test" {
+  domain_name = "acctest%[1]d.hashicorptest.com"
+}
+`, data.RandomInteger)
+}
+
+func (r DomainResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_domain" "import" {
+  domain_name = azuread_domain.test.domain_name
+}
+`, r.basic(data))
+}
@@ -46,5 +46,8 @@ func (r Registration) DataSources() []sdk.DataSource {
 
 // Resources returns the typed Resources supported by this service
 func (r Registration) Resources() []sdk.Resource {
-	return []sdk.Resource{}
+	return []sdk.Resource{
+		DomainFederationConfigurationResource{},
+		DomainResource{},
+	}
 }
@@ -11,6 +11,8 @@ import (
 	memberofBeta "github.com/hashicorp/go-azure-sdk/microsoft-graph/groups/beta/memberof"
 	ownerBeta "github.com/hashicorp/go-azure-sdk/microsoft-graph/groups/beta/owner"
 	transitivememberBeta "github.com/hashicorp/go-azure-sdk/microsoft-graph/groups/beta/transitivemember"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/groups/stable/grouplifecyclepolicy"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/groups/stable/setting"
 	"github.com/hashicorp/terraform-provider-azuread/internal/common"
 )
 
@@ -22,9 +24,11 @@ type Client struct {
 	AdministrativeUnitMemberClientBeta *administrativeunitmemberBeta.AdministrativeUnitMemberClient
 	DirectoryObjectClient              *directoryobject.DirectoryObjectClient
 	GroupClientBeta                    *groupBeta.GroupClient
+	GroupLifecyclePolicyClient         *grouplifecyclepolicy.GroupLifecyclePolicyClient
 	GroupMemberClientBeta              *memberBeta.MemberClient
 	GroupMemberOfClientBeta            *memberofBeta.MemberOfClient
 	GroupOwnerClientBeta               *ownerBeta.OwnerClient
+	GroupSettingClient                 *setting.SettingClient
 	GroupTransitiveMemberClientBeta    *transitivememberBeta.TransitiveMemberClient
 }
 
@@ -48,6 +52,13 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(groupClientBeta.Client)
 
+	// The underlying client is also used for the tenant-wide /groupLifecyclePolicies collection
+	groupLifecyclePolicyClient, err := grouplifecyclepolicy.NewGroupLifecyclePolicyClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(groupLifecyclePolicyClient.Client)
+
 	// Group members not returned in full when using v1.0 API, see https://github.com/hashicorp/terraform-provider-azuread/issues/1018
 	memberClientBeta, err := memberBeta.NewMemberClientWithBaseURI(o.Environment.MicrosoftGraph)
 	if err != nil {
@@ -67,6 +78,13 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(ownerClientBeta.Client)
 
+	// Also used for tenant-wide directory settings, which are not currently supported by the SDK
+	settingClient, err := setting.NewSettingClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(settingClient.Client)
+
 	// Group members not returned in full when using v1.0 API, see https://github.com/hashicorp/terraform-provider-azuread/issues/1018
 	transitiveMemberClientBeta, err := transitivememberBeta.NewTransitiveMemberClientWithBaseURI(o.Environment.MicrosoftGraph)
 	if err != nil {
@@ -78,9 +96,11 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		AdministrativeUnitMemberClientBeta: administrativeUnitMemberClientBeta,
 		DirectoryObjectClient:              directoryObjectClient,
 		GroupClientBeta:                    groupClientBeta,
+		GroupLifecyclePolicyClient:         groupLifecyclePolicyClient,
 		GroupMemberClientBeta:              memberClientBeta,
 		GroupMemberOfClientBeta:            memberOfClientBeta,
 		GroupOwnerClientBeta:               ownerClientBeta,
+		GroupSettingClient:                 settingClient,
 		GroupTransitiveMemberClientBeta:    transitiveMemberClientBeta,
 	}, nil
 }
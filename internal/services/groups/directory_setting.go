@@ -0,0 +1,290 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package groups
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/msgraph"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/groups/parse"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/groups/validate"
+)
+
+// The setting package only covers settings for a single group at /groups/{groupId}/settings, so tenant-wide settings at
+// /groupSettings and the templates at /groupSettingTemplates are requested using its underlying client.
+
+type directorySettingResponse struct {
+	HttpResponse *http.Response
+	Model        *stable.GroupSetting
+}
+
+func listDirectorySettingTemplates(ctx context.Context, c *msgraph.Client) (*[]stable.GroupSettingTemplate, error) {
+	opts := client.RequestOptions{
+		ContentType:         "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{http.StatusOK},
+		HttpMethod:          http.MethodGet,
+		Path:                "/groupSettingTemplates",
+	}
+
+	req, err := c.NewRequest(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var values struct {
+		Values *[]stable.GroupSettingTemplate `json:"value"`
+	}
+	if err = resp.Unmarshal(&values); err != nil {
+		return nil, err
+	}
+
+	return values.Values, nil
+}
+
+// findDirectorySettingTemplate retrieves a directory setting template by its display name, e.g. `Group.Unified`
+func findDirectorySettingTemplate(ctx context.Context, c *msgraph.Client, name string) (*stable.GroupSettingTemplate, error) {
+	templates, err := listDirectorySettingTemplates(ctx, c)
+	if err != nil {
+		return nil, fmt.Errorf("listing directory setting templates: %+v", err)
+	}
+	if templates == nil {
+		return nil, fmt.Errorf("listing directory setting templates: model was nil")
+	}
+
+	names := make([]string, 0)
+	for _, template := range *templates {
+		if strings.EqualFold(template.DisplayName.GetOrZero(), name) {
+			return &template, nil
+		}
+		names = append(names, template.DisplayName.GetOrZero())
+	}
+
+	sort.Strings(names)
+	return nil, fmt.Errorf("no directory setting template was found with the name %q, available templates are: %s", name, strings.Join(names, ", "))
+}
+
+// findDirectorySettingTemplateById retrieves a directory setting template by its ID, which is used when importing
+func findDirectorySettingTemplateById(ctx context.Context, c *msgraph.Client, id string) (*stable.GroupSettingTemplate, error) {
+	templates, err := listDirectorySettingTemplates(ctx, c)
+	if err != nil {
+		return nil, fmt.Errorf("listing directory setting templates: %+v", err)
+	}
+	if templates != nil {
+		for _, template := range *templates {
+			if strings.EqualFold(pointer.From(template.Id), id) {
+				return &template, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("no directory setting template was found with the ID %q", id)
+}
+
+func listDirectorySettings(ctx context.Context, c *msgraph.Client) (*[]stable.GroupSetting, error) {
+	opts := client.RequestOptions{
+		ContentType:         "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{http.StatusOK},
+		HttpMethod:          http.MethodGet,
+		Path:                "/groupSettings",
+	}
+
+	req, err := c.NewRequest(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var values struct {
+		Values *[]stable.GroupSetting `json:"value"`
+	}
+	if err = resp.Unmarshal(&values); err != nil {
+		return nil, err
+	}
+
+	return values.Values, nil
+}
+
+func createDirectorySetting(ctx context.Context, c *msgraph.Client, input stable.GroupSetting) (*stable.GroupSetting, error) {
+	opts := client.RequestOptions{
+		ContentType:         "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{http.StatusCreated, http.StatusOK},
+		HttpMethod:          http.MethodPost,
+		Path:                "/groupSettings",
+	}
+
+	req, err := c.NewRequest(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = req.Marshal(input); err != nil {
+		return nil, err
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var model stable.GroupSetting
+	if err = resp.Unmarshal(&model); err != nil {
+		return nil, err
+	}
+
+	return &model, nil
+}
+
+func getDirectorySetting(ctx context.Context, c *msgraph.Client, id parse.DirectorySettingId) (result directorySettingResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType:         "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{http.StatusOK},
+		HttpMethod:          http.MethodGet,
+		Path:                id.ID(),
+	}
+
+	req, err := c.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	resp, err := req.Execute(ctx)
+	if resp != nil {
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var model stable.GroupSetting
+	result.Model = &model
+	err = resp.Unmarshal(result.Model)
+
+	return
+}
+
+func updateDirectorySetting(ctx context.Context, c *msgraph.Client, id parse.DirectorySettingId, input stable.GroupSetting) error {
+	opts := client.RequestOptions{
+		ContentType:         "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{http.StatusNoContent, http.StatusOK},
+		HttpMethod:          http.MethodPatch,
+		Path:                id.ID(),
+	}
+
+	req, err := c.NewRequest(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	if err = req.Marshal(input); err != nil {
+		return err
+	}
+
+	_, err = req.Execute(ctx)
+	return err
+}
+
+func deleteDirectorySetting(ctx context.Context, c *msgraph.Client, id parse.DirectorySettingId) error {
+	opts := client.RequestOptions{
+		ContentType:         "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{http.StatusNoContent, http.StatusOK},
+		HttpMethod:          http.MethodDelete,
+		Path:                id.ID(),
+	}
+
+	req, err := c.NewRequest(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	_, err = req.Execute(ctx)
+	return err
+}
+
+// validateDirectorySettingValues ensures that the specified values are declared by the template, and that each value
+// can be interpreted as the type declared by the corresponding setting definition
+func validateDirectorySettingValues(template stable.GroupSettingTemplate, values map[string]string) error {
+	definitions := make(map[string]stable.SettingTemplateValue)
+	names := make([]string, 0)
+	for _, definition := range pointer.From(template.Values) {
+		definitions[definition.Name.GetOrZero()] = definition
+		names = append(names, definition.Name.GetOrZero())
+	}
+	sort.Strings(names)
+
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, name := range keys {
+		definition, ok := definitions[name]
+		if !ok {
+			return fmt.Errorf("the setting %q is not declared by the %q template, valid settings are: %s", name, template.DisplayName.GetOrZero(), strings.Join(names, ", "))
+		}
+		if err := validate.DirectorySettingValue(definition.Type.GetOrZero(), values[name]); err != nil {
+			return fmt.Errorf("invalid value for setting %q: %+v", name, err)
+		}
+	}
+
+	return nil
+}
+
+// expandDirectorySettingValues returns the complete set of values for a directory setting, using the template defaults
+// for any setting not specified. The API replaces all values on update, so they must always be sent in full.
+func expandDirectorySettingValues(template stable.GroupSettingTemplate, values map[string]string) *[]stable.SettingValue {
+	result := make([]stable.SettingValue, 0)
+	for _, definition := range pointer.From(template.Values) {
+		name := definition.Name.GetOrZero()
+		value := definition.DefaultValue.GetOrZero()
+		if v, ok := values[name]; ok {
+			value = v
+		}
+		result = append(result, stable.SettingValue{
+			Name:  nullable.Value(name),
+			Value: nullable.Value(value),
+		})
+	}
+	return &result
+}
+
+// flattenDirectorySettingValues returns the values for a directory setting which should be recorded in state. These are
+// any values which were previously recorded, along with any values that differ from the template default, so that
+// drift is detected for settings not specified in configuration.
+func flattenDirectorySettingValues(template stable.GroupSettingTemplate, input *[]stable.SettingValue, existing map[string]string) map[string]string {
+	defaults := make(map[string]string)
+	for _, definition := range pointer.From(template.Values) {
+		defaults[definition.Name.GetOrZero()] = definition.DefaultValue.GetOrZero()
+	}
+
+	result := make(map[string]string)
+	for _, v := range pointer.From(input) {
+		name := v.Name.GetOrZero()
+		value := v.Value.GetOrZero()
+
+		_, managed := existing[name]
+		if defaultValue, declared := defaults[name]; managed || !declared || value != defaultValue {
+			result[name] = value
+		}
+	}
+
+	return result
+}
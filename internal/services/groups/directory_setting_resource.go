@@ -0,0 +1,263 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package groups
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/suppress"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/hashicorp/terraform-provider-azuread/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/groups/parse"
+)
+
+type DirectorySettingModel struct {
+	TemplateId   string            `tfschema:"template_id"`
+	TemplateName string            `tfschema:"template_name"`
+	Values       map[string]string `tfschema:"values"`
+}
+
+var _ sdk.ResourceWithUpdate = DirectorySettingResource{}
+var _ sdk.ResourceWithCustomizeDiff = DirectorySettingResource{}
+
+type DirectorySettingResource struct{}
+
+func (r DirectorySettingResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return parse.ValidateDirectorySettingID
+}
+
+func (r DirectorySettingResource) ResourceType() string {
+	return "azuread_directory_setting"
+}
+
+func (r DirectorySettingResource) ModelObject() interface{} {
+	return &DirectorySettingModel{}
+}
+
+func (r DirectorySettingResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"template_name": {
+			Description:      "The name of the directory setting template, e.g. `Group.Unified`",
+			Type:             pluginsdk.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			DiffSuppressFunc: suppress.CaseDifference,
+			ValidateFunc:     validation.StringIsNotEmpty,
+		},
+
+		"values": {
+			Description: "A mapping of setting names to values. Settings not specified will use the default value declared by the template",
+			Type:        pluginsdk.TypeMap,
+			Optional:    true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r DirectorySettingResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"template_id": {
+			Description: "The ID of the directory setting template",
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+		},
+	}
+}
+
+func (r DirectorySettingResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Groups.GroupSettingClient.Client
+
+			// Values can only be validated against the template once they are known
+			if !metadata.ResourceDiff.NewValueKnown("template_name") || !metadata.ResourceDiff.NewValueKnown("values") {
+				return nil
+			}
+
+			var model DirectorySettingModel
+			if err := metadata.DecodeDiff(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			template, err := findDirectorySettingTemplate(ctx, client, model.TemplateName)
+			if err != nil {
+				return err
+			}
+
+			return validateDirectorySettingValues(*template, model.Values)
+		},
+	}
+}
+
+func (r DirectorySettingResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Groups.GroupSettingClient.Client
+
+			var model DirectorySettingModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			template, err := findDirectorySettingTemplate(ctx, client, model.TemplateName)
+			if err != nil {
+				return err
+			}
+			if err = validateDirectorySettingValues(*template, model.Values); err != nil {
+				return err
+			}
+
+			// Only one directory setting can exist for each template
+			existing, err := listDirectorySettings(ctx, client)
+			if err != nil {
+				return fmt.Errorf("listing directory settings: %+v", err)
+			}
+			for _, setting := range pointer.From(existing) {
+				if strings.EqualFold(setting.TemplateId.GetOrZero(), pointer.From(template.Id)) && setting.Id != nil {
+					return tf.ImportAsExistsError(r.ResourceType(), parse.NewDirectorySettingID(*setting.Id).ID())
+				}
+			}
+
+			properties := stable.GroupSetting{
+				TemplateId: nullable.Value(pointer.From(template.Id)),
+				Values:     expandDirectorySettingValues(*template, model.Values),
+			}
+
+			setting, err := createDirectorySetting(ctx, client, properties)
+			if err != nil {
+				return fmt.Errorf("creating directory setting for template %q: %+v", model.TemplateName, err)
+			}
+			if setting.Id == nil {
+				return errors.New("creating directory setting: model returned with nil ID")
+			}
+
+			id := parse.NewDirectorySettingID(*setting.Id)
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r DirectorySettingResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Groups.GroupSettingClient.Client
+
+			id, err := parse.ParseDirectorySettingID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var existing DirectorySettingModel
+			if err = metadata.Decode(&existing); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			resp, err := getDirectorySetting(ctx, client, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			setting := resp.Model
+			if setting == nil {
+				return fmt.Errorf("retrieving %s: model was nil", id)
+			}
+
+			template, err := findDirectorySettingTemplateById(ctx, client, setting.TemplateId.GetOrZero())
+			if err != nil {
+				return fmt.Errorf("retrieving template for %s: %+v", id, err)
+			}
+
+			// Template names are matched case-insensitively, so retain the configured casing where possible
+			templateName := template.DisplayName.GetOrZero()
+			if strings.EqualFold(existing.TemplateName, templateName) {
+				templateName = existing.TemplateName
+			}
+
+			state := DirectorySettingModel{
+				TemplateId:   setting.TemplateId.GetOrZero(),
+				TemplateName: templateName,
+				Values:       flattenDirectorySettingValues(*template, setting.Values, existing.Values),
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r DirectorySettingResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Groups.GroupSettingClient.Client
+
+			id, err := parse.ParseDirectorySettingID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model DirectorySettingModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			template, err := findDirectorySettingTemplate(ctx, client, model.TemplateName)
+			if err != nil {
+				return err
+			}
+			if err = validateDirectorySettingValues(*template, model.Values); err != nil {
+				return err
+			}
+
+			properties := stable.GroupSetting{
+				Values: expandDirectorySettingValues(*template, model.Values),
+			}
+
+			if err = updateDirectorySetting(ctx, client, *id, properties); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r DirectorySettingResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Groups.GroupSettingClient.Client
+
+			id, err := parse.ParseDirectorySettingID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err = deleteDirectorySetting(ctx, client, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
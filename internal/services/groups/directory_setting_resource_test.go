@@ -0,0 +1,124 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package groups_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/groups/parse"
+)
+
+type DirectorySettingResource struct{}
+
+func TestAccDirectorySetting_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_directory_setting", "test")
+	r := DirectorySettingResource{}
+
+	// Only one directory setting can exist for each template, so these tests must not run in parallel
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("template_id").IsUuid(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDirectorySetting_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_directory_setting", "test")
+	r := DirectorySettingResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("values.%").HasValue("3"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r DirectorySettingResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	c := clients.Groups.GroupSettingClient.Client
+
+	id, err := parse.ParseDirectorySettingID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.NewRequest(ctx, client.RequestOptions{
+		ContentType:         "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{http.StatusOK},
+		HttpMethod:          http.MethodGet,
+		Path:                id.ID(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		if resp != nil && response.WasNotFound(resp.Response) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to retrieve %s: %v", id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (DirectorySettingResource) basic() string {
+	return `
+provider "azuread" {}
+
+resource "azuread_directory_setting" "test" {
+  template_name = "Group.Unified"
+}
+`
+}
+
+func (DirectorySettingResource) complete() string {
+	return `
+provider "azuread" {}
+
+resource "azuread_directory_setting" "test" {
+  template_name = "Group.Unified"
+
+  values = {
+    AllowGuestsToAccessGroups = "false"
+    EnableMIPLabels           = "true"
+    UsageGuidelinesUrl        = "https://example.com/guidelines"
+  }
+}
+`
+}
@@ -0,0 +1,265 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package groups
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/groups/stable/setting"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/sdk"
+)
+
+// groupDirectorySettingTemplateName is the only directory setting template which can be applied to an individual group
+const groupDirectorySettingTemplateName = "Group.Unified.Guest"
+
+type GroupDirectorySettingModel struct {
+	GroupId    string            `tfschema:"group_id"`
+	TemplateId string            `tfschema:"template_id"`
+	Values     map[string]string `tfschema:"values"`
+}
+
+var _ sdk.ResourceWithUpdate = GroupDirectorySettingResource{}
+var _ sdk.ResourceWithCustomizeDiff = GroupDirectorySettingResource{}
+
+type GroupDirectorySettingResource struct{}
+
+func (r GroupDirectorySettingResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return stable.ValidateGroupIdSettingID
+}
+
+func (r GroupDirectorySettingResource) ResourceType() string {
+	return "azuread_group_directory_setting"
+}
+
+func (r GroupDirectorySettingResource) ModelObject() interface{} {
+	return &GroupDirectorySettingModel{}
+}
+
+func (r GroupDirectorySettingResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"group_id": {
+			Description:  "The resource ID of the Microsoft 365 group to which the setting applies",
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: stable.ValidateGroupID,
+		},
+
+		"values": {
+			Description: "A mapping of setting names to values. Settings not specified will use the default value declared by the template",
+			Type:        pluginsdk.TypeMap,
+			Optional:    true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r GroupDirectorySettingResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"template_id": {
+			Description: "The ID of the directory setting template",
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+		},
+	}
+}
+
+func (r GroupDirectorySettingResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Groups.GroupSettingClient.Client
+
+			// Values can only be validated against the template once they are known
+			if !metadata.ResourceDiff.NewValueKnown("values") {
+				return nil
+			}
+
+			var model GroupDirectorySettingModel
+			if err := metadata.DecodeDiff(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			template, err := findDirectorySettingTemplate(ctx, client, groupDirectorySettingTemplateName)
+			if err != nil {
+				return err
+			}
+
+			return validateDirectorySettingValues(*template, model.Values)
+		},
+	}
+}
+
+func (r GroupDirectorySettingResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Groups.GroupSettingClient
+
+			var model GroupDirectorySettingModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			groupId, err := stable.ParseGroupID(model.GroupId)
+			if err != nil {
+				return err
+			}
+
+			template, err := findDirectorySettingTemplate(ctx, client.Client, groupDirectorySettingTemplateName)
+			if err != nil {
+				return err
+			}
+			if err = validateDirectorySettingValues(*template, model.Values); err != nil {
+				return err
+			}
+
+			// Only one setting can exist for each template
+			existing, err := client.ListSettings(ctx, *groupId, setting.DefaultListSettingsOperationOptions())
+			if err != nil {
+				return fmt.Errorf("listing settings for %s: %+v", groupId, err)
+			}
+			for _, s := range pointer.From(existing.Model) {
+				if strings.EqualFold(s.TemplateId.GetOrZero(), pointer.From(template.Id)) && s.Id != nil {
+					return tf.ImportAsExistsError(r.ResourceType(), stable.NewGroupIdSettingID(groupId.GroupId, *s.Id).ID())
+				}
+			}
+
+			properties := stable.GroupSetting{
+				TemplateId: nullable.Value(pointer.From(template.Id)),
+				Values:     expandDirectorySettingValues(*template, model.Values),
+			}
+
+			resp, err := client.CreateSetting(ctx, *groupId, properties, setting.DefaultCreateSettingOperationOptions())
+			if err != nil {
+				return fmt.Errorf("creating directory setting for %s: %+v", groupId, err)
+			}
+			if resp.Model == nil {
+				return fmt.Errorf("creating directory setting for %s: model was nil", groupId)
+			}
+			if resp.Model.Id == nil {
+				return errors.New("creating directory setting: model returned with nil ID")
+			}
+
+			id := stable.NewGroupIdSettingID(groupId.GroupId, *resp.Model.Id)
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r GroupDirectorySettingResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Groups.GroupSettingClient
+
+			id, err := stable.ParseGroupIdSettingID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var existing GroupDirectorySettingModel
+			if err = metadata.Decode(&existing); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			resp, err := client.GetSetting(ctx, *id, setting.DefaultGetSettingOperationOptions())
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			groupSetting := resp.Model
+			if groupSetting == nil {
+				return fmt.Errorf("retrieving %s: model was nil", id)
+			}
+
+			template, err := findDirectorySettingTemplateById(ctx, client.Client, groupSetting.TemplateId.GetOrZero())
+			if err != nil {
+				return fmt.Errorf("retrieving template for %s: %+v", id, err)
+			}
+
+			state := GroupDirectorySettingModel{
+				GroupId:    stable.NewGroupID(id.GroupId).ID(),
+				TemplateId: groupSetting.TemplateId.GetOrZero(),
+				Values:     flattenDirectorySettingValues(*template, groupSetting.Values, existing.Values),
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r GroupDirectorySettingResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Groups.GroupSettingClient
+
+			id, err := stable.ParseGroupIdSettingID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model GroupDirectorySettingModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			template, err := findDirectorySettingTemplate(ctx, client.Client, groupDirectorySettingTemplateName)
+			if err != nil {
+				return err
+			}
+			if err = validateDirectorySettingValues(*template, model.Values); err != nil {
+				return err
+			}
+
+			properties := stable.GroupSetting{
+				Values: expandDirectorySettingValues(*template, model.Values),
+			}
+
+			if _, err = client.UpdateSetting(ctx, *id, properties, setting.DefaultUpdateSettingOperationOptions()); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r GroupDirectorySettingResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Groups.GroupSettingClient
+
+			id, err := stable.ParseGroupIdSettingID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err = client.DeleteSetting(ctx, *id, setting.DefaultDeleteSettingOperationOptions()); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
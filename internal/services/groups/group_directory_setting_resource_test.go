@@ -0,0 +1,122 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package groups_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/groups/stable/setting"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+)
+
+type GroupDirectorySettingResource struct{}
+
+func TestAccGroupDirectorySetting_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group_directory_setting", "test")
+	r := GroupDirectorySettingResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("template_id").IsUuid(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccGroupDirectorySetting_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group_directory_setting", "test")
+	r := GroupDirectorySettingResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("values.AllowToAddGuests").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.allowGuests(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("values.AllowToAddGuests").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r GroupDirectorySettingResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Groups.GroupSettingClient
+
+	id, err := stable.ParseGroupIdSettingID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetSetting(ctx, *id, setting.DefaultGetSettingOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to retrieve %s: %v", id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (GroupDirectorySettingResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_group" "test" {
+  display_name     = "acctestGroup-%[1]d"
+  types            = ["Unified"]
+  mail_enabled     = true
+  mail_nickname    = "acctest.Group-%[1]d"
+  security_enabled = false
+}
+`, data.RandomInteger)
+}
+
+func (r GroupDirectorySettingResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_group_directory_setting" "test" {
+  group_id = azuread_group.test.id
+
+  values = {
+    AllowToAddGuests = "false"
+  }
+}
+`, r.template(data))
+}
+
+func (r GroupDirectorySettingResource) allowGuests(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_group_directory_setting" "test" {
+  group_id = azuread_group.test.id
+
+  values = {
+    AllowToAddGuests = "true"
+  }
+}
+`, r.template(data))
+}
@@ -0,0 +1,306 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package groups
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/beta"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	groupBeta "github.com/hashicorp/go-azure-sdk/microsoft-graph/groups/beta/group"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/licenses"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/hashicorp/terraform-provider-azuread/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/groups/parse"
+)
+
+type GroupLicenseAssignmentModel struct {
+	DisabledServicePlans []string `tfschema:"disabled_service_plans"`
+	SkuId                string   `tfschema:"sku_id"`
+	SkuPartNumber        string   `tfschema:"sku_part_number"`
+	GroupId              string   `tfschema:"group_id"`
+}
+
+var _ sdk.ResourceWithUpdate = GroupLicenseAssignmentResource{}
+
+type GroupLicenseAssignmentResource struct{}
+
+func (r GroupLicenseAssignmentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return parse.ValidateGroupLicenseAssignmentID
+}
+
+func (r GroupLicenseAssignmentResource) ResourceType() string {
+	return "azuread_group_license_assignment"
+}
+
+func (r GroupLicenseAssignmentResource) ModelObject() interface{} {
+	return &GroupLicenseAssignmentModel{}
+}
+
+func (r GroupLicenseAssignmentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"group_id": {
+			Description:  "The resource ID of the group to which the license is assigned",
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: stable.ValidateGroupID,
+		},
+
+		"sku_id": {
+			Description:  "The ID of the SKU to assign",
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ExactlyOneOf: []string{"sku_id", "sku_part_number"},
+			ValidateFunc: validation.IsUUID,
+		},
+
+		"sku_part_number": {
+			Description:  "The part number of the SKU to assign, e.g. `ENTERPRISEPACK`",
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ExactlyOneOf: []string{"sku_id", "sku_part_number"},
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"disabled_service_plans": {
+			Description: "A set of service plan names to disable for this license",
+			Type:        pluginsdk.TypeSet,
+			Optional:    true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func (r GroupLicenseAssignmentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r GroupLicenseAssignmentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Groups.GroupClientBeta
+
+			var model GroupLicenseAssignmentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			groupId, err := beta.ParseGroupID(model.GroupId)
+			if err != nil {
+				return err
+			}
+
+			sku, err := licenses.FindSubscribedSku(ctx, client.Client, model.SkuId, model.SkuPartNumber)
+			if err != nil {
+				return err
+			}
+
+			disabledPlans, err := licenses.ExpandServicePlans(*sku, model.DisabledServicePlans)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewGroupLicenseAssignmentID(groupId.GroupId, sku.SkuId.GetOrZero())
+
+			tf.LockByName(groupResourceName, id.GroupId)
+			defer tf.UnlockByName(groupResourceName, id.GroupId)
+
+			existing, err := findGroupAssignedLicense(ctx, client, id)
+			if err != nil {
+				return err
+			}
+			if existing != nil {
+				return tf.ImportAsExistsError(r.ResourceType(), id.ID())
+			}
+
+			request := groupBeta.AssignLicenseRequest{
+				AddLicenses: &[]beta.AssignedLicense{
+					{
+						SkuId:         nullable.Value(id.SkuId),
+						DisabledPlans: disabledPlans,
+					},
+				},
+				RemoveLicenses: &[]string{},
+			}
+
+			if _, err = client.AssignLicense(ctx, *groupId, request, groupBeta.DefaultAssignLicenseOperationOptions()); err != nil {
+				return fmt.Errorf("assigning license %q to %s: %+v", sku.SkuPartNumber.GetOrZero(), groupId, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r GroupLicenseAssignmentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Groups.GroupClientBeta
+
+			id, err := parse.ParseGroupLicenseAssignmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			license, err := findGroupAssignedLicense(ctx, client, *id)
+			if err != nil {
+				return err
+			}
+			if license == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			sku, err := licenses.FindSubscribedSku(ctx, client.Client, id.SkuId, "")
+			if err != nil {
+				return err
+			}
+
+			// SKU part numbers and service plan names are matched case-insensitively, so retain the configured casing
+			var existing GroupLicenseAssignmentModel
+			if err = metadata.Decode(&existing); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			skuPartNumber := sku.SkuPartNumber.GetOrZero()
+			if strings.EqualFold(existing.SkuPartNumber, skuPartNumber) {
+				skuPartNumber = existing.SkuPartNumber
+			}
+
+			state := GroupLicenseAssignmentModel{
+				DisabledServicePlans: licenses.FlattenServicePlans(*sku, license.DisabledPlans, existing.DisabledServicePlans),
+				SkuId:                id.SkuId,
+				SkuPartNumber:        skuPartNumber,
+				GroupId:              stable.NewGroupID(id.GroupId).ID(),
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r GroupLicenseAssignmentResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Groups.GroupClientBeta
+
+			id, err := parse.ParseGroupLicenseAssignmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model GroupLicenseAssignmentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			sku, err := licenses.FindSubscribedSku(ctx, client.Client, id.SkuId, "")
+			if err != nil {
+				return err
+			}
+
+			disabledPlans, err := licenses.ExpandServicePlans(*sku, model.DisabledServicePlans)
+			if err != nil {
+				return err
+			}
+
+			tf.LockByName(groupResourceName, id.GroupId)
+			defer tf.UnlockByName(groupResourceName, id.GroupId)
+
+			// Assigning a license which is already assigned replaces its disabled plans
+			request := groupBeta.AssignLicenseRequest{
+				AddLicenses: &[]beta.AssignedLicense{
+					{
+						SkuId:         nullable.Value(id.SkuId),
+						DisabledPlans: disabledPlans,
+					},
+				},
+				RemoveLicenses: &[]string{},
+			}
+
+			if _, err = client.AssignLicense(ctx, beta.NewGroupID(id.GroupId), request, groupBeta.DefaultAssignLicenseOperationOptions()); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r GroupLicenseAssignmentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Groups.GroupClientBeta
+
+			id, err := parse.ParseGroupLicenseAssignmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			tf.LockByName(groupResourceName, id.GroupId)
+			defer tf.UnlockByName(groupResourceName, id.GroupId)
+
+			request := groupBeta.AssignLicenseRequest{
+				AddLicenses:    &[]beta.AssignedLicense{},
+				RemoveLicenses: &[]string{id.SkuId},
+			}
+
+			if _, err = client.AssignLicense(ctx, beta.NewGroupID(id.GroupId), request, groupBeta.DefaultAssignLicenseOperationOptions()); err != nil {
+				return fmt.Errorf("removing %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// findGroupAssignedLicense returns the license with the specified SKU which is assigned to a group, or nil if the group
+// or license was not found
+func findGroupAssignedLicense(ctx context.Context, client *groupBeta.GroupClient, id parse.GroupLicenseAssignmentId) (*beta.AssignedLicense, error) {
+	groupId := beta.NewGroupID(id.GroupId)
+
+	options := groupBeta.GetGroupOperationOptions{
+		Select: &[]string{"assignedLicenses", "id"},
+	}
+
+	resp, err := client.GetGroup(ctx, groupId, options)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", groupId, err)
+	}
+	if resp.Model == nil {
+		return nil, fmt.Errorf("retrieving %s: model was nil", groupId)
+	}
+
+	for _, license := range pointer.From(resp.Model.AssignedLicenses) {
+		if strings.EqualFold(license.SkuId.GetOrZero(), id.SkuId) {
+			return &license, nil
+		}
+	}
+
+	return nil, nil
+}
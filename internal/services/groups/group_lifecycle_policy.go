@@ -0,0 +1,174 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package groups
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/msgraph"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/groups/parse"
+)
+
+// The SDK only exposes group lifecycle policies beneath /groups/{groupId}/groupLifecyclePolicies, so requests to create,
+// retrieve, update and delete policies in the tenant-wide /groupLifecyclePolicies collection are made using the
+// underlying client.
+
+const (
+	groupLifecyclePolicyManagedGroupTypesAll      = "All"
+	groupLifecyclePolicyManagedGroupTypesNone     = "None"
+	groupLifecyclePolicyManagedGroupTypesSelected = "Selected"
+)
+
+var possibleValuesForGroupLifecyclePolicyManagedGroupTypes = []string{
+	groupLifecyclePolicyManagedGroupTypesAll,
+	groupLifecyclePolicyManagedGroupTypesNone,
+	groupLifecyclePolicyManagedGroupTypesSelected,
+}
+
+type groupLifecyclePolicyResponse struct {
+	HttpResponse *http.Response
+	Model        *stable.GroupLifecyclePolicy
+}
+
+func listGroupLifecyclePolicies(ctx context.Context, c *msgraph.Client) (*[]stable.GroupLifecyclePolicy, error) {
+	opts := client.RequestOptions{
+		ContentType:         "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{http.StatusOK},
+		HttpMethod:          http.MethodGet,
+		Path:                "/groupLifecyclePolicies",
+	}
+
+	req, err := c.NewRequest(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var values struct {
+		Values *[]stable.GroupLifecyclePolicy `json:"value"`
+	}
+	if err = resp.Unmarshal(&values); err != nil {
+		return nil, err
+	}
+
+	return values.Values, nil
+}
+
+func createGroupLifecyclePolicy(ctx context.Context, c *msgraph.Client, input stable.GroupLifecyclePolicy) (*stable.GroupLifecyclePolicy, error) {
+	opts := client.RequestOptions{
+		ContentType:         "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{http.StatusCreated, http.StatusOK},
+		HttpMethod:          http.MethodPost,
+		Path:                "/groupLifecyclePolicies",
+	}
+
+	req, err := c.NewRequest(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = req.Marshal(input); err != nil {
+		return nil, err
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var model stable.GroupLifecyclePolicy
+	if err = resp.Unmarshal(&model); err != nil {
+		return nil, err
+	}
+
+	return &model, nil
+}
+
+func getGroupLifecyclePolicy(ctx context.Context, c *msgraph.Client, id parse.GroupLifecyclePolicyId) (result groupLifecyclePolicyResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType:         "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{http.StatusOK},
+		HttpMethod:          http.MethodGet,
+		Path:                id.ID(),
+	}
+
+	req, err := c.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	resp, err := req.Execute(ctx)
+	if resp != nil {
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var model stable.GroupLifecyclePolicy
+	result.Model = &model
+	err = resp.Unmarshal(result.Model)
+
+	return
+}
+
+func updateGroupLifecyclePolicy(ctx context.Context, c *msgraph.Client, id parse.GroupLifecyclePolicyId, input stable.GroupLifecyclePolicy) error {
+	opts := client.RequestOptions{
+		ContentType:         "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{http.StatusNoContent, http.StatusOK},
+		HttpMethod:          http.MethodPatch,
+		Path:                id.ID(),
+	}
+
+	req, err := c.NewRequest(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	if err = req.Marshal(input); err != nil {
+		return err
+	}
+
+	_, err = req.Execute(ctx)
+	return err
+}
+
+func deleteGroupLifecyclePolicy(ctx context.Context, c *msgraph.Client, id parse.GroupLifecyclePolicyId) error {
+	opts := client.RequestOptions{
+		ContentType:         "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{http.StatusNoContent, http.StatusOK},
+		HttpMethod:          http.MethodDelete,
+		Path:                id.ID(),
+	}
+
+	req, err := c.NewRequest(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	_, err = req.Execute(ctx)
+	return err
+}
+
+func expandGroupLifecyclePolicyNotificationEmails(input []string) string {
+	return strings.Join(input, ";")
+}
+
+func flattenGroupLifecyclePolicyNotificationEmails(input string) []string {
+	result := make([]string, 0)
+	for _, email := range strings.Split(input, ";") {
+		if email = strings.TrimSpace(email); email != "" {
+			result = append(result, email)
+		}
+	}
+	return result
+}
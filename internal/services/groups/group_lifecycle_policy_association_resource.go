@@ -0,0 +1,206 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package groups
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/groups/stable/grouplifecyclepolicy"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/groups/parse"
+)
+
+type GroupLifecyclePolicyAssociationModel struct {
+	GroupId                string `tfschema:"group_id"`
+	GroupLifecyclePolicyId string `tfschema:"group_lifecycle_policy_id"`
+}
+
+var _ sdk.Resource = GroupLifecyclePolicyAssociationResource{}
+
+type GroupLifecyclePolicyAssociationResource struct{}
+
+func (r GroupLifecyclePolicyAssociationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return stable.ValidateGroupIdGroupLifecyclePolicyID
+}
+
+func (r GroupLifecyclePolicyAssociationResource) ResourceType() string {
+	return "azuread_group_lifecycle_policy_association"
+}
+
+func (r GroupLifecyclePolicyAssociationResource) ModelObject() interface{} {
+	return &GroupLifecyclePolicyAssociationModel{}
+}
+
+func (r GroupLifecyclePolicyAssociationResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"group_lifecycle_policy_id": {
+			Description:  "The resource ID of the group lifecycle policy",
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: parse.ValidateGroupLifecyclePolicyID,
+		},
+
+		"group_id": {
+			Description:  "The resource ID of the Microsoft 365 group to add to the policy",
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: stable.ValidateGroupID,
+		},
+	}
+}
+
+func (r GroupLifecyclePolicyAssociationResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r GroupLifecyclePolicyAssociationResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Groups.GroupLifecyclePolicyClient
+
+			var model GroupLifecyclePolicyAssociationModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			policyId, err := parse.ParseGroupLifecyclePolicyID(model.GroupLifecyclePolicyId)
+			if err != nil {
+				return err
+			}
+
+			groupId, err := stable.ParseGroupID(model.GroupId)
+			if err != nil {
+				return err
+			}
+
+			id := stable.NewGroupIdGroupLifecyclePolicyID(groupId.GroupId, policyId.GroupLifecyclePolicyId)
+
+			resp, err := getGroupLifecyclePolicy(ctx, client.Client, *policyId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", policyId, err)
+			}
+			if resp.Model == nil {
+				return fmt.Errorf("retrieving %s: model was nil", policyId)
+			}
+
+			// Groups can only be individually added to a policy which applies to selected groups
+			if managedGroupTypes := resp.Model.ManagedGroupTypes.GetOrZero(); managedGroupTypes != groupLifecyclePolicyManagedGroupTypesSelected {
+				return fmt.Errorf("groups can only be added to a group lifecycle policy when `managed_group_types` is %q, %s currently applies to %q", groupLifecyclePolicyManagedGroupTypesSelected, policyId, managedGroupTypes)
+			}
+
+			existing, err := findGroupLifecyclePolicyForGroup(ctx, client, id)
+			if err != nil {
+				return err
+			}
+			if existing {
+				return tf.ImportAsExistsError(r.ResourceType(), id.ID())
+			}
+
+			request := grouplifecyclepolicy.AddGroupLifecyclePolicyGroupRequest{
+				GroupId: pointer.To(groupId.GroupId),
+			}
+
+			addResp, err := client.AddGroupLifecyclePolicyGroup(ctx, id, request, grouplifecyclepolicy.DefaultAddGroupLifecyclePolicyGroupOperationOptions())
+			if err != nil {
+				return fmt.Errorf("adding %s to %s: %+v", groupId, policyId, err)
+			}
+			if addResp.Model == nil || !pointer.From(addResp.Model.Value) {
+				return fmt.Errorf("adding %s to %s: the API reported that the group was not added", groupId, policyId)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r GroupLifecyclePolicyAssociationResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Groups.GroupLifecyclePolicyClient
+
+			id, err := stable.ParseGroupIdGroupLifecyclePolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			exists, err := findGroupLifecyclePolicyForGroup(ctx, client, *id)
+			if err != nil {
+				return err
+			}
+			if !exists {
+				return metadata.MarkAsGone(id)
+			}
+
+			state := GroupLifecyclePolicyAssociationModel{
+				GroupId:                stable.NewGroupID(id.GroupId).ID(),
+				GroupLifecyclePolicyId: parse.NewGroupLifecyclePolicyID(id.GroupLifecyclePolicyId).ID(),
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r GroupLifecyclePolicyAssociationResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Groups.GroupLifecyclePolicyClient
+
+			id, err := stable.ParseGroupIdGroupLifecyclePolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			request := grouplifecyclepolicy.RemoveGroupLifecyclePolicyGroupRequest{
+				GroupId: pointer.To(id.GroupId),
+			}
+
+			resp, err := client.RemoveGroupLifecyclePolicyGroup(ctx, *id, request, grouplifecyclepolicy.DefaultRemoveGroupLifecyclePolicyGroupOperationOptions())
+			if err != nil {
+				return fmt.Errorf("removing %s: %+v", id, err)
+			}
+			if resp.Model == nil || !pointer.From(resp.Model.Value) {
+				return fmt.Errorf("removing %s: the API reported that the group was not removed", id)
+			}
+
+			return nil
+		},
+	}
+}
+
+// findGroupLifecyclePolicyForGroup determines whether the specified group lifecycle policy applies to a group
+func findGroupLifecyclePolicyForGroup(ctx context.Context, client *grouplifecyclepolicy.GroupLifecyclePolicyClient, id stable.GroupIdGroupLifecyclePolicyId) (bool, error) {
+	groupId := stable.NewGroupID(id.GroupId)
+
+	resp, err := client.ListGroupLifecyclePolicies(ctx, groupId, grouplifecyclepolicy.DefaultListGroupLifecyclePoliciesOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return false, nil
+		}
+		return false, fmt.Errorf("listing group lifecycle policies for %s: %+v", groupId, err)
+	}
+
+	for _, policy := range pointer.From(resp.Model) {
+		if strings.EqualFold(pointer.From(policy.Id), id.GroupLifecyclePolicyId) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
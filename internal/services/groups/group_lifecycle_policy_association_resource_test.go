@@ -0,0 +1,113 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package groups_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/groups/stable/grouplifecyclepolicy"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+)
+
+type GroupLifecyclePolicyAssociationResource struct{}
+
+func TestAccGroupLifecyclePolicyAssociation_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group_lifecycle_policy_association", "test")
+	r := GroupLifecyclePolicyAssociationResource{}
+
+	// Only one group lifecycle policy can exist in a tenant, so these tests must not run in parallel
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccGroupLifecyclePolicyAssociation_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group_lifecycle_policy_association", "test")
+	r := GroupLifecyclePolicyAssociationResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport(data)),
+	})
+}
+
+func (r GroupLifecyclePolicyAssociationResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Groups.GroupLifecyclePolicyClient
+
+	id, err := stable.ParseGroupIdGroupLifecyclePolicyID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.ListGroupLifecyclePolicies(ctx, stable.NewGroupID(id.GroupId), grouplifecyclepolicy.DefaultListGroupLifecyclePoliciesOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to list group lifecycle policies for %s: %v", id, err)
+	}
+
+	for _, policy := range pointer.From(resp.Model) {
+		if strings.EqualFold(pointer.From(policy.Id), id.GroupLifecyclePolicyId) {
+			return pointer.To(true), nil
+		}
+	}
+
+	return pointer.To(false), nil
+}
+
+func (GroupLifecyclePolicyAssociationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_group_lifecycle_policy" "test" {
+  group_lifetime_in_days = 180
+  managed_group_types    = "Selected"
+}
+
+resource "azuread_group" "test" {
+  display_name     = "acctestGroup-%[1]d"
+  types            = ["Unified"]
+  mail_enabled     = true
+  mail_nickname    = "acctest.Group-%[1]d"
+  security_enabled = false
+}
+
+resource "azuread_group_lifecycle_policy_association" "test" {
+  group_lifecycle_policy_id = azuread_group_lifecycle_policy.test.id
+  group_id                  = azuread_group.test.id
+}
+`, data.RandomInteger)
+}
+
+func (r GroupLifecyclePolicyAssociationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_group_lifecycle_policy_association" "import" {
+  group_lifecycle_policy_id = azuread_group_lifecycle_policy_association.test.group_lifecycle_policy_id
+  group_id                  = azuread_group_lifecycle_policy_association.test.group_id
+}
+`, r.basic(data))
+}
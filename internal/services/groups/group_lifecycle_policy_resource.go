@@ -0,0 +1,213 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package groups
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/hashicorp/terraform-provider-azuread/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/groups/parse"
+)
+
+type GroupLifecyclePolicyModel struct {
+	AlternateNotificationEmails []string `tfschema:"alternate_notification_emails"`
+	GroupLifetimeInDays         int64    `tfschema:"group_lifetime_in_days"`
+	ManagedGroupTypes           string   `tfschema:"managed_group_types"`
+}
+
+var _ sdk.ResourceWithUpdate = GroupLifecyclePolicyResource{}
+
+type GroupLifecyclePolicyResource struct{}
+
+func (r GroupLifecyclePolicyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return parse.ValidateGroupLifecyclePolicyID
+}
+
+func (r GroupLifecyclePolicyResource) ResourceType() string {
+	return "azuread_group_lifecycle_policy"
+}
+
+func (r GroupLifecyclePolicyResource) ModelObject() interface{} {
+	return &GroupLifecyclePolicyModel{}
+}
+
+func (r GroupLifecyclePolicyResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"group_lifetime_in_days": {
+			Description:  "The number of days before a group expires and needs to be renewed",
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntAtLeast(30),
+		},
+
+		"managed_group_types": {
+			Description:  "The Microsoft 365 groups to which the policy applies",
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(possibleValuesForGroupLifecyclePolicyManagedGroupTypes, false),
+		},
+
+		"alternate_notification_emails": {
+			Description: "A list of email addresses to which notifications are sent for groups without owners",
+			Type:        pluginsdk.TypeList,
+			Optional:    true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func (r GroupLifecyclePolicyResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r GroupLifecyclePolicyResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Groups.GroupLifecyclePolicyClient.Client
+
+			var model GroupLifecyclePolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// Only one group lifecycle policy can exist in a tenant
+			existing, err := listGroupLifecyclePolicies(ctx, client)
+			if err != nil {
+				return fmt.Errorf("listing group lifecycle policies: %+v", err)
+			}
+			for _, policy := range pointer.From(existing) {
+				if policy.Id != nil {
+					return tf.ImportAsExistsError(r.ResourceType(), parse.NewGroupLifecyclePolicyID(*policy.Id).ID())
+				}
+			}
+
+			properties := stable.GroupLifecyclePolicy{
+				AlternateNotificationEmails: nullable.NoZero(expandGroupLifecyclePolicyNotificationEmails(model.AlternateNotificationEmails)),
+				GroupLifetimeInDays:         nullable.Value(model.GroupLifetimeInDays),
+				ManagedGroupTypes:           nullable.Value(model.ManagedGroupTypes),
+			}
+
+			policy, err := createGroupLifecyclePolicy(ctx, client, properties)
+			if err != nil {
+				return fmt.Errorf("creating group lifecycle policy: %+v", err)
+			}
+			if policy.Id == nil {
+				return errors.New("creating group lifecycle policy: model returned with nil ID")
+			}
+
+			id := parse.NewGroupLifecyclePolicyID(*policy.Id)
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r GroupLifecyclePolicyResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Groups.GroupLifecyclePolicyClient.Client
+
+			id, err := parse.ParseGroupLifecyclePolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := getGroupLifecyclePolicy(ctx, client, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			policy := resp.Model
+			if policy == nil {
+				return fmt.Errorf("retrieving %s: model was nil", id)
+			}
+
+			state := GroupLifecyclePolicyModel{
+				AlternateNotificationEmails: flattenGroupLifecyclePolicyNotificationEmails(policy.AlternateNotificationEmails.GetOrZero()),
+				GroupLifetimeInDays:         policy.GroupLifetimeInDays.GetOrZero(),
+				ManagedGroupTypes:           policy.ManagedGroupTypes.GetOrZero(),
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r GroupLifecyclePolicyResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Groups.GroupLifecyclePolicyClient.Client
+
+			id, err := parse.ParseGroupLifecyclePolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model GroupLifecyclePolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			properties := stable.GroupLifecyclePolicy{}
+
+			if metadata.ResourceData.HasChange("alternate_notification_emails") {
+				properties.AlternateNotificationEmails = nullable.Value(expandGroupLifecyclePolicyNotificationEmails(model.AlternateNotificationEmails))
+			}
+
+			if metadata.ResourceData.HasChange("group_lifetime_in_days") {
+				properties.GroupLifetimeInDays = nullable.Value(model.GroupLifetimeInDays)
+			}
+
+			if metadata.ResourceData.HasChange("managed_group_types") {
+				properties.ManagedGroupTypes = nullable.Value(model.ManagedGroupTypes)
+			}
+
+			if err = updateGroupLifecyclePolicy(ctx, client, *id, properties); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r GroupLifecyclePolicyResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Groups.GroupLifecyclePolicyClient.Client
+
+			id, err := parse.ParseGroupLifecyclePolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err = deleteGroupLifecyclePolicy(ctx, client, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package groups_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/groups/parse"
+)
+
+type GroupLifecyclePolicyResource struct{}
+
+func TestAccGroupLifecyclePolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group_lifecycle_policy", "test")
+	r := GroupLifecyclePolicyResource{}
+
+	// Only one group lifecycle policy can exist in a tenant, so these tests must not run in parallel
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccGroupLifecyclePolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group_lifecycle_policy", "test")
+	r := GroupLifecyclePolicyResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("alternate_notification_emails.#").HasValue("2"),
+				check.That(data.ResourceName).Key("group_lifetime_in_days").HasValue("365"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r GroupLifecyclePolicyResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	c := clients.Groups.GroupLifecyclePolicyClient.Client
+
+	id, err := parse.ParseGroupLifecyclePolicyID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.NewRequest(ctx, client.RequestOptions{
+		ContentType:         "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{http.StatusOK},
+		HttpMethod:          http.MethodGet,
+		Path:                id.ID(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		if resp != nil && response.WasNotFound(resp.Response) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to retrieve %s: %v", id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (GroupLifecyclePolicyResource) basic() string {
+	return `
+provider "azuread" {}
+
+resource "azuread_group_lifecycle_policy" "test" {
+  group_lifetime_in_days = 180
+  managed_group_types    = "Selected"
+}
+`
+}
+
+func (GroupLifecyclePolicyResource) complete() string {
+	return `
+provider "azuread" {}
+
+resource "azuread_group_lifecycle_policy" "test" {
+  group_lifetime_in_days        = 365
+  managed_group_types           = "Selected"
+  alternate_notification_emails = ["admin@example.com", "security@example.com"]
+}
+`
+}
@@ -274,6 +274,12 @@ func groupResource() *pluginsdk.Resource {
 				Default:     false,
 			},
 
+			"expiration_date_time": {
+				Description: "The date and time when the group is set to expire, as determined by the group lifecycle policy",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+
 			"mail": {
 				Description: "The SMTP address for the group",
 				Type:        pluginsdk.TypeString,
@@ -330,6 +336,12 @@ func groupResource() *pluginsdk.Resource {
 					Type: pluginsdk.TypeString,
 				},
 			},
+
+			"renewed_date_time": {
+				Description: "The date and time when the group was last renewed",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -1258,6 +1270,7 @@ func groupResourceReadFunc(enableRetries bool) pluginsdk.ReadContextFunc {
 		tf.Set(d, "behaviors", tf.FlattenStringSlicePtr(group.ResourceBehaviorOptions))
 		tf.Set(d, "description", group.Description.GetOrZero())
 		tf.Set(d, "display_name", group.DisplayName.GetOrZero())
+		tf.Set(d, "expiration_date_time", group.ExpirationDateTime.GetOrZero())
 		tf.Set(d, "mail_enabled", group.MailEnabled.GetOrZero())
 		tf.Set(d, "mail", group.Mail.GetOrZero())
 		tf.Set(d, "mail_nickname", group.MailNickname.GetOrZero())
@@ -1270,6 +1283,7 @@ func groupResourceReadFunc(enableRetries bool) pluginsdk.ReadContextFunc {
 		tf.Set(d, "preferred_language", group.PreferredLanguage.GetOrZero())
 		tf.Set(d, "provisioning_options", tf.FlattenStringSlicePtr(group.ResourceProvisioningOptions))
 		tf.Set(d, "proxy_addresses", tf.FlattenStringSlicePtr(group.ProxyAddresses))
+		tf.Set(d, "renewed_date_time", group.RenewedDateTime.GetOrZero())
 		tf.Set(d, "security_enabled", group.SecurityEnabled.GetOrZero())
 		tf.Set(d, "theme", group.Theme.GetOrZero())
 		tf.Set(d, "types", tf.FlattenStringSlicePtr(group.GroupTypes))
@@ -23,6 +23,45 @@ import (
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
 )
 
+// groupsFilterProperties are the group properties which can be referenced in an advanced query filter
+var groupsFilterProperties = []string{
+	"assignedLabels",
+	"assignedLicenses",
+	"classification",
+	"createdDateTime",
+	"description",
+	"displayName",
+	"expirationDateTime",
+	"groupTypes",
+	"hasMembersWithLicenseErrors",
+	"id",
+	"isAssignableToRole",
+	"mail",
+	"mailEnabled",
+	"mailNickname",
+	"membershipRule",
+	"membershipRuleProcessingState",
+	"onPremisesSamAccountName",
+	"onPremisesSecurityIdentifier",
+	"onPremisesSyncEnabled",
+	"preferredLanguage",
+	"proxyAddresses",
+	"renewedDateTime",
+	"securityEnabled",
+	"securityIdentifier",
+	"theme",
+	"visibility",
+}
+
+// groupsSearchProperties are the group properties which can be referenced in a search expression
+var groupsSearchProperties = []string{
+	"description",
+	"displayName",
+	"mail",
+	"mailNickname",
+	"proxyAddresses",
+}
+
 func groupsDataSource() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		ReadContext: groupsDataSourceRead,
@@ -33,11 +72,12 @@ func groupsDataSource() *pluginsdk.Resource {
 
 		Schema: map[string]*pluginsdk.Schema{
 			"object_ids": {
-				Description:  "The object IDs of the groups",
-				Type:         pluginsdk.TypeList,
-				Optional:     true,
-				Computed:     true,
-				ExactlyOneOf: []string{"display_names", "display_name_prefix", "object_ids", "return_all"},
+				Description:   "The object IDs of the groups",
+				Type:          pluginsdk.TypeList,
+				Optional:      true,
+				Computed:      true,
+				AtLeastOneOf:  []string{"display_names", "display_name_prefix", "object_ids", "filter", "return_all", "search"},
+				ConflictsWith: []string{"display_names", "display_name_prefix", "filter", "return_all", "search"},
 				Elem: &pluginsdk.Schema{
 					Type:         pluginsdk.TypeString,
 					ValidateFunc: validation.IsUUID,
@@ -45,11 +85,12 @@ func groupsDataSource() *pluginsdk.Resource {
 			},
 
 			"display_names": {
-				Description:  "The display names of the groups",
-				Type:         pluginsdk.TypeList,
-				Optional:     true,
-				Computed:     true,
-				ExactlyOneOf: []string{"display_names", "display_name_prefix", "object_ids", "return_all"},
+				Description:   "The display names of the groups",
+				Type:          pluginsdk.TypeList,
+				Optional:      true,
+				Computed:      true,
+				AtLeastOneOf:  []string{"display_names", "display_name_prefix", "object_ids", "filter", "return_all", "search"},
+				ConflictsWith: []string{"display_name_prefix", "object_ids", "filter", "return_all", "search"},
 				Elem: &pluginsdk.Schema{
 					Type:         pluginsdk.TypeString,
 					ValidateFunc: validation.StringIsNotEmpty,
@@ -57,12 +98,31 @@ func groupsDataSource() *pluginsdk.Resource {
 			},
 
 			"display_name_prefix": {
-				Description:  "Common display name prefix of the groups",
-				Type:         pluginsdk.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ExactlyOneOf: []string{"display_names", "display_name_prefix", "object_ids", "return_all"},
-				ValidateFunc: validation.StringIsNotEmpty,
+				Description:   "Common display name prefix of the groups",
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				Computed:      true,
+				AtLeastOneOf:  []string{"display_names", "display_name_prefix", "object_ids", "filter", "return_all", "search"},
+				ConflictsWith: []string{"display_names", "object_ids", "filter", "return_all", "search"},
+				ValidateFunc:  validation.StringIsNotEmpty,
+			},
+
+			"filter": {
+				Description:   "An OData `$filter` expression used to find groups. This is sent as an advanced query, using the `ConsistencyLevel: eventual` header and `$count`, so that additional properties and operators can be used",
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				AtLeastOneOf:  []string{"display_names", "display_name_prefix", "object_ids", "filter", "return_all", "search"},
+				ConflictsWith: []string{"display_names", "display_name_prefix", "object_ids", "return_all"},
+				ValidateFunc:  validation.ODataFilter(groupsFilterProperties),
+			},
+
+			"search": {
+				Description:   "An OData `$search` expression used to find groups, in the form `\"property:value\"`. This is sent using the `ConsistencyLevel: eventual` header and `$count`, and is combined with `filter` when both are specified",
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				AtLeastOneOf:  []string{"display_names", "display_name_prefix", "object_ids", "filter", "return_all", "search"},
+				ConflictsWith: []string{"display_names", "display_name_prefix", "object_ids", "return_all"},
+				ValidateFunc:  validation.ODataSearch(groupsSearchProperties),
 			},
 
 			"ignore_missing": {
@@ -70,15 +130,15 @@ func groupsDataSource() *pluginsdk.Resource {
 				Type:          pluginsdk.TypeBool,
 				Optional:      true,
 				Default:       false,
-				ConflictsWith: []string{"return_all"},
+				ConflictsWith: []string{"filter", "return_all", "search"},
 			},
 
 			"return_all": {
 				Description:   "Retrieve all groups with no filter",
 				Type:          pluginsdk.TypeBool,
 				Optional:      true,
-				ConflictsWith: []string{"ignore_missing"},
-				ExactlyOneOf:  []string{"display_names", "display_name_prefix", "object_ids", "return_all"},
+				AtLeastOneOf:  []string{"display_names", "display_name_prefix", "object_ids", "filter", "return_all", "search"},
+				ConflictsWith: []string{"ignore_missing", "display_names", "display_name_prefix", "object_ids", "filter", "search"},
 			},
 
 			"mail_enabled": {
@@ -108,6 +168,8 @@ func groupsDataSourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta i
 	var ignoreMissing = d.Get("ignore_missing").(bool)
 	var returnAll = d.Get("return_all").(bool)
 	var displayNamePrefix = d.Get("display_name_prefix").(string)
+	var advancedFilter = d.Get("filter").(string)
+	var search = d.Get("search").(string)
 
 	var displayNames []interface{}
 	if v, ok := d.GetOk("display_names"); ok {
@@ -138,6 +200,41 @@ func groupsDataSourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta i
 			return tf.ErrorDiagPathF(err, "return_all", "No groups found")
 		}
 
+		groups = append(groups, *resp.Model...)
+	} else if advancedFilter != "" || search != "" {
+		if advancedFilter != "" {
+			filter = append(filter, fmt.Sprintf("(%s)", advancedFilter))
+		}
+
+		// Advanced queries require the ConsistencyLevel header and a $count parameter
+		options := groupBeta.ListGroupsOperationOptions{
+			ConsistencyLevel: pointer.To(odata.ConsistencyLevelEventual),
+			Count:            pointer.To(true),
+		}
+		if len(filter) > 0 {
+			options.Filter = pointer.To(strings.Join(filter, " and "))
+		}
+		if search != "" {
+			options.Search = pointer.To(search)
+		}
+
+		resp, err := client.ListGroups(ctx, options)
+		if err != nil {
+			if advancedFilter == "" {
+				return tf.ErrorDiagPathF(err, "search", "Could not retrieve groups matching search: %q", search)
+			}
+			return tf.ErrorDiagPathF(err, "filter", "Could not retrieve groups matching filter: %q", advancedFilter)
+		}
+		if resp.Model == nil {
+			return tf.ErrorDiagF(errors.New("model was nil"), "Bad API response")
+		}
+		if len(*resp.Model) == 0 {
+			if advancedFilter == "" {
+				return tf.ErrorDiagPathF(nil, "search", "No groups found matching search: %q", search)
+			}
+			return tf.ErrorDiagPathF(nil, "filter", "No groups found matching filter: %q", advancedFilter)
+		}
+
 		groups = append(groups, *resp.Model...)
 	} else if displayNamePrefix != "" {
 		options := groupBeta.ListGroupsOperationOptions{
@@ -204,7 +301,7 @@ func groupsDataSourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta i
 		}
 	}
 
-	if !returnAll && !ignoreMissing && displayNamePrefix == "" && len(groups) != expectedCount {
+	if !returnAll && !ignoreMissing && displayNamePrefix == "" && advancedFilter == "" && search == "" && len(groups) != expectedCount {
 		return tf.ErrorDiagF(fmt.Errorf("expected: %d, actual: %d", expectedCount, len(groups)), "Unexpected number of groups returned")
 	}
 
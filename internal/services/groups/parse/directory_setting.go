@@ -0,0 +1,80 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = &DirectorySettingId{}
+
+// DirectorySettingId is the ID of a tenant-wide directory setting, which is exposed by the API as a group setting
+type DirectorySettingId struct {
+	GroupSettingId string
+}
+
+func NewDirectorySettingID(groupSettingId string) DirectorySettingId {
+	return DirectorySettingId{
+		GroupSettingId: groupSettingId,
+	}
+}
+
+// ParseDirectorySettingID parses 'input' into a DirectorySettingId
+func ParseDirectorySettingID(input string) (*DirectorySettingId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&DirectorySettingId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := DirectorySettingId{}
+	if err = id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+// ValidateDirectorySettingID checks that 'input' can be parsed as a DirectorySettingId
+func ValidateDirectorySettingID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseDirectorySettingID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+func (id *DirectorySettingId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.GroupSettingId, ok = input.Parsed["groupSettingId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "groupSettingId", input)
+	}
+
+	return nil
+}
+
+func (id DirectorySettingId) ID() string {
+	return fmt.Sprintf("/groupSettings/%s", id.GroupSettingId)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this ID
+func (id DirectorySettingId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("groupSettings", "groupSettings", "groupSettings"),
+		resourceids.UserSpecifiedSegment("groupSettingId", "groupSettingId"),
+	}
+}
+
+func (id DirectorySettingId) String() string {
+	return fmt.Sprintf("Directory Setting (Group Setting: %q)", id.GroupSettingId)
+}
@@ -0,0 +1,88 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = &GroupLicenseAssignmentId{}
+
+// GroupLicenseAssignmentId is the ID of a license assigned to a group, which is identified by its SKU ID
+type GroupLicenseAssignmentId struct {
+	GroupId string
+	SkuId   string
+}
+
+func NewGroupLicenseAssignmentID(groupId string, skuId string) GroupLicenseAssignmentId {
+	return GroupLicenseAssignmentId{
+		GroupId: groupId,
+		SkuId:   skuId,
+	}
+}
+
+// ParseGroupLicenseAssignmentID parses 'input' into a GroupLicenseAssignmentId
+func ParseGroupLicenseAssignmentID(input string) (*GroupLicenseAssignmentId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&GroupLicenseAssignmentId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := GroupLicenseAssignmentId{}
+	if err = id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+// ValidateGroupLicenseAssignmentID checks that 'input' can be parsed as a GroupLicenseAssignmentId
+func ValidateGroupLicenseAssignmentID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseGroupLicenseAssignmentID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+func (id *GroupLicenseAssignmentId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.GroupId, ok = input.Parsed["groupId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "groupId", input)
+	}
+
+	if id.SkuId, ok = input.Parsed["skuId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "skuId", input)
+	}
+
+	return nil
+}
+
+func (id GroupLicenseAssignmentId) ID() string {
+	return fmt.Sprintf("/groups/%s/assignedLicenses/%s", id.GroupId, id.SkuId)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this ID
+func (id GroupLicenseAssignmentId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("groups", "groups", "groups"),
+		resourceids.UserSpecifiedSegment("groupId", "groupId"),
+		resourceids.StaticSegment("assignedLicenses", "assignedLicenses", "assignedLicenses"),
+		resourceids.UserSpecifiedSegment("skuId", "skuId"),
+	}
+}
+
+func (id GroupLicenseAssignmentId) String() string {
+	return fmt.Sprintf("Group License Assignment (Group: %q, SKU: %q)", id.GroupId, id.SkuId)
+}
@@ -0,0 +1,80 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = &GroupLifecyclePolicyId{}
+
+// GroupLifecyclePolicyId is the ID of a tenant-wide group lifecycle policy
+type GroupLifecyclePolicyId struct {
+	GroupLifecyclePolicyId string
+}
+
+func NewGroupLifecyclePolicyID(groupLifecyclePolicyId string) GroupLifecyclePolicyId {
+	return GroupLifecyclePolicyId{
+		GroupLifecyclePolicyId: groupLifecyclePolicyId,
+	}
+}
+
+// ParseGroupLifecyclePolicyID parses 'input' into a GroupLifecyclePolicyId
+func ParseGroupLifecyclePolicyID(input string) (*GroupLifecyclePolicyId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&GroupLifecyclePolicyId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := GroupLifecyclePolicyId{}
+	if err = id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+// ValidateGroupLifecyclePolicyID checks that 'input' can be parsed as a GroupLifecyclePolicyId
+func ValidateGroupLifecyclePolicyID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseGroupLifecyclePolicyID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+func (id *GroupLifecyclePolicyId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.GroupLifecyclePolicyId, ok = input.Parsed["groupLifecyclePolicyId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "groupLifecyclePolicyId", input)
+	}
+
+	return nil
+}
+
+func (id GroupLifecyclePolicyId) ID() string {
+	return fmt.Sprintf("/groupLifecyclePolicies/%s", id.GroupLifecyclePolicyId)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this ID
+func (id GroupLifecyclePolicyId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("groupLifecyclePolicies", "groupLifecyclePolicies", "groupLifecyclePolicies"),
+		resourceids.UserSpecifiedSegment("groupLifecyclePolicyId", "groupLifecyclePolicyId"),
+	}
+}
+
+func (id GroupLifecyclePolicyId) String() string {
+	return fmt.Sprintf("Group Lifecycle Policy (Group Lifecycle Policy: %q)", id.GroupLifecyclePolicyId)
+}
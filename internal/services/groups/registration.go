@@ -3,7 +3,10 @@
 
 package groups
 
-import "github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+import (
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/sdk"
+)
 
 type Registration struct{}
 
@@ -40,3 +43,21 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azuread_group_member":          groupMemberResource(),
 	}
 }
+
+// DataSources returns the typed DataSources supported by this service
+func (r Registration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{
+		TransitiveMembershipsDataSource{},
+	}
+}
+
+// Resources returns the typed Resources supported by this service
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		DirectorySettingResource{},
+		GroupDirectorySettingResource{},
+		GroupLicenseAssignmentResource{},
+		GroupLifecyclePolicyAssociationResource{},
+		GroupLifecyclePolicyResource{},
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package groups
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/msgraph"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+// The SDK exposes memberOf and transitiveMemberOf separately for each principal type, so for principals other than
+// groups these are retrieved using the underlying client, with the collection path determined by the principal type.
+
+var principalCollectionsByODataType = map[string]string{
+	"#microsoft.graph.device":           "devices",
+	"#microsoft.graph.group":            "groups",
+	"#microsoft.graph.orgContact":       "contacts",
+	"#microsoft.graph.servicePrincipal": "servicePrincipals",
+	"#microsoft.graph.user":             "users",
+}
+
+type directoryObjectMembership struct {
+	ODataType   *string `json:"@odata.type"`
+	Id          *string `json:"id"`
+	DisplayName *string `json:"displayName"`
+}
+
+type directoryObjectMembershipsPager struct {
+	NextLink *odata.Link `json:"@odata.nextLink"`
+}
+
+func (p *directoryObjectMembershipsPager) NextPageLink() *odata.Link {
+	defer func() {
+		p.NextLink = nil
+	}()
+
+	return p.NextLink
+}
+
+// listDirectoryObjectMemberships retrieves the memberships for a principal, where relationship is either `memberOf`
+// or `transitiveMemberOf`
+func listDirectoryObjectMemberships(ctx context.Context, c *msgraph.Client, collection, objectId, relationship string) (*[]directoryObjectMembership, error) {
+	opts := client.RequestOptions{
+		ContentType:         "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{http.StatusOK},
+		HttpMethod:          http.MethodGet,
+		OptionsObject: directoryObjectMembershipsOptions{
+			Select: []string{"displayName", "id"},
+		},
+		Pager: &directoryObjectMembershipsPager{},
+		Path:  fmt.Sprintf("/%s/%s/%s", collection, objectId, relationship),
+	}
+
+	req, err := c.NewRequest(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := req.ExecutePaged(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var values struct {
+		Values *[]directoryObjectMembership `json:"value"`
+	}
+	if err = resp.Unmarshal(&values); err != nil {
+		return nil, err
+	}
+
+	return values.Values, nil
+}
+
+type directoryObjectMembershipsOptions struct {
+	Select []string
+}
+
+func (o directoryObjectMembershipsOptions) ToHeaders() *client.Headers {
+	return &client.Headers{}
+}
+
+func (o directoryObjectMembershipsOptions) ToOData() *odata.Query {
+	return &odata.Query{
+		Select: o.Select,
+	}
+}
+
+func (o directoryObjectMembershipsOptions) ToQuery() *client.QueryParams {
+	return &client.QueryParams{}
+}
+
+// formatDirectoryObjectType converts an OData type such as `#microsoft.graph.directoryRole` to `DirectoryRole`
+func formatDirectoryObjectType(in string) string {
+	t := strings.TrimPrefix(in, "#microsoft.graph.")
+	if t == "" {
+		return t
+	}
+	return strings.ToUpper(t[:1]) + t[1:]
+}
+
+// membershipPaths returns the shortest path of nested group object IDs through which each transitive membership is
+// inherited, beginning with a group that the principal is a direct member of. Paths for direct memberships are empty.
+func membershipPaths(direct []string, parents map[string][]string) map[string][]string {
+	previous := make(map[string]string)
+	visited := make(map[string]bool)
+
+	queue := make([]string, 0, len(direct))
+	for _, id := range direct {
+		k := strings.ToLower(id)
+		if !visited[k] {
+			visited[k] = true
+			queue = append(queue, id)
+		}
+	}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		for _, parent := range parents[strings.ToLower(current)] {
+			k := strings.ToLower(parent)
+			if visited[k] {
+				continue
+			}
+			visited[k] = true
+			previous[k] = current
+			queue = append(queue, parent)
+		}
+	}
+
+	paths := make(map[string][]string)
+	for k := range visited {
+		path := make([]string, 0)
+		for via, ok := previous[k]; ok; via, ok = previous[strings.ToLower(via)] {
+			path = append([]string{via}, path...)
+		}
+		paths[k] = path
+	}
+
+	return paths
+}
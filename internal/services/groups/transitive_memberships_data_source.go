@@ -0,0 +1,281 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package groups
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/beta"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/directoryobjects/stable/directoryobject"
+	memberofBeta "github.com/hashicorp/go-azure-sdk/microsoft-graph/groups/beta/memberof"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/lookup"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/hashicorp/terraform-provider-azuread/internal/sdk"
+)
+
+type TransitiveMembershipsId string
+
+func (id TransitiveMembershipsId) ID() string {
+	return string(id)
+}
+
+func (TransitiveMembershipsId) String() string {
+	return "Transitive Memberships"
+}
+
+type TransitiveMembershipsDataSourceModel struct {
+	IncludePaths  bool                   `tfschema:"include_paths"`
+	Memberships   []TransitiveMembership `tfschema:"memberships"`
+	ObjectId      string                 `tfschema:"object_id"`
+	PrincipalType string                 `tfschema:"principal_type"`
+}
+
+type TransitiveMembership struct {
+	Direct      bool     `tfschema:"direct"`
+	DisplayName string   `tfschema:"display_name"`
+	ObjectId    string   `tfschema:"object_id"`
+	Path        []string `tfschema:"path"`
+	Type        string   `tfschema:"type"`
+}
+
+type TransitiveMembershipsDataSource struct{}
+
+var _ sdk.DataSource = TransitiveMembershipsDataSource{}
+
+func (r TransitiveMembershipsDataSource) ResourceType() string {
+	return "azuread_transitive_memberships"
+}
+
+func (r TransitiveMembershipsDataSource) ModelObject() interface{} {
+	return &TransitiveMembershipsDataSourceModel{}
+}
+
+func (r TransitiveMembershipsDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"object_id": {
+			Description:  "The object ID of the user, service principal, group or device for which to retrieve memberships",
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+
+		"include_paths": {
+			Description: "Whether to compute the path of nested groups through which each inherited membership is obtained",
+			Type:        pluginsdk.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+	}
+}
+
+func (r TransitiveMembershipsDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"principal_type": {
+			Description: "The object type of the principal, e.g. `User`, `ServicePrincipal`, `Group` or `Device`",
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+		},
+
+		"memberships": {
+			Description: "A list of groups, directory roles and administrative units that the principal is a member of, directly or through nested groups",
+			Type:        pluginsdk.TypeList,
+			Computed:    true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"direct": {
+						Description: "Whether the principal is a direct member. When `false`, the membership is inherited through one or more nested groups",
+						Type:        pluginsdk.TypeBool,
+						Computed:    true,
+					},
+
+					"display_name": {
+						Description: "The display name of the object",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"object_id": {
+						Description: "The object ID of the object",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"path": {
+						Description: "When `include_paths` is `true`, the object IDs of the nested groups through which an inherited membership is obtained, beginning with the group that the principal is a direct member of",
+						Type:        pluginsdk.TypeList,
+						Computed:    true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+
+					"type": {
+						Description: "The object type, e.g. `Group`, `DirectoryRole` or `AdministrativeUnit`",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r TransitiveMembershipsDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			directoryObjectClient := metadata.Client.Groups.DirectoryObjectClient
+			memberOfClient := metadata.Client.Groups.GroupMemberOfClientBeta
+			tenantId := metadata.Client.TenantID
+
+			var state TransitiveMembershipsDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := stable.NewDirectoryObjectID(state.ObjectId)
+
+			resp, err := directoryObjectClient.GetDirectoryObject(ctx, id, directoryobject.DefaultGetDirectoryObjectOperationOptions())
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("%s was not found", id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+			if resp.Model == nil {
+				return fmt.Errorf("retrieving %s: model was nil", id)
+			}
+
+			odataType := pointer.From(resp.Model.DirectoryObject().ODataType)
+			collection, ok := principalCollectionsByODataType[odataType]
+			if !ok {
+				return fmt.Errorf("retrieving memberships for %s: unsupported object type %q", id, odataType)
+			}
+			state.PrincipalType = formatDirectoryObjectType(odataType)
+
+			transitive, err := listDirectoryObjectMemberships(ctx, directoryObjectClient.Client, collection, state.ObjectId, "transitiveMemberOf")
+			if err != nil {
+				return fmt.Errorf("listing transitive memberships for %s: %+v", id, err)
+			}
+
+			direct, err := listDirectoryObjectMemberships(ctx, directoryObjectClient.Client, collection, state.ObjectId, "memberOf")
+			if err != nil {
+				return fmt.Errorf("listing direct memberships for %s: %+v", id, err)
+			}
+
+			directIds := make([]string, 0)
+			isDirect := make(map[string]bool)
+			for _, m := range pointer.From(direct) {
+				if m.Id == nil {
+					continue
+				}
+				directIds = append(directIds, *m.Id)
+				isDirect[strings.ToLower(*m.Id)] = true
+			}
+
+			var paths map[string][]string
+			if state.IncludePaths {
+				// Only groups can be nested, so the direct parents of each inherited group are retrieved in order to
+				// reconstruct the path to each membership
+				groupIds := make([]string, 0)
+				for _, m := range pointer.From(transitive) {
+					if m.Id != nil && pointer.From(m.ODataType) == "#microsoft.graph.group" {
+						groupIds = append(groupIds, *m.Id)
+					}
+				}
+
+				type edge struct {
+					child  string
+					parent string
+				}
+
+				edges, err := lookup.InChunks(ctx, groupIds, 1, lookup.DefaultConcurrency, func(ctx context.Context, chunk []string) ([]edge, error) {
+					groupId := beta.NewGroupID(chunk[0])
+					options := memberofBeta.ListMemberOfsOperationOptions{
+						Select: pointer.To([]string{"id"}),
+					}
+					resp, err := memberOfClient.ListMemberOfs(ctx, groupId, options)
+					if err != nil {
+						return nil, fmt.Errorf("listing memberships for %s: %+v", groupId, err)
+					}
+
+					result := make([]edge, 0)
+					for _, parent := range pointer.From(resp.Model) {
+						if parentId := parent.DirectoryObject().Id; parentId != nil {
+							result = append(result, edge{child: chunk[0], parent: *parentId})
+						}
+					}
+					return result, nil
+				})
+				if err != nil {
+					return fmt.Errorf("determining membership paths for %s: %+v", id, err)
+				}
+
+				parents := make(map[string][]string)
+				for _, e := range edges {
+					k := strings.ToLower(e.child)
+					parents[k] = append(parents[k], e.parent)
+				}
+
+				paths = membershipPaths(directIds, parents)
+			}
+
+			state.Memberships = make([]TransitiveMembership, 0)
+			for _, m := range pointer.From(transitive) {
+				if m.Id == nil {
+					continue
+				}
+
+				membership := TransitiveMembership{
+					Direct:      isDirect[strings.ToLower(*m.Id)],
+					DisplayName: pointer.From(m.DisplayName),
+					ObjectId:    *m.Id,
+					Path:        make([]string, 0),
+					Type:        formatDirectoryObjectType(pointer.From(m.ODataType)),
+				}
+				if path, ok := paths[strings.ToLower(*m.Id)]; ok {
+					membership.Path = path
+				}
+
+				state.Memberships = append(state.Memberships, membership)
+			}
+
+			sort.SliceStable(state.Memberships, func(i, j int) bool {
+				a, b := state.Memberships[i], state.Memberships[j]
+				if a.Type != b.Type {
+					return a.Type < b.Type
+				}
+				if !strings.EqualFold(a.DisplayName, b.DisplayName) {
+					return strings.ToLower(a.DisplayName) < strings.ToLower(b.DisplayName)
+				}
+				return a.ObjectId < b.ObjectId
+			})
+
+			objectIds := make([]string, 0, len(state.Memberships))
+			for _, m := range state.Memberships {
+				objectIds = append(objectIds, m.ObjectId)
+			}
+
+			// Generate a unique ID based on result
+			h := sha1.New()
+			if _, err := h.Write([]byte(strings.Join(objectIds, "/"))); err != nil {
+				return fmt.Errorf("unable to compute hash for object IDs: %+v", err)
+			}
+
+			metadata.SetID(TransitiveMembershipsId(fmt.Sprintf("transitiveMemberships#%s#%s#%s", tenantId, state.ObjectId, base64.URLEncoding.EncodeToString(h.Sum(nil)))))
+
+			return metadata.Encode(&state)
+		},
+	}
+}
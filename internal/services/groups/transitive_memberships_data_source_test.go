@@ -0,0 +1,102 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package groups_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type TransitiveMembershipsDataSource struct{}
+
+func TestAccTransitiveMembershipsDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_transitive_memberships", "test")
+	r := TransitiveMembershipsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("principal_type").HasValue("User"),
+				check.That(data.ResourceName).Key("memberships.#").HasValue("2"),
+				check.That(data.ResourceName).Key("memberships.0.display_name").HasValue(fmt.Sprintf("acctestGroup-%d-Inner", data.RandomInteger)),
+				check.That(data.ResourceName).Key("memberships.0.type").HasValue("Group"),
+				check.That(data.ResourceName).Key("memberships.0.direct").HasValue("true"),
+				check.That(data.ResourceName).Key("memberships.1.display_name").HasValue(fmt.Sprintf("acctestGroup-%d-Outer", data.RandomInteger)),
+				check.That(data.ResourceName).Key("memberships.1.direct").HasValue("false"),
+				check.That(data.ResourceName).Key("memberships.1.path.#").HasValue("0"),
+			),
+		},
+	})
+}
+
+func TestAccTransitiveMembershipsDataSource_includePaths(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_transitive_memberships", "test")
+	r := TransitiveMembershipsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.includePaths(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("memberships.#").HasValue("2"),
+				check.That(data.ResourceName).Key("memberships.0.path.#").HasValue("0"),
+				check.That(data.ResourceName).Key("memberships.1.direct").HasValue("false"),
+				check.That(data.ResourceName).Key("memberships.1.path.#").HasValue("1"),
+				check.That(data.ResourceName).Key("memberships.1.path.0").MatchesOtherKey(check.That("azuread_group.inner").Key("object_id")),
+			),
+		},
+	})
+}
+
+func (TransitiveMembershipsDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser.%[1]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+}
+
+resource "azuread_group" "inner" {
+  display_name     = "acctestGroup-%[1]d-Inner"
+  security_enabled = true
+  members          = [azuread_user.test.object_id]
+}
+
+resource "azuread_group" "outer" {
+  display_name     = "acctestGroup-%[1]d-Outer"
+  security_enabled = true
+  members          = [azuread_group.inner.object_id]
+}
+`, data.RandomInteger, data.RandomPassword)
+}
+
+func (r TransitiveMembershipsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_transitive_memberships" "test" {
+  object_id  = azuread_user.test.object_id
+  depends_on = [azuread_group.inner, azuread_group.outer]
+}
+`, r.template(data))
+}
+
+func (r TransitiveMembershipsDataSource) includePaths(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_transitive_memberships" "test" {
+  object_id     = azuread_user.test.object_id
+  include_paths = true
+  depends_on    = [azuread_group.inner, azuread_group.outer]
+}
+`, r.template(data))
+}
@@ -0,0 +1,45 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-uuid"
+)
+
+// DirectorySettingValue checks whether a value is valid for a setting with the specified type, as declared by the
+// setting definitions of a directory setting template. Values are always transmitted as strings, so this ensures they
+// can be interpreted as the declared type. Unrecognised types are not validated.
+func DirectorySettingValue(settingType, value string) error {
+	switch strings.ToLower(settingType) {
+	case "system.boolean":
+		if value != "true" && value != "false" {
+			return fmt.Errorf("expected a boolean value (true or false), got %q", value)
+		}
+
+	case "system.int32":
+		if _, err := strconv.ParseInt(value, 10, 32); err != nil {
+			return fmt.Errorf("expected a 32-bit integer value, got %q", value)
+		}
+
+	case "system.int64":
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			return fmt.Errorf("expected a 64-bit integer value, got %q", value)
+		}
+
+	case "system.guid":
+		// An empty value is permitted to unset an optional GUID, such as the group permitted to create groups
+		if value == "" {
+			return nil
+		}
+		if _, err := uuid.ParseUUID(value); err != nil {
+			return fmt.Errorf("expected a UUID value, got %q", value)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"testing"
+)
+
+func TestDirectorySettingValue(t *testing.T) {
+	cases := []struct {
+		Type     string
+		Value    string
+		TestName string
+		ErrCount int
+	}{
+		{
+			Type:     "System.Boolean",
+			Value:    "true",
+			TestName: "Valid_Boolean",
+			ErrCount: 0,
+		},
+		{
+			Type:     "System.Boolean",
+			Value:    "yes",
+			TestName: "Invalid_Boolean",
+			ErrCount: 1,
+		},
+		{
+			Type:     "System.Int32",
+			Value:    "90",
+			TestName: "Valid_Int32",
+			ErrCount: 0,
+		},
+		{
+			Type:     "System.Int32",
+			Value:    "4294967296",
+			TestName: "Invalid_Int32Overflow",
+			ErrCount: 1,
+		},
+		{
+			Type:     "System.Int32",
+			Value:    "ninety",
+			TestName: "Invalid_Int32",
+			ErrCount: 1,
+		},
+		{
+			Type:     "System.Guid",
+			Value:    "62e90394-69f5-4237-9190-012177145e10",
+			TestName: "Valid_Guid",
+			ErrCount: 0,
+		},
+		{
+			Type:     "System.Guid",
+			Value:    "",
+			TestName: "Valid_GuidEmpty",
+			ErrCount: 0,
+		},
+		{
+			Type:     "System.Guid",
+			Value:    "not-a-guid",
+			TestName: "Invalid_Guid",
+			ErrCount: 1,
+		},
+		{
+			Type:     "System.String",
+			Value:    "[GroupName]-[Department]",
+			TestName: "Valid_String",
+			ErrCount: 0,
+		},
+		{
+			Type:     "System.Unknown",
+			Value:    "anything",
+			TestName: "Valid_UnrecognisedType",
+			ErrCount: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			err := DirectorySettingValue(tc.Type, tc.Value)
+			errCount := 0
+			if err != nil {
+				errCount = 1
+			}
+			if errCount != tc.ErrCount {
+				t.Fatalf("Expected DirectorySettingValue to have %d errors for %q (%s), got %d: %v", tc.ErrCount, tc.Value, tc.Type, errCount, err)
+			}
+		})
+	}
+}
@@ -116,7 +116,7 @@ func accessPackageAssignmentPolicyResource() *pluginsdk.Resource {
 						},
 
 						"requestor": {
-							Description: "The users who are allowed to request on this policy, which can be singleUser, groupMembers, and connectedOrganizationMembers",
+							Description: "The users who are allowed to request on this policy, which can be singleUser, groupMembers, and connectedOrganizationMembers. For connectedOrganizationMembers, the object ID is the ID of the connected organization",
 							Type:        pluginsdk.TypeList,
 							Optional:    true,
 							Elem:        schemaUserSet(),
@@ -366,6 +366,30 @@ func assignmentPolicyCustomizeDiff(ctx context.Context, diff *pluginsdk.Resource
 		}
 	}
 
+	if requestorSettings := diff.Get("requestor_settings").([]interface{}); len(requestorSettings) > 0 && requestorSettings[0] != nil {
+		requestorSetting := requestorSettings[0].(map[string]interface{})
+		scopeType := requestorSetting["scope_type"].(string)
+
+		for i, raw := range requestorSetting["requestor"].([]interface{}) {
+			requestor := raw.(map[string]interface{})
+			subjectType := formatODataType(requestor["subject_type"].(string))
+
+			switch subjectType {
+			case "ConnectedOrganizationMembers", "GroupMembers", "SingleUser":
+				if diff.NewValueKnown(fmt.Sprintf("requestor_settings.0.requestor.%d.object_id", i)) && requestor["object_id"].(string) == "" {
+					return fmt.Errorf("`object_id` must be set for requestors with `subject_type` %q", subjectType)
+				}
+			}
+
+			if scopeType == RequestorScopeTypeSpecificConnectedOrganizationSubjects && subjectType != "ConnectedOrganizationMembers" {
+				return fmt.Errorf("requestors must have a `subject_type` of %q when `scope_type` is %q", "connectedOrganizationMembers", scopeType)
+			}
+			if scopeType == RequestorScopeTypeSpecificDirectorySubjects && subjectType == "ConnectedOrganizationMembers" {
+				return fmt.Errorf("requestors with a `subject_type` of %q require a `scope_type` of %q", "connectedOrganizationMembers", RequestorScopeTypeSpecificConnectedOrganizationSubjects)
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -123,6 +123,22 @@ func TestAccAccessPackageAssignmentPolicy_removeQuestion(t *testing.T) {
 	})
 }
 
+func TestAccAccessPackageAssignmentPolicy_connectedOrganization(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_access_package_assignment_policy", "test")
+	r := AccessPackageAssignmentPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.connectedOrganization(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("requestor_settings.0.requestor.0.subject_type").HasValue("ConnectedOrganizationMembers"),
+			),
+		},
+		data.ImportStep("access_package_id"),
+	})
+}
+
 func (AccessPackageAssignmentPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	client := clients.IdentityGovernance.AccessPackageAssignmentPolicyClient
 	id := beta.NewIdentityGovernanceEntitlementManagementAccessPackageAssignmentPolicyID(state.ID)
@@ -414,3 +430,48 @@ resource "azuread_access_package_assignment_policy" "test" {
 }
 `, data.RandomInteger)
 }
+
+func (AccessPackageAssignmentPolicyResource) connectedOrganization(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_access_package" "test" {
+  display_name = "access-package-%[2]d"
+  description  = "Test Access Package %[2]d"
+  catalog_id   = azuread_access_package_catalog.test.id
+}
+
+resource "azuread_access_package_catalog" "test" {
+  display_name       = "test-catalog-%[2]d"
+  description        = "Test Catalog %[2]d"
+  externally_visible = true
+}
+
+resource "azuread_access_package_assignment_policy" "test" {
+  display_name      = "access-package-assignment-policy-%[2]d"
+  description       = "Test Access Package Assignnment Policy %[2]d"
+  access_package_id = azuread_access_package.test.id
+
+  requestor_settings {
+    scope_type = "SpecificConnectedOrganizationSubjects"
+
+    requestor {
+      object_id    = azuread_access_package_connected_organization.test.id
+      subject_type = "connectedOrganizationMembers"
+    }
+  }
+
+  approval_settings {
+    approval_required = true
+
+    approval_stage {
+      approval_timeout_in_days = 14
+
+      primary_approver {
+        subject_type = "InternalSponsors"
+      }
+    }
+  }
+}
+`, AccessPackageConnectedOrganizationResource{}.complete(data), data.RandomInteger)
+}
@@ -0,0 +1,165 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package identitygovernance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/beta"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/beta/entitlementmanagementconnectedorganization"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+)
+
+func accessPackageConnectedOrganizationDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		ReadContext: accessPackageConnectedOrganizationDataRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"object_id": {
+				Description:  "The ID of this connected organization",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsUUID,
+				ExactlyOneOf: []string{"object_id", "display_name"},
+			},
+
+			"display_name": {
+				Description:  "The display name of the connected organization",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"object_id", "display_name"},
+			},
+
+			"description": {
+				Description: "The description of the connected organization",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+
+			"domain_name": {
+				Description: "The domain name of the connected organization, when its identity source is a domain",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+
+			"external_sponsor_ids": {
+				Description: "The object IDs of guest users and groups from the connected organization who are sponsors of the connected organization",
+				Type:        pluginsdk.TypeSet,
+				Computed:    true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"internal_sponsor_ids": {
+				Description: "The object IDs of users and groups in this tenant who are sponsors of the connected organization",
+				Type:        pluginsdk.TypeSet,
+				Computed:    true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"state": {
+				Description: "The state of the connected organization",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+
+			"tenant_id": {
+				Description: "The tenant ID of the Azure Active Directory tenant for the connected organization, when its identity source is a tenant",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func accessPackageConnectedOrganizationDataRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).IdentityGovernance.ConnectedOrganizationClient
+
+	objectId := d.Get("object_id").(string)
+	displayName := d.Get("display_name").(string)
+
+	var connectedOrganization *beta.ConnectedOrganization
+	if objectId != "" {
+		id := beta.NewIdentityGovernanceEntitlementManagementConnectedOrganizationID(objectId)
+		resp, err := client.GetEntitlementManagementConnectedOrganization(ctx, id, entitlementmanagementconnectedorganization.DefaultGetEntitlementManagementConnectedOrganizationOperationOptions())
+		if err != nil {
+			return tf.ErrorDiagF(err, "Retrieving %s", id)
+		}
+
+		if resp.Model == nil {
+			return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", id)
+		}
+		connectedOrganization = resp.Model
+
+	} else if displayName != "" {
+		options := entitlementmanagementconnectedorganization.ListEntitlementManagementConnectedOrganizationsOperationOptions{
+			Filter: pointer.To(fmt.Sprintf("displayName eq '%s'", odata.EscapeSingleQuote(displayName))),
+		}
+
+		resp, err := client.ListEntitlementManagementConnectedOrganizations(ctx, options)
+		if err != nil {
+			return tf.ErrorDiagF(err, "Listing connected organizations with filter %s", *options.Filter)
+		}
+
+		if resp.Model == nil || len(*resp.Model) == 0 {
+			return tf.ErrorDiagF(errors.New("no matching results"), "Listing connected organizations with filter %s", *options.Filter)
+		}
+		if len(*resp.Model) > 1 {
+			return tf.ErrorDiagF(errors.New("multiple results matched"), "Listing connected organizations with filter %s", *options.Filter)
+		}
+
+		for _, c := range *resp.Model {
+			if strings.EqualFold(c.DisplayName.GetOrZero(), displayName) {
+				connectedOrganization = &c
+				break
+			}
+		}
+	}
+
+	if connectedOrganization == nil {
+		return tf.ErrorDiagF(fmt.Errorf("no connected organization matched with specified parameters"), "Connected organization not found")
+	}
+	if connectedOrganization.Id == nil {
+		return tf.ErrorDiagF(fmt.Errorf("model has nil ID"), "Connected organization not found")
+	}
+
+	id := beta.NewIdentityGovernanceEntitlementManagementConnectedOrganizationID(*connectedOrganization.Id)
+	d.SetId(id.ConnectedOrganizationId)
+
+	internalSponsorIds, externalSponsorIds, err := listConnectedOrganizationSponsorIds(ctx, meta.(*clients.Client), id)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving sponsors for %s", id)
+	}
+
+	tenantId, domainName := flattenConnectedOrganizationIdentitySources(connectedOrganization.IdentitySources)
+
+	tf.Set(d, "object_id", id.ConnectedOrganizationId)
+	tf.Set(d, "display_name", connectedOrganization.DisplayName.GetOrZero())
+	tf.Set(d, "description", connectedOrganization.Description.GetOrZero())
+	tf.Set(d, "domain_name", domainName)
+	tf.Set(d, "external_sponsor_ids", externalSponsorIds)
+	tf.Set(d, "internal_sponsor_ids", internalSponsorIds)
+	tf.Set(d, "state", pointer.FromEnum(connectedOrganization.State))
+	tf.Set(d, "tenant_id", tenantId)
+
+	return nil
+}
@@ -0,0 +1,67 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package identitygovernance_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type AccessPackageConnectedOrganizationDataSource struct{}
+
+func TestAccAccessPackageConnectedOrganizationDataSource_byId(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_access_package_connected_organization", "test")
+	r := AccessPackageConnectedOrganizationDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.byId(data),
+			Check:  r.testCheckFunc(data),
+		},
+	})
+}
+
+func TestAccAccessPackageConnectedOrganizationDataSource_byDisplayName(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_access_package_connected_organization", "test")
+	r := AccessPackageConnectedOrganizationDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.byDisplayName(data),
+			Check:  r.testCheckFunc(data),
+		},
+	})
+}
+
+func (AccessPackageConnectedOrganizationDataSource) testCheckFunc(data acceptance.TestData) acceptance.TestCheckFunc {
+	return acceptance.ComposeTestCheckFunc(
+		check.That(data.ResourceName).Key("description").HasValue(fmt.Sprintf("Test connected organization %[1]d", data.RandomInteger)),
+		check.That(data.ResourceName).Key("display_name").HasValue(fmt.Sprintf("acctest-connected-organization-%[1]d", data.RandomInteger)),
+		check.That(data.ResourceName).Key("internal_sponsor_ids.#").HasValue("2"),
+		check.That(data.ResourceName).Key("state").HasValue("proposed"),
+	)
+}
+
+func (AccessPackageConnectedOrganizationDataSource) byId(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_access_package_connected_organization" "test" {
+  object_id = azuread_access_package_connected_organization.test.id
+}
+`, AccessPackageConnectedOrganizationResource{}.complete(data))
+}
+
+func (AccessPackageConnectedOrganizationDataSource) byDisplayName(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_access_package_connected_organization" "test" {
+  display_name = azuread_access_package_connected_organization.test.display_name
+}
+`, AccessPackageConnectedOrganizationResource{}.complete(data))
+}
@@ -0,0 +1,263 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package identitygovernance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/beta"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/beta/entitlementmanagementconnectedorganization"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/consistency"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+)
+
+func accessPackageConnectedOrganizationResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: accessPackageConnectedOrganizationResourceCreate,
+		ReadContext:   accessPackageConnectedOrganizationResourceRead,
+		UpdateContext: accessPackageConnectedOrganizationResourceUpdate,
+		DeleteContext: accessPackageConnectedOrganizationResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			if _, err := uuid.ParseUUID(id); err != nil {
+				return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"display_name": {
+				Description:  "The display name of the connected organization",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"description": {
+				Description: "The description of the connected organization",
+				Type:        pluginsdk.TypeString,
+				Optional:    true,
+			},
+
+			"tenant_id": {
+				Description:  "The tenant ID of the Azure Active Directory tenant for the connected organization",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"domain_name", "tenant_id"},
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"domain_name": {
+				Description:  "The domain name of the connected organization, used when the organization does not have an Azure Active Directory tenant, or to look up the tenant for the domain",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"domain_name", "tenant_id"},
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"state": {
+				Description:  "The state of the connected organization",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Default:      string(beta.ConnectedOrganizationState_Configured),
+				ValidateFunc: validation.StringInSlice(beta.PossibleValuesForConnectedOrganizationState(), false),
+			},
+
+			"internal_sponsor_ids": {
+				Description: "The object IDs of users and groups in this tenant who are sponsors of the connected organization",
+				Type:        pluginsdk.TypeSet,
+				Optional:    true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.IsUUID,
+				},
+			},
+
+			"external_sponsor_ids": {
+				Description: "The object IDs of guest users and groups from the connected organization who are sponsors of the connected organization",
+				Type:        pluginsdk.TypeSet,
+				Optional:    true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.IsUUID,
+				},
+			},
+		},
+	}
+}
+
+func accessPackageConnectedOrganizationResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).IdentityGovernance.ConnectedOrganizationClient
+	displayName := d.Get("display_name").(string)
+
+	var identitySource beta.IdentitySource
+	if tenantId := d.Get("tenant_id").(string); tenantId != "" {
+		identitySource = beta.AzureActiveDirectoryTenant{
+			DisplayName: nullable.Value(displayName),
+			TenantId:    nullable.Value(tenantId),
+		}
+	} else {
+		identitySource = beta.DomainIdentitySource{
+			DisplayName: nullable.Value(displayName),
+			DomainName:  nullable.Value(d.Get("domain_name").(string)),
+		}
+	}
+
+	properties := beta.ConnectedOrganization{
+		Description:     nullable.NoZero(d.Get("description").(string)),
+		DisplayName:     nullable.Value(displayName),
+		IdentitySources: &[]beta.IdentitySource{identitySource},
+		State:           pointer.To(beta.ConnectedOrganizationState(d.Get("state").(string))),
+	}
+
+	resp, err := client.CreateEntitlementManagementConnectedOrganization(ctx, properties, entitlementmanagementconnectedorganization.DefaultCreateEntitlementManagementConnectedOrganizationOperationOptions())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Creating connected organization %q", displayName)
+	}
+
+	connectedOrganization := resp.Model
+	if connectedOrganization == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Creating connected organization %q", displayName)
+	}
+	if connectedOrganization.Id == nil || *connectedOrganization.Id == "" {
+		return tf.ErrorDiagF(errors.New("API returned connected organization with nil ID"), "Bad API Response")
+	}
+
+	id := beta.NewIdentityGovernanceEntitlementManagementConnectedOrganizationID(*connectedOrganization.Id)
+	d.SetId(id.ConnectedOrganizationId)
+
+	// Now ensure we can retrieve the connected organization consistently
+	if err = consistency.WaitForUpdate(ctx, func(ctx context.Context) (*bool, error) {
+		resp, err := client.GetEntitlementManagementConnectedOrganization(ctx, id, entitlementmanagementconnectedorganization.DefaultGetEntitlementManagementConnectedOrganizationOperationOptions())
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+		return pointer.To(resp.Model != nil), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for creation of %s", id)
+	}
+
+	if err = updateConnectedOrganizationSponsors(ctx, meta.(*clients.Client), d, id); err != nil {
+		return tf.ErrorDiagF(err, "Adding sponsors for %s", id)
+	}
+
+	return accessPackageConnectedOrganizationResourceRead(ctx, d, meta)
+}
+
+func accessPackageConnectedOrganizationResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).IdentityGovernance.ConnectedOrganizationClient
+
+	id := beta.NewIdentityGovernanceEntitlementManagementConnectedOrganizationID(d.Id())
+
+	if d.HasChanges("description", "display_name", "state") {
+		properties := beta.ConnectedOrganization{
+			Description: nullable.Value(d.Get("description").(string)),
+			DisplayName: nullable.Value(d.Get("display_name").(string)),
+			State:       pointer.To(beta.ConnectedOrganizationState(d.Get("state").(string))),
+		}
+
+		if _, err := client.UpdateEntitlementManagementConnectedOrganization(ctx, id, properties, entitlementmanagementconnectedorganization.DefaultUpdateEntitlementManagementConnectedOrganizationOperationOptions()); err != nil {
+			return tf.ErrorDiagF(err, "Updating %s", id)
+		}
+	}
+
+	if err := updateConnectedOrganizationSponsors(ctx, meta.(*clients.Client), d, id); err != nil {
+		return tf.ErrorDiagF(err, "Updating sponsors for %s", id)
+	}
+
+	return accessPackageConnectedOrganizationResourceRead(ctx, d, meta)
+}
+
+func accessPackageConnectedOrganizationResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).IdentityGovernance.ConnectedOrganizationClient
+
+	id := beta.NewIdentityGovernanceEntitlementManagementConnectedOrganizationID(d.Id())
+
+	resp, err := client.GetEntitlementManagementConnectedOrganization(ctx, id, entitlementmanagementconnectedorganization.DefaultGetEntitlementManagementConnectedOrganizationOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", id)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving %s", id)
+	}
+
+	connectedOrganization := resp.Model
+	if connectedOrganization == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", id)
+	}
+
+	internalSponsorIds, externalSponsorIds, err := listConnectedOrganizationSponsorIds(ctx, meta.(*clients.Client), id)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving sponsors for %s", id)
+	}
+
+	tenantId, domainName := flattenConnectedOrganizationIdentitySources(connectedOrganization.IdentitySources)
+
+	tf.Set(d, "description", connectedOrganization.Description.GetOrZero())
+	tf.Set(d, "display_name", connectedOrganization.DisplayName.GetOrZero())
+	tf.Set(d, "external_sponsor_ids", externalSponsorIds)
+	tf.Set(d, "internal_sponsor_ids", internalSponsorIds)
+	tf.Set(d, "state", pointer.FromEnum(connectedOrganization.State))
+	tf.Set(d, "tenant_id", tenantId)
+
+	// When created with a domain name belonging to an Azure Active Directory tenant, the API resolves the identity
+	// source to the tenant, so only update the domain name when a domain identity source is returned
+	if domainName != "" {
+		tf.Set(d, "domain_name", domainName)
+	}
+
+	return nil
+}
+
+func accessPackageConnectedOrganizationResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).IdentityGovernance.ConnectedOrganizationClient
+
+	id := beta.NewIdentityGovernanceEntitlementManagementConnectedOrganizationID(d.Id())
+
+	if _, err := client.DeleteEntitlementManagementConnectedOrganization(ctx, id, entitlementmanagementconnectedorganization.DefaultDeleteEntitlementManagementConnectedOrganizationOperationOptions()); err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Deleting %s", id)
+	}
+
+	// Wait for object to be deleted
+	if err := consistency.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+		if resp, err := client.GetEntitlementManagementConnectedOrganization(ctx, id, entitlementmanagementconnectedorganization.DefaultGetEntitlementManagementConnectedOrganizationOperationOptions()); err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+
+		return pointer.To(true), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for deletion of %s", id)
+	}
+
+	return nil
+}
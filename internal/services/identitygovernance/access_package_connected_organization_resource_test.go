@@ -0,0 +1,134 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package identitygovernance_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/beta"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/beta/entitlementmanagementconnectedorganization"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+)
+
+type AccessPackageConnectedOrganizationResource struct{}
+
+func TestAccAccessPackageConnectedOrganization_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_access_package_connected_organization", "test")
+	r := AccessPackageConnectedOrganizationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("state").HasValue("configured"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAccessPackageConnectedOrganization_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_access_package_connected_organization", "test")
+	r := AccessPackageConnectedOrganizationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("internal_sponsor_ids.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAccessPackageConnectedOrganization_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_access_package_connected_organization", "test")
+	r := AccessPackageConnectedOrganizationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("internal_sponsor_ids.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (AccessPackageConnectedOrganizationResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.IdentityGovernance.ConnectedOrganizationClient
+	id := beta.NewIdentityGovernanceEntitlementManagementConnectedOrganizationID(state.ID)
+
+	resp, err := client.GetEntitlementManagementConnectedOrganization(ctx, id, entitlementmanagementconnectedorganization.DefaultGetEntitlementManagementConnectedOrganizationOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to retrieve %s: %v", id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (AccessPackageConnectedOrganizationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_access_package_connected_organization" "test" {
+  display_name = "acctest-connected-organization-%[1]d"
+  domain_name  = "acctest%[1]d.example.com"
+}
+`, data.RandomInteger)
+}
+
+func (AccessPackageConnectedOrganizationResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+data "azuread_client_config" "test" {}
+
+resource "azuread_group" "sponsor" {
+  display_name     = "acctest-connected-organization-sponsor-%[1]d"
+  security_enabled = true
+}
+
+resource "azuread_access_package_connected_organization" "test" {
+  display_name = "acctest-connected-organization-%[1]d"
+  description  = "Test connected organization %[1]d"
+  domain_name  = "acctest%[1]d.example.com"
+  state        = "proposed"
+
+  internal_sponsor_ids = [
+    azuread_group.sponsor.object_id,
+    data.azuread_client_config.test.object_id,
+  ]
+}
+`, data.RandomInteger)
+}
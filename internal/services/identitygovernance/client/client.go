@@ -13,6 +13,9 @@ import (
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/beta/entitlementmanagementaccesspackagecatalog"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/beta/entitlementmanagementaccesspackagecatalogaccesspackageresource"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/beta/entitlementmanagementaccesspackageresourcerequest"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/beta/entitlementmanagementconnectedorganization"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/beta/entitlementmanagementconnectedorganizationexternalsponsor"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/beta/entitlementmanagementconnectedorganizationinternalsponsor"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/rolemanagement/beta/entitlementmanagementroleassignment"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/rolemanagement/beta/entitlementmanagementroledefinition"
 
@@ -26,14 +29,17 @@ import (
 )
 
 type Client struct {
-	AccessPackageAssignmentPolicyClient  *entitlementmanagementaccesspackageassignmentpolicy.EntitlementManagementAccessPackageAssignmentPolicyClient
-	AccessPackageCatalogClient           *entitlementmanagementaccesspackagecatalog.EntitlementManagementAccessPackageCatalogClient
-	AccessPackageCatalogResourceClient   *entitlementmanagementaccesspackagecatalogaccesspackageresource.EntitlementManagementAccessPackageCatalogAccessPackageResourceClient
-	AccessPackageClient                  *entitlementmanagementaccesspackage.EntitlementManagementAccessPackageClient
-	AccessPackageResourceRequestClient   *entitlementmanagementaccesspackageresourcerequest.EntitlementManagementAccessPackageResourceRequestClient
-	AccessPackageResourceRoleScopeClient *entitlementmanagementaccesspackageaccesspackageresourcerolescope.EntitlementManagementAccessPackageAccessPackageResourceRoleScopeClient
-	RoleAssignmentClient                 *entitlementmanagementroleassignment.EntitlementManagementRoleAssignmentClient
-	RoleDefinitionClient                 *entitlementmanagementroledefinition.EntitlementManagementRoleDefinitionClient
+	AccessPackageAssignmentPolicyClient        *entitlementmanagementaccesspackageassignmentpolicy.EntitlementManagementAccessPackageAssignmentPolicyClient
+	AccessPackageCatalogClient                 *entitlementmanagementaccesspackagecatalog.EntitlementManagementAccessPackageCatalogClient
+	AccessPackageCatalogResourceClient         *entitlementmanagementaccesspackagecatalogaccesspackageresource.EntitlementManagementAccessPackageCatalogAccessPackageResourceClient
+	AccessPackageClient                        *entitlementmanagementaccesspackage.EntitlementManagementAccessPackageClient
+	AccessPackageResourceRequestClient         *entitlementmanagementaccesspackageresourcerequest.EntitlementManagementAccessPackageResourceRequestClient
+	AccessPackageResourceRoleScopeClient       *entitlementmanagementaccesspackageaccesspackageresourcerolescope.EntitlementManagementAccessPackageAccessPackageResourceRoleScopeClient
+	ConnectedOrganizationClient                *entitlementmanagementconnectedorganization.EntitlementManagementConnectedOrganizationClient
+	ConnectedOrganizationExternalSponsorClient *entitlementmanagementconnectedorganizationexternalsponsor.EntitlementManagementConnectedOrganizationExternalSponsorClient
+	ConnectedOrganizationInternalSponsorClient *entitlementmanagementconnectedorganizationinternalsponsor.EntitlementManagementConnectedOrganizationInternalSponsorClient
+	RoleAssignmentClient                       *entitlementmanagementroleassignment.EntitlementManagementRoleAssignmentClient
+	RoleDefinitionClient                       *entitlementmanagementroledefinition.EntitlementManagementRoleDefinitionClient
 
 	PrivilegedAccessGroupAssignmentScheduleClient          *privilegedaccessgroupassignmentschedule.PrivilegedAccessGroupAssignmentScheduleClient
 	PrivilegedAccessGroupAssignmentScheduleInstanceClient  *privilegedaccessgroupassignmentscheduleinstance.PrivilegedAccessGroupAssignmentScheduleInstanceClient
@@ -80,6 +86,24 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(accessPackageResourceRoleScopeClient.Client)
 
+	connectedOrganizationClient, err := entitlementmanagementconnectedorganization.NewEntitlementManagementConnectedOrganizationClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(connectedOrganizationClient.Client)
+
+	connectedOrganizationExternalSponsorClient, err := entitlementmanagementconnectedorganizationexternalsponsor.NewEntitlementManagementConnectedOrganizationExternalSponsorClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(connectedOrganizationExternalSponsorClient.Client)
+
+	connectedOrganizationInternalSponsorClient, err := entitlementmanagementconnectedorganizationinternalsponsor.NewEntitlementManagementConnectedOrganizationInternalSponsorClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(connectedOrganizationInternalSponsorClient.Client)
+
 	roleAssignmentClient, err := entitlementmanagementroleassignment.NewEntitlementManagementRoleAssignmentClientWithBaseURI(o.Environment.MicrosoftGraph)
 	if err != nil {
 		return nil, err
@@ -129,14 +153,17 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	o.Configure(privilegedAccessGroupEligibilityScheduleRequestClient.Client)
 
 	return &Client{
-		AccessPackageAssignmentPolicyClient:  accessPackageAssignmentPolicyClient,
-		AccessPackageCatalogClient:           accessPackageCatalogClient,
-		AccessPackageCatalogResourceClient:   accessPackageCatalogResourceClient,
-		AccessPackageClient:                  accessPackageClient,
-		AccessPackageResourceRequestClient:   accessPackageResourceRequestClient,
-		AccessPackageResourceRoleScopeClient: accessPackageResourceRoleScopeClient,
-		RoleAssignmentClient:                 roleAssignmentClient,
-		RoleDefinitionClient:                 roleDefinitionClient,
+		AccessPackageAssignmentPolicyClient:        accessPackageAssignmentPolicyClient,
+		AccessPackageCatalogClient:                 accessPackageCatalogClient,
+		AccessPackageCatalogResourceClient:         accessPackageCatalogResourceClient,
+		AccessPackageClient:                        accessPackageClient,
+		AccessPackageResourceRequestClient:         accessPackageResourceRequestClient,
+		AccessPackageResourceRoleScopeClient:       accessPackageResourceRoleScopeClient,
+		ConnectedOrganizationClient:                connectedOrganizationClient,
+		ConnectedOrganizationExternalSponsorClient: connectedOrganizationExternalSponsorClient,
+		ConnectedOrganizationInternalSponsorClient: connectedOrganizationInternalSponsorClient,
+		RoleAssignmentClient:                       roleAssignmentClient,
+		RoleDefinitionClient:                       roleDefinitionClient,
 
 		PrivilegedAccessGroupAssignmentScheduleClient:          privilegedAccessGroupAssignmentScheduleClient,
 		PrivilegedAccessGroupAssignmentScheduleInstanceClient:  privilegedAccessGroupAssignmentScheduleInstanceClient,
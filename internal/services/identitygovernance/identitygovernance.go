@@ -11,8 +11,13 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/beta"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/beta/entitlementmanagementaccesspackage"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/beta/entitlementmanagementconnectedorganizationexternalsponsor"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/beta/entitlementmanagementconnectedorganizationinternalsponsor"
 	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 )
@@ -434,3 +439,107 @@ func flattenAccessPackageLocalizedContent(input *beta.AccessPackageLocalizedCont
 func formatODataType(in string) string {
 	return cases.Title(language.AmericanEnglish, cases.NoLower).String(strings.TrimPrefix(in, "#microsoft.graph."))
 }
+
+func listConnectedOrganizationSponsorIds(ctx context.Context, client *clients.Client, id beta.IdentityGovernanceEntitlementManagementConnectedOrganizationId) ([]string, []string, error) {
+	internalSponsorClient := client.IdentityGovernance.ConnectedOrganizationInternalSponsorClient
+	externalSponsorClient := client.IdentityGovernance.ConnectedOrganizationExternalSponsorClient
+
+	internalResp, err := internalSponsorClient.ListEntitlementManagementConnectedOrganizationInternalSponsors(ctx, id, entitlementmanagementconnectedorganizationinternalsponsor.DefaultListEntitlementManagementConnectedOrganizationInternalSponsorsOperationOptions())
+	if err != nil {
+		return nil, nil, fmt.Errorf("listing internal sponsors: %v", err)
+	}
+
+	externalResp, err := externalSponsorClient.ListEntitlementManagementConnectedOrganizationExternalSponsors(ctx, id, entitlementmanagementconnectedorganizationexternalsponsor.DefaultListEntitlementManagementConnectedOrganizationExternalSponsorsOperationOptions())
+	if err != nil {
+		return nil, nil, fmt.Errorf("listing external sponsors: %v", err)
+	}
+
+	return flattenDirectoryObjectIds(internalResp.Model), flattenDirectoryObjectIds(externalResp.Model), nil
+}
+
+func updateConnectedOrganizationSponsors(ctx context.Context, client *clients.Client, d *pluginsdk.ResourceData, id beta.IdentityGovernanceEntitlementManagementConnectedOrganizationId) error {
+	if !d.HasChanges("external_sponsor_ids", "internal_sponsor_ids") {
+		return nil
+	}
+
+	internalSponsorClient := client.IdentityGovernance.ConnectedOrganizationInternalSponsorClient
+	externalSponsorClient := client.IdentityGovernance.ConnectedOrganizationExternalSponsorClient
+
+	existingInternalSponsors, existingExternalSponsors, err := listConnectedOrganizationSponsorIds(ctx, client, id)
+	if err != nil {
+		return err
+	}
+
+	desiredInternalSponsors := *tf.ExpandStringSlicePtr(d.Get("internal_sponsor_ids").(*pluginsdk.Set).List())
+	desiredExternalSponsors := *tf.ExpandStringSlicePtr(d.Get("external_sponsor_ids").(*pluginsdk.Set).List())
+
+	for _, sponsorId := range tf.Difference(desiredInternalSponsors, existingInternalSponsors) {
+		request := beta.ReferenceCreate{
+			ODataId: pointer.To(internalSponsorClient.Client.BaseUri + beta.NewDirectoryObjectID(sponsorId).ID()),
+		}
+		if _, err = internalSponsorClient.AddEntitlementManagementConnectedOrganizationInternalSponsorRef(ctx, id, request, entitlementmanagementconnectedorganizationinternalsponsor.DefaultAddEntitlementManagementConnectedOrganizationInternalSponsorRefOperationOptions()); err != nil {
+			return fmt.Errorf("adding internal sponsor %q: %v", sponsorId, err)
+		}
+	}
+
+	for _, sponsorId := range tf.Difference(existingInternalSponsors, desiredInternalSponsors) {
+		sponsorRefId := beta.NewIdentityGovernanceEntitlementManagementConnectedOrganizationIdInternalSponsorID(id.ConnectedOrganizationId, sponsorId)
+		if _, err = internalSponsorClient.RemoveEntitlementManagementConnectedOrganizationInternalSponsorRef(ctx, sponsorRefId, entitlementmanagementconnectedorganizationinternalsponsor.DefaultRemoveEntitlementManagementConnectedOrganizationInternalSponsorRefOperationOptions()); err != nil {
+			return fmt.Errorf("removing internal sponsor %q: %v", sponsorId, err)
+		}
+	}
+
+	for _, sponsorId := range tf.Difference(desiredExternalSponsors, existingExternalSponsors) {
+		request := beta.ReferenceCreate{
+			ODataId: pointer.To(externalSponsorClient.Client.BaseUri + beta.NewDirectoryObjectID(sponsorId).ID()),
+		}
+		if _, err = externalSponsorClient.AddEntitlementManagementConnectedOrganizationExternalSponsorRef(ctx, id, request, entitlementmanagementconnectedorganizationexternalsponsor.DefaultAddEntitlementManagementConnectedOrganizationExternalSponsorRefOperationOptions()); err != nil {
+			return fmt.Errorf("adding external sponsor %q: %v", sponsorId, err)
+		}
+	}
+
+	for _, sponsorId := range tf.Difference(existingExternalSponsors, desiredExternalSponsors) {
+		sponsorRefId := beta.NewIdentityGovernanceEntitlementManagementConnectedOrganizationIdExternalSponsorID(id.ConnectedOrganizationId, sponsorId)
+		if _, err = externalSponsorClient.RemoveEntitlementManagementConnectedOrganizationExternalSponsorRef(ctx, sponsorRefId, entitlementmanagementconnectedorganizationexternalsponsor.DefaultRemoveEntitlementManagementConnectedOrganizationExternalSponsorRefOperationOptions()); err != nil {
+			return fmt.Errorf("removing external sponsor %q: %v", sponsorId, err)
+		}
+	}
+
+	return nil
+}
+
+func flattenDirectoryObjectIds(input *[]beta.DirectoryObject) []string {
+	result := make([]string, 0)
+	if input == nil {
+		return result
+	}
+
+	for _, o := range *input {
+		if id := o.DirectoryObject().Id; id != nil {
+			result = append(result, *id)
+		}
+	}
+
+	return result
+}
+
+// flattenConnectedOrganizationIdentitySources returns the tenant ID and domain name from the first identity source of a
+// connected organization, either of which may be empty depending on the type of identity source
+func flattenConnectedOrganizationIdentitySources(input *[]beta.IdentitySource) (tenantId string, domainName string) {
+	if input == nil {
+		return
+	}
+
+	for _, source := range *input {
+		switch s := source.(type) {
+		case beta.AzureActiveDirectoryTenant:
+			return s.TenantId.GetOrZero(), ""
+		case beta.DomainIdentitySource:
+			return "", s.DomainName.GetOrZero()
+		case beta.ExternalDomainFederation:
+			return "", s.DomainName.GetOrZero()
+		}
+	}
+
+	return
+}
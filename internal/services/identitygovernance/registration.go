@@ -30,9 +30,10 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azuread_access_package":              accessPackageDataSource(),
-		"azuread_access_package_catalog":      accessPackageCatalogDataSource(),
-		"azuread_access_package_catalog_role": accessPackageCatalogRoleDataSource(),
+		"azuread_access_package":                        accessPackageDataSource(),
+		"azuread_access_package_catalog":                accessPackageCatalogDataSource(),
+		"azuread_access_package_catalog_role":           accessPackageCatalogRoleDataSource(),
+		"azuread_access_package_connected_organization": accessPackageConnectedOrganizationDataSource(),
 	}
 }
 
@@ -43,6 +44,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azuread_access_package_assignment_policy":            accessPackageAssignmentPolicyResource(),
 		"azuread_access_package_catalog":                      accessPackageCatalogResource(),
 		"azuread_access_package_catalog_role_assignment":      accessPackageCatalogRoleAssignmentResource(),
+		"azuread_access_package_connected_organization":       accessPackageConnectedOrganizationResource(),
 		"azuread_access_package_resource_catalog_association": accessPackageResourceCatalogAssociationResource(),
 		"azuread_access_package_resource_package_association": accessPackageResourcePackageAssociationResource(),
 	}
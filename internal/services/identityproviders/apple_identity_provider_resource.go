@@ -0,0 +1,190 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package identityproviders
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identity/stable/identityprovider"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+)
+
+func appleIdentityProviderResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: appleIdentityProviderResourceCreate,
+		ReadContext:   appleIdentityProviderResourceRead,
+		UpdateContext: appleIdentityProviderResourceUpdate,
+		DeleteContext: identityProviderResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(validateIdentityProviderId),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"display_name": {
+				Description:  "The display name of the identity provider",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+
+			"developer_id": {
+				Description:  "The Apple developer identifier (Team ID)",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+
+			"key_id": {
+				Description:  "The Apple key identifier",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+
+			"service_id": {
+				Description:  "The Apple service identifier",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+
+			"certificate_data_wo": {
+				Description:  "The contents of the private key (.p8) file issued by Apple. This value is write-only and is never stored in state",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				WriteOnly:    true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+
+			"certificate_data_wo_version": {
+				Description: "A version number for the certificate data. Changing this value causes the certificate data to be sent to the identity provider configuration",
+				Type:        pluginsdk.TypeInt,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func appleIdentityProviderResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).IdentityProviders.IdentityProviderClient
+
+	certificateData, err := pluginsdk.GetWriteOnlyString(d, "certificate_data_wo")
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "certificate_data_wo", "Could not retrieve certificate data")
+	}
+
+	properties := stable.AppleManagedIdentityProvider{
+		CertificateData: nullable.Value(certificateData),
+		DeveloperId:     nullable.Value(d.Get("developer_id").(string)),
+		DisplayName:     nullable.Value(d.Get("display_name").(string)),
+		KeyId:           nullable.Value(d.Get("key_id").(string)),
+		ServiceId:       nullable.Value(d.Get("service_id").(string)),
+	}
+
+	resp, err := client.CreateIdentityProvider(ctx, properties, identityprovider.DefaultCreateIdentityProviderOperationOptions())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Creating Apple identity provider")
+	}
+
+	if resp.Model == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Creating Apple identity provider")
+	}
+
+	providerId := resp.Model.IdentityProviderBase().Id
+	if providerId == nil || *providerId == "" {
+		return tf.ErrorDiagF(errors.New("API returned identity provider with nil ID"), "Bad API Response")
+	}
+
+	id := stable.NewIdentityIdentityProviderID(*providerId)
+	d.SetId(id.ID())
+
+	if err = waitForIdentityProvider(ctx, client, id); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for creation of %s", id)
+	}
+
+	return appleIdentityProviderResourceRead(ctx, d, meta)
+}
+
+func appleIdentityProviderResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).IdentityProviders.IdentityProviderClient
+
+	id, err := stable.ParseIdentityIdentityProviderID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing ID")
+	}
+
+	properties := stable.AppleManagedIdentityProvider{
+		DeveloperId: nullable.Value(d.Get("developer_id").(string)),
+		DisplayName: nullable.Value(d.Get("display_name").(string)),
+		KeyId:       nullable.Value(d.Get("key_id").(string)),
+		ServiceId:   nullable.Value(d.Get("service_id").(string)),
+	}
+
+	if d.HasChange("certificate_data_wo_version") {
+		certificateData, err := pluginsdk.GetWriteOnlyString(d, "certificate_data_wo")
+		if err != nil {
+			return tf.ErrorDiagPathF(err, "certificate_data_wo", "Could not retrieve certificate data")
+		}
+		properties.CertificateData = nullable.Value(certificateData)
+	}
+
+	if _, err = client.UpdateIdentityProvider(ctx, *id, properties, identityprovider.DefaultUpdateIdentityProviderOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Updating %s", id)
+	}
+
+	return appleIdentityProviderResourceRead(ctx, d, meta)
+}
+
+func appleIdentityProviderResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).IdentityProviders.IdentityProviderClient
+
+	id, err := stable.ParseIdentityIdentityProviderID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing ID")
+	}
+
+	resp, err := client.GetIdentityProvider(ctx, *id, identityprovider.DefaultGetIdentityProviderOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", id)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving %s", id)
+	}
+
+	if resp.Model == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", id)
+	}
+
+	provider, ok := resp.Model.(stable.AppleManagedIdentityProvider)
+	if !ok {
+		return tf.ErrorDiagF(fmt.Errorf("unexpected identity provider type %q", pointer.From(resp.Model.IdentityProviderBase().ODataType)), "Retrieving %s", id)
+	}
+
+	tf.Set(d, "developer_id", provider.DeveloperId.GetOrZero())
+	tf.Set(d, "display_name", provider.DisplayName.GetOrZero())
+	tf.Set(d, "key_id", provider.KeyId.GetOrZero())
+	tf.Set(d, "service_id", provider.ServiceId.GetOrZero())
+
+	return nil
+}
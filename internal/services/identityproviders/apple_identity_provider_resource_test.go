@@ -0,0 +1,94 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package identityproviders_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identity/stable/identityprovider"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+)
+
+type AppleIdentityProviderResource struct{}
+
+// Configuring Apple as an identity provider requires a private key issued by Apple, specified using the
+// `ARM_TEST_APPLE_CERTIFICATE_DATA` environment variable
+func appleCertificateData(t *testing.T) string {
+	certificateData := os.Getenv("ARM_TEST_APPLE_CERTIFICATE_DATA")
+	if certificateData == "" {
+		t.Skip("`ARM_TEST_APPLE_CERTIFICATE_DATA` must be set to run Apple identity provider acceptance tests")
+	}
+	return certificateData
+}
+
+func TestAccAppleIdentityProvider_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_apple_identity_provider", "test")
+	r := AppleIdentityProviderResource{}
+	certificateData := appleCertificateData(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, certificateData, "acctest"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("certificate_data_wo").DoesNotExist(),
+			),
+		},
+		data.ImportStep("certificate_data_wo_version"),
+		{
+			Config: r.basic(data, certificateData, "acctest-updated"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("display_name").HasValue(fmt.Sprintf("acctest-updated-%s", data.RandomString)),
+			),
+		},
+		data.ImportStep("certificate_data_wo_version"),
+	})
+}
+
+func (r AppleIdentityProviderResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.IdentityProviders.IdentityProviderClient
+
+	id, err := stable.ParseIdentityIdentityProviderID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetIdentityProvider(ctx, *id, identityprovider.DefaultGetIdentityProviderOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+
+		return nil, fmt.Errorf("retrieving %s: %v", id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (r AppleIdentityProviderResource) basic(data acceptance.TestData, certificateData, displayNamePrefix string) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_apple_identity_provider" "test" {
+  display_name                = "%[3]s-%[1]s"
+  developer_id                = "ACCTEST%[1]s"
+  key_id                      = "KEY%[1]s"
+  service_id                  = "com.example.acctest%[1]s"
+  certificate_data_wo         = <<EOT
+%[2]s
+EOT
+  certificate_data_wo_version = 1
+}
+`, data.RandomString, certificateData, displayNamePrefix)
+}
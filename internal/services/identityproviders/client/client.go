@@ -0,0 +1,45 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/directory/stable/federationconfiguration"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identity/stable/identityprovider"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/msgraph"
+	"github.com/hashicorp/terraform-provider-azuread/internal/common"
+)
+
+type Client struct {
+	FederationConfigurationClient *federationconfiguration.FederationConfigurationClient
+	IdentityProviderClient        *identityprovider.IdentityProviderClient
+
+	// IdentityProviderBetaClient targets the beta API, which is required for OpenID Connect identity providers
+	IdentityProviderBetaClient *identityprovider.IdentityProviderClient
+}
+
+func NewClient(o *common.ClientOptions) (*Client, error) {
+	federationConfigurationClient, err := federationconfiguration.NewFederationConfigurationClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(federationConfigurationClient.Client)
+
+	identityProviderClient, err := identityprovider.NewIdentityProviderClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(identityProviderClient.Client)
+
+	identityProviderBetaClient, err := msgraph.NewClient(o.Environment.MicrosoftGraph, "identityprovider", msgraph.VersionBeta)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(identityProviderBetaClient)
+
+	return &Client{
+		FederationConfigurationClient: federationConfigurationClient,
+		IdentityProviderClient:        identityProviderClient,
+		IdentityProviderBetaClient:    &identityprovider.IdentityProviderClient{Client: identityProviderBetaClient},
+	}, nil
+}
@@ -0,0 +1,291 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package identityproviders
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/directory/stable/federationconfiguration"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/consistency"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/credentials"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+)
+
+func externalDomainFederationResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: externalDomainFederationResourceCreate,
+		ReadContext:   externalDomainFederationResourceRead,
+		UpdateContext: externalDomainFederationResourceUpdate,
+		DeleteContext: externalDomainFederationResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			if _, errs := stable.ValidateDirectoryFederationConfigurationID(id, "id"); len(errs) > 0 {
+				out := ""
+				for _, err := range errs {
+					out += err.Error()
+				}
+				return errors.New(out)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"display_name": {
+				Description:  "The display name of the identity provider",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+
+			"domains": {
+				Description: "The domain names of the external organizations that the tenant is federating with",
+				Type:        pluginsdk.TypeSet,
+				Required:    true,
+				ForceNew:    true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+			},
+
+			"issuer_uri": {
+				Description:  "The issuer URI of the federation server",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+
+			"passive_sign_in_uri": {
+				Description:  "The URI that web-based clients are directed to when signing in",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsHttpsUrl,
+			},
+
+			"metadata_exchange_uri": {
+				Description:  "The URI of the metadata exchange endpoint used for authentication from rich client applications",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsHttpsUrl,
+			},
+
+			"preferred_authentication_protocol": {
+				Description:  "The preferred authentication protocol",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(stable.PossibleValuesForAuthenticationProtocol(), false),
+			},
+
+			"signing_certificate": {
+				Description:      "The current token signing certificate of the federation server, either PEM-encoded or base64-encoded DER",
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				ValidateFunc:     credentials.ValidateCertificate,
+				DiffSuppressFunc: credentials.CertificateDiffSuppress,
+			},
+
+			"signing_certificate_thumbprint": {
+				Description: "The SHA-1 thumbprint of the current token signing certificate",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func externalDomainFederationResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).IdentityProviders.FederationConfigurationClient
+
+	properties, err := expandExternalDomainFederation(d)
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "signing_certificate", "Could not parse signing certificate")
+	}
+
+	domains := make([]stable.ExternalDomainName, 0)
+	for _, v := range d.Get("domains").(*pluginsdk.Set).List() {
+		domains = append(domains, stable.ExternalDomainName{
+			Id: pointer.To(v.(string)),
+		})
+	}
+	properties.Domains = &domains
+
+	resp, err := client.CreateFederationConfiguration(ctx, *properties, federationconfiguration.DefaultCreateFederationConfigurationOperationOptions())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Creating external domain federation")
+	}
+
+	if resp.Model == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Creating external domain federation")
+	}
+
+	providerId := resp.Model.IdentityProviderBase().Id
+	if providerId == nil || *providerId == "" {
+		return tf.ErrorDiagF(errors.New("API returned external domain federation with nil ID"), "Bad API Response")
+	}
+
+	id := stable.NewDirectoryFederationConfigurationID(*providerId)
+	d.SetId(id.ID())
+
+	// Now ensure we can retrieve the federation configuration consistently
+	if err = consistency.WaitForUpdate(ctx, func(ctx context.Context) (*bool, error) {
+		resp, err := client.GetFederationConfiguration(ctx, id, federationconfiguration.DefaultGetFederationConfigurationOperationOptions())
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return pointer.To(false), err
+		}
+		return pointer.To(resp.Model != nil), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for creation of %s", id)
+	}
+
+	return externalDomainFederationResourceRead(ctx, d, meta)
+}
+
+func externalDomainFederationResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).IdentityProviders.FederationConfigurationClient
+
+	id, err := stable.ParseDirectoryFederationConfigurationID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing ID")
+	}
+
+	properties, err := expandExternalDomainFederation(d)
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "signing_certificate", "Could not parse signing certificate")
+	}
+
+	if _, err = client.UpdateFederationConfiguration(ctx, *id, *properties, federationconfiguration.DefaultUpdateFederationConfigurationOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Updating %s", id)
+	}
+
+	return externalDomainFederationResourceRead(ctx, d, meta)
+}
+
+func externalDomainFederationResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).IdentityProviders.FederationConfigurationClient
+
+	id, err := stable.ParseDirectoryFederationConfigurationID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing ID")
+	}
+
+	options := federationconfiguration.GetFederationConfigurationOperationOptions{
+		Expand: &odata.Expand{Relationship: "domains"},
+	}
+
+	resp, err := client.GetFederationConfiguration(ctx, *id, options)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", id)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving %s", id)
+	}
+
+	if resp.Model == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", id)
+	}
+
+	// The SDK does not discriminate this type when unmarshaling, so it must be decoded from the raw values
+	var federation stable.SamlOrWsFedExternalDomainFederation
+	if err = decodeRawIdentityProvider(resp.Model, &federation); err != nil {
+		return tf.ErrorDiagF(err, "Retrieving %s", id)
+	}
+
+	domains := make([]string, 0)
+	for _, domain := range pointer.From(federation.Domains) {
+		if domain.Id != nil {
+			domains = append(domains, *domain.Id)
+		}
+	}
+
+	tf.Set(d, "display_name", federation.DisplayName.GetOrZero())
+	tf.Set(d, "domains", domains)
+	tf.Set(d, "issuer_uri", federation.IssuerUri.GetOrZero())
+	tf.Set(d, "metadata_exchange_uri", federation.MetadataExchangeUri.GetOrZero())
+	tf.Set(d, "passive_sign_in_uri", federation.PassiveSignInUri.GetOrZero())
+	tf.Set(d, "preferred_authentication_protocol", string(pointer.From(federation.PreferredAuthenticationProtocol)))
+	tf.Set(d, "signing_certificate", federation.SigningCertificate.GetOrZero())
+
+	var thumbprint string
+	if signingCertificate := federation.SigningCertificate.GetOrZero(); signingCertificate != "" {
+		if thumbprint, err = credentials.GetTokenSigningCertificateThumbprint([]byte(signingCertificate)); err != nil {
+			log.Printf("[DEBUG] Unable to compute thumbprint of signing certificate for %s: %+v", id, err)
+		}
+	}
+	tf.Set(d, "signing_certificate_thumbprint", thumbprint)
+
+	return nil
+}
+
+func externalDomainFederationResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).IdentityProviders.FederationConfigurationClient
+
+	id, err := stable.ParseDirectoryFederationConfigurationID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing ID")
+	}
+
+	if _, err := client.DeleteFederationConfiguration(ctx, *id, federationconfiguration.DefaultDeleteFederationConfigurationOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Deleting %s", id)
+	}
+
+	if err := consistency.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+		if resp, err := client.GetFederationConfiguration(ctx, *id, federationconfiguration.DefaultGetFederationConfigurationOperationOptions()); err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+		return pointer.To(true), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for deletion of %s", id)
+	}
+
+	return nil
+}
+
+func expandExternalDomainFederation(d *pluginsdk.ResourceData) (*stable.SamlOrWsFedExternalDomainFederation, error) {
+	signingCertificate, err := credentials.NormalizeCertificate(d.Get("signing_certificate").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	properties := stable.SamlOrWsFedExternalDomainFederation{
+		DisplayName:                     nullable.Value(d.Get("display_name").(string)),
+		IssuerUri:                       nullable.Value(d.Get("issuer_uri").(string)),
+		PassiveSignInUri:                nullable.Value(d.Get("passive_sign_in_uri").(string)),
+		PreferredAuthenticationProtocol: pointer.To(stable.AuthenticationProtocol(d.Get("preferred_authentication_protocol").(string))),
+		SigningCertificate:              nullable.Value(signingCertificate),
+	}
+
+	if v := d.Get("metadata_exchange_uri").(string); v != "" {
+		properties.MetadataExchangeUri = nullable.Value(v)
+	} else {
+		properties.MetadataExchangeUri = nullable.NoZero("")
+	}
+
+	return &properties, nil
+}
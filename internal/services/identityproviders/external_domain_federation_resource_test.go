@@ -0,0 +1,176 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package identityproviders_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/directory/stable/federationconfiguration"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+)
+
+// To generate a suitable certificate for testing:
+// openssl req -subj '/CN=hashicorptest/O=HashiCorp, Inc./ST=CA/C=US' -new -newkey rsa:2048 -sha256 -days 3650 -nodes -x509 -keyout server.key -out server.crt
+
+// The following certificate will expire on March 7, 2031
+const externalDomainFederationCertificatePem string = `-----BEGIN CERTIFICATE-----
+MIIDFDCCAfwCCQCvHp+vopfOOTANBgkqhkiG9w0BAQsFADBMMRYwFAYDVQQDDA1o
+YXNoaWNvcnB0ZXN0MRgwFgYDVQQKDA9IYXNoaUNvcnAsIEluYy4xCzAJBgNVBAgM
+AkNBMQswCQYDVQQGEwJVUzAeFw0yMTAzMDkxMTAyMTNaFw0zMTAzMDcxMTAyMTNa
+MEwxFjAUBgNVBAMMDWhhc2hpY29ycHRlc3QxGDAWBgNVBAoMD0hhc2hpQ29ycCwg
+SW5jLjELMAkGA1UECAwCQ0ExCzAJBgNVBAYTAlVTMIIBIjANBgkqhkiG9w0BAQEF
+AAOCAQ8AMIIBCgKCAQEAlVmb5pmoASvZ5pxD6CEBiPYqADb7teCHV54RRwv1aJjS
+eiPUW/1WNQooIQF0M0yzFdHmwx3HSoxCkQwwxVMAPsuqFJVabs/eAr41NpxQCncb
+i+vKlbmaAWbaIdidxeUe1jXB2N0YXRCg7Ps8IGA0UochvRGypfciy4k6/xEfrrQP
+FlrPeDeaurNUjJ4IotTBLzWNAX9nT1HKzvljYNg4A0PwuzPNOmgxUSpAeiPbDoQo
+D/YcQUKWzBlW8qt9ZnuRMGNi6V2fnQeTLblfsheaavXyP11syJ9owz6mDffZELHd
+SYC7j2EOqG+Pndd55MLOac8cF4D9Y91PkLKKjNIrWwIDAQABMA0GCSqGSIb3DQEB
+CwUAA4IBAQBlVJLn17BFmigbqS8JIx0/RTbGokRoLKdg7SZAQJWn20jDtunSo+sp
+ZzuZ4uS8WbgZ+SFD1rrQy3s0F9HssZFBwDGyn31z/sGjkwWpoAP65v1DCaNzmAsz
+xMNijhYlShv61g2IEO9Q98bgBW9LNwmJRGnGxz0ufzeZuUr9IV9EjeoJCKPIbwJC
+lab0Ty/kRC13JgNhHtNFwYVwK6NDt46IRsjxqWQ6bVakrEROlfuoY8sxUjunj+hB
+2vZTkZKaPc0sFvUQjNHxHX4jMeTwCopQCo+qF3lPde+G7C1MNf30kDZlks++GLNs
+0/0Ayfjh6JllWqW482dIIqMErl6s5DuK
+-----END CERTIFICATE-----`
+
+type ExternalDomainFederationResource struct{}
+
+func TestAccExternalDomainFederation_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_external_domain_federation", "test")
+	r := ExternalDomainFederationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("domains.#").HasValue("1"),
+				check.That(data.ResourceName).Key("signing_certificate_thumbprint").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccExternalDomainFederation_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_external_domain_federation", "test")
+	r := ExternalDomainFederationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("domains.#").HasValue("2"),
+				check.That(data.ResourceName).Key("preferred_authentication_protocol").HasValue("wsFed"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccExternalDomainFederation_invalidCertificate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_external_domain_federation", "test")
+	r := ExternalDomainFederationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.invalidCertificate(data),
+			ExpectError: regexp.MustCompile("must be a PEM-encoded or base64-encoded DER X.509 certificate"),
+		},
+	})
+}
+
+func (r ExternalDomainFederationResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.IdentityProviders.FederationConfigurationClient
+
+	id, err := stable.ParseDirectoryFederationConfigurationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetFederationConfiguration(ctx, *id, federationconfiguration.DefaultGetFederationConfigurationOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+
+		return nil, fmt.Errorf("retrieving %s: %v", id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (r ExternalDomainFederationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_external_domain_federation" "test" {
+  display_name                      = "acctest-federation-%[1]s"
+  domains                           = ["acctest%[1]s.example.com"]
+  issuer_uri                        = "https://idp.acctest%[1]s.example.com/issuer"
+  passive_sign_in_uri               = "https://idp.acctest%[1]s.example.com/sso"
+  preferred_authentication_protocol = "saml"
+  signing_certificate               = <<EOT
+%[2]s
+EOT
+}
+`, data.RandomString, externalDomainFederationCertificatePem)
+}
+
+func (r ExternalDomainFederationResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_external_domain_federation" "test" {
+  display_name                      = "acctest-federation-updated-%[1]s"
+  domains                           = ["acctest%[1]s.example.com", "acctest%[1]s.example.net"]
+  issuer_uri                        = "https://idp.acctest%[1]s.example.com/issuer"
+  passive_sign_in_uri               = "https://idp.acctest%[1]s.example.com/adfs/ls"
+  metadata_exchange_uri             = "https://idp.acctest%[1]s.example.com/adfs/services/trust/mex"
+  preferred_authentication_protocol = "wsFed"
+  signing_certificate               = <<EOT
+%[2]s
+EOT
+}
+`, data.RandomString, externalDomainFederationCertificatePem)
+}
+
+func (r ExternalDomainFederationResource) invalidCertificate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_external_domain_federation" "test" {
+  display_name                      = "acctest-federation-%[1]s"
+  domains                           = ["acctest%[1]s.example.com"]
+  issuer_uri                        = "https://idp.acctest%[1]s.example.com/issuer"
+  passive_sign_in_uri               = "https://idp.acctest%[1]s.example.com/sso"
+  preferred_authentication_protocol = "saml"
+  signing_certificate               = "not a certificate"
+}
+`, data.RandomString)
+}
@@ -0,0 +1,99 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package identityproviders
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identity/stable/identityprovider"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/consistency"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+)
+
+func validateIdentityProviderId(id string) error {
+	if _, errs := stable.ValidateIdentityIdentityProviderID(id, "id"); len(errs) > 0 {
+		out := ""
+		for _, err := range errs {
+			out += err.Error()
+		}
+		return errors.New(out)
+	}
+	return nil
+}
+
+// waitForIdentityProvider waits until a newly created identity provider can be retrieved consistently
+func waitForIdentityProvider(ctx context.Context, client *identityprovider.IdentityProviderClient, id stable.IdentityIdentityProviderId) error {
+	return consistency.WaitForUpdate(ctx, func(ctx context.Context) (*bool, error) {
+		resp, err := client.GetIdentityProvider(ctx, id, identityprovider.DefaultGetIdentityProviderOperationOptions())
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return pointer.To(false), err
+		}
+		return pointer.To(resp.Model != nil), nil
+	})
+}
+
+func deleteIdentityProvider(ctx context.Context, client *identityprovider.IdentityProviderClient, id stable.IdentityIdentityProviderId) error {
+	if _, err := client.DeleteIdentityProvider(ctx, id, identityprovider.DefaultDeleteIdentityProviderOperationOptions()); err != nil {
+		return fmt.Errorf("deleting %s: %+v", id, err)
+	}
+
+	if err := consistency.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+		if resp, err := client.GetIdentityProvider(ctx, id, identityprovider.DefaultGetIdentityProviderOperationOptions()); err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+		return pointer.To(true), nil
+	}); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func identityProviderResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).IdentityProviders.IdentityProviderClient
+
+	id, err := stable.ParseIdentityIdentityProviderID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing ID")
+	}
+
+	if err = deleteIdentityProvider(ctx, client, *id); err != nil {
+		return tf.ErrorDiagF(err, "Deleting identity provider")
+	}
+
+	return nil
+}
+
+// decodeRawIdentityProvider decodes an identity provider which is not modelled by the SDK, into the specified model
+func decodeRawIdentityProvider(in stable.IdentityProviderBase, out interface{}) error {
+	raw, ok := in.(stable.RawIdentityProviderBaseImpl)
+	if !ok {
+		return fmt.Errorf("unexpected identity provider type %q", pointer.From(in.IdentityProviderBase().ODataType))
+	}
+
+	encoded, err := json.Marshal(raw.Values)
+	if err != nil {
+		return fmt.Errorf("marshaling identity provider: %+v", err)
+	}
+
+	if err = json.Unmarshal(encoded, out); err != nil {
+		return fmt.Errorf("unmarshaling identity provider: %+v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,392 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package identityproviders
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/beta"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identity/stable/identityprovider"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+)
+
+func oidcIdentityProviderResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: oidcIdentityProviderResourceCreate,
+		ReadContext:   oidcIdentityProviderResourceRead,
+		UpdateContext: oidcIdentityProviderResourceUpdate,
+		DeleteContext: oidcIdentityProviderResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(validateIdentityProviderId),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"display_name": {
+				Description:  "The display name of the identity provider",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+
+			"client_id": {
+				Description:  "The client ID of the application registered with the identity provider",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+
+			"client_secret_wo": {
+				Description:  "The client secret of the application registered with the identity provider. This value is write-only and is never stored in state",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				WriteOnly:    true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+
+			"client_secret_wo_version": {
+				Description: "A version number for the client secret. Changing this value causes the client secret to be sent to the identity provider configuration",
+				Type:        pluginsdk.TypeInt,
+				Optional:    true,
+			},
+
+			"issuer": {
+				Description:  "The issuer URI of the identity provider",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsHttpsUrl,
+			},
+
+			"well_known_endpoint": {
+				Description:  "The URL of the OpenID Connect metadata document of the identity provider",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsHttpsUrl,
+			},
+
+			"scope": {
+				Description:  "A space-separated list of scopes to request from the identity provider",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+
+			"response_type": {
+				Description:  "The response type to request from the identity provider",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Default:      string(beta.OidcResponseType_Code),
+				ValidateFunc: validation.StringInSlice(beta.PossibleValuesForOidcResponseType(), false),
+			},
+
+			"inbound_claim_mapping": {
+				Description: "The mapping of claims issued by the identity provider to the claims used by Microsoft Entra ID",
+				Type:        pluginsdk.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"sub": {
+							Description:  "The claim containing the unique identifier of the user at the identity provider",
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+
+						"name": {
+							Description: "The claim containing the full name of the user",
+							Type:        pluginsdk.TypeString,
+							Optional:    true,
+						},
+
+						"given_name": {
+							Description: "The claim containing the given name of the user",
+							Type:        pluginsdk.TypeString,
+							Optional:    true,
+						},
+
+						"family_name": {
+							Description: "The claim containing the family name of the user",
+							Type:        pluginsdk.TypeString,
+							Optional:    true,
+						},
+
+						"email": {
+							Description: "The claim containing the email address of the user",
+							Type:        pluginsdk.TypeString,
+							Optional:    true,
+						},
+
+						"email_verified": {
+							Description: "The claim indicating whether the email address of the user has been verified",
+							Type:        pluginsdk.TypeString,
+							Optional:    true,
+						},
+
+						"phone_number": {
+							Description: "The claim containing the phone number of the user",
+							Type:        pluginsdk.TypeString,
+							Optional:    true,
+						},
+
+						"phone_number_verified": {
+							Description: "The claim indicating whether the phone number of the user has been verified",
+							Type:        pluginsdk.TypeString,
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func oidcIdentityProviderResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).IdentityProviders.IdentityProviderBetaClient
+
+	clientSecret, err := pluginsdk.GetWriteOnlyString(d, "client_secret_wo")
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "client_secret_wo", "Could not retrieve client secret")
+	}
+
+	properties := expandOidcIdentityProvider(d)
+	properties.ClientAuthentication = beta.OidcClientSecretAuthentication{
+		ClientSecret: pointer.To(clientSecret),
+	}
+
+	resp, err := client.CreateIdentityProvider(ctx, properties, identityprovider.DefaultCreateIdentityProviderOperationOptions())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Creating OpenID Connect identity provider")
+	}
+
+	if resp.Model == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Creating OpenID Connect identity provider")
+	}
+
+	providerId := resp.Model.IdentityProviderBase().Id
+	if providerId == nil || *providerId == "" {
+		return tf.ErrorDiagF(errors.New("API returned identity provider with nil ID"), "Bad API Response")
+	}
+
+	id := stable.NewIdentityIdentityProviderID(*providerId)
+	d.SetId(id.ID())
+
+	if err = waitForIdentityProvider(ctx, client, id); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for creation of %s", id)
+	}
+
+	return oidcIdentityProviderResourceRead(ctx, d, meta)
+}
+
+func oidcIdentityProviderResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).IdentityProviders.IdentityProviderBetaClient
+
+	id, err := stable.ParseIdentityIdentityProviderID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing ID")
+	}
+
+	properties := expandOidcIdentityProvider(d)
+
+	if d.HasChange("client_secret_wo_version") {
+		clientSecret, err := pluginsdk.GetWriteOnlyString(d, "client_secret_wo")
+		if err != nil {
+			return tf.ErrorDiagPathF(err, "client_secret_wo", "Could not retrieve client secret")
+		}
+		properties.ClientAuthentication = beta.OidcClientSecretAuthentication{
+			ClientSecret: pointer.To(clientSecret),
+		}
+	}
+
+	if _, err = client.UpdateIdentityProvider(ctx, *id, properties, identityprovider.DefaultUpdateIdentityProviderOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Updating %s", id)
+	}
+
+	return oidcIdentityProviderResourceRead(ctx, d, meta)
+}
+
+func oidcIdentityProviderResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).IdentityProviders.IdentityProviderBetaClient
+
+	id, err := stable.ParseIdentityIdentityProviderID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing ID")
+	}
+
+	resp, err := client.GetIdentityProvider(ctx, *id, identityprovider.DefaultGetIdentityProviderOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", id)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving %s", id)
+	}
+
+	if resp.Model == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", id)
+	}
+
+	var provider beta.OidcIdentityProvider
+	if err = decodeRawIdentityProvider(resp.Model, &provider); err != nil {
+		return tf.ErrorDiagF(err, "Retrieving %s", id)
+	}
+
+	tf.Set(d, "client_id", pointer.From(provider.ClientId))
+	tf.Set(d, "display_name", provider.DisplayName.GetOrZero())
+	tf.Set(d, "inbound_claim_mapping", flattenOidcInboundClaimMapping(provider.InboundClaimMapping))
+	tf.Set(d, "issuer", pointer.From(provider.Issuer))
+	tf.Set(d, "response_type", string(pointer.From(provider.ResponseType)))
+	tf.Set(d, "scope", pointer.From(provider.Scope))
+	tf.Set(d, "well_known_endpoint", provider.WellKnownEndpoint.GetOrZero())
+
+	return nil
+}
+
+func oidcIdentityProviderResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).IdentityProviders.IdentityProviderBetaClient
+
+	id, err := stable.ParseIdentityIdentityProviderID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing ID")
+	}
+
+	if err = deleteIdentityProvider(ctx, client, *id); err != nil {
+		return tf.ErrorDiagF(err, "Deleting identity provider")
+	}
+
+	return nil
+}
+
+// oidcIdentityProvider wraps the beta OidcIdentityProvider model, so that it can be sent using the identity provider
+// client, which otherwise only accepts stable models
+type oidcIdentityProvider struct {
+	beta.OidcIdentityProvider
+}
+
+var _ stable.IdentityProviderBase = oidcIdentityProvider{}
+
+func (s oidcIdentityProvider) IdentityProviderBase() stable.BaseIdentityProviderBaseImpl {
+	return stable.BaseIdentityProviderBaseImpl{
+		DisplayName: s.DisplayName,
+		Id:          s.Id,
+		ODataId:     s.ODataId,
+		ODataType:   s.ODataType,
+	}
+}
+
+func (s oidcIdentityProvider) Entity() stable.BaseEntityImpl {
+	return stable.BaseEntityImpl{
+		Id:        s.Id,
+		ODataId:   s.ODataId,
+		ODataType: s.ODataType,
+	}
+}
+
+var _ json.Marshaler = oidcIdentityProvider{}
+
+// MarshalJSON omits the client authentication when it is not being changed, since the API does not accept a null value
+func (s oidcIdentityProvider) MarshalJSON() ([]byte, error) {
+	encoded, err := s.OidcIdentityProvider.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	if s.ClientAuthentication != nil {
+		return encoded, nil
+	}
+
+	var decoded map[string]interface{}
+	if err = json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, fmt.Errorf("unmarshaling oidcIdentityProvider: %+v", err)
+	}
+
+	delete(decoded, "clientAuthentication")
+
+	return json.Marshal(decoded)
+}
+
+func expandOidcIdentityProvider(d *pluginsdk.ResourceData) oidcIdentityProvider {
+	return oidcIdentityProvider{
+		OidcIdentityProvider: beta.OidcIdentityProvider{
+			ClientId:            pointer.To(d.Get("client_id").(string)),
+			DisplayName:         nullable.Value(d.Get("display_name").(string)),
+			InboundClaimMapping: expandOidcInboundClaimMapping(d.Get("inbound_claim_mapping").([]interface{})),
+			Issuer:              pointer.To(d.Get("issuer").(string)),
+			ResponseType:        pointer.To(beta.OidcResponseType(d.Get("response_type").(string))),
+			Scope:               pointer.To(d.Get("scope").(string)),
+			WellKnownEndpoint:   nullable.Value(d.Get("well_known_endpoint").(string)),
+		},
+	}
+}
+
+func expandOidcInboundClaimMapping(in []interface{}) *beta.OidcInboundClaimMappingOverride {
+	if len(in) == 0 || in[0] == nil {
+		return nil
+	}
+	mapping := in[0].(map[string]interface{})
+
+	result := beta.OidcInboundClaimMappingOverride{
+		Sub: nullable.Value(mapping["sub"].(string)),
+	}
+
+	if v := mapping["name"].(string); v != "" {
+		result.Name = nullable.Value(v)
+	}
+	if v := mapping["given_name"].(string); v != "" {
+		result.Givenname = nullable.Value(v)
+	}
+	if v := mapping["family_name"].(string); v != "" {
+		result.Familyname = nullable.Value(v)
+	}
+	if v := mapping["email"].(string); v != "" {
+		result.Email = nullable.Value(v)
+	}
+	if v := mapping["email_verified"].(string); v != "" {
+		result.Emailverified = nullable.Value(v)
+	}
+	if v := mapping["phone_number"].(string); v != "" {
+		result.Phonenumber = nullable.Value(v)
+	}
+	if v := mapping["phone_number_verified"].(string); v != "" {
+		result.Phonenumberverified = nullable.Value(v)
+	}
+
+	return &result
+}
+
+func flattenOidcInboundClaimMapping(in *beta.OidcInboundClaimMappingOverride) []map[string]interface{} {
+	if in == nil {
+		return []map[string]interface{}{}
+	}
+
+	return []map[string]interface{}{{
+		"email":                 in.Email.GetOrZero(),
+		"email_verified":        in.Emailverified.GetOrZero(),
+		"family_name":           in.Familyname.GetOrZero(),
+		"given_name":            in.Givenname.GetOrZero(),
+		"name":                  in.Name.GetOrZero(),
+		"phone_number":          in.Phonenumber.GetOrZero(),
+		"phone_number_verified": in.Phonenumberverified.GetOrZero(),
+		"sub":                   in.Sub.GetOrZero(),
+	}}
+}
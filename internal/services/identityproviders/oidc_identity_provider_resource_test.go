@@ -0,0 +1,134 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package identityproviders_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identity/stable/identityprovider"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+)
+
+type OidcIdentityProviderResource struct{}
+
+func TestAccOidcIdentityProvider_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_oidc_identity_provider", "test")
+	r := OidcIdentityProviderResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("client_secret_wo").DoesNotExist(),
+				check.That(data.ResourceName).Key("response_type").HasValue("code"),
+			),
+		},
+		data.ImportStep("client_secret_wo_version"),
+	})
+}
+
+func TestAccOidcIdentityProvider_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_oidc_identity_provider", "test")
+	r := OidcIdentityProviderResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("client_secret_wo_version"),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("inbound_claim_mapping.0.email").HasValue("email"),
+			),
+		},
+		data.ImportStep("client_secret_wo_version"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("client_secret_wo_version"),
+	})
+}
+
+func (r OidcIdentityProviderResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.IdentityProviders.IdentityProviderBetaClient
+
+	id, err := stable.ParseIdentityIdentityProviderID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetIdentityProvider(ctx, *id, identityprovider.DefaultGetIdentityProviderOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+
+		return nil, fmt.Errorf("retrieving %s: %v", id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (r OidcIdentityProviderResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_oidc_identity_provider" "test" {
+  display_name             = "acctest-oidc-%[1]s"
+  client_id                = "acctest-client-%[1]s"
+  client_secret_wo         = "acctest-secret-%[1]s"
+  client_secret_wo_version = 1
+  issuer                   = "https://accounts.google.com"
+  well_known_endpoint      = "https://accounts.google.com/.well-known/openid-configuration"
+  scope                    = "openid profile"
+
+  inbound_claim_mapping {
+    sub = "sub"
+  }
+}
+`, data.RandomString)
+}
+
+func (r OidcIdentityProviderResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_oidc_identity_provider" "test" {
+  display_name             = "acctest-oidc-updated-%[1]s"
+  client_id                = "acctest-client-%[1]s"
+  client_secret_wo         = "acctest-secret-updated-%[1]s"
+  client_secret_wo_version = 2
+  issuer                   = "https://accounts.google.com"
+  well_known_endpoint      = "https://accounts.google.com/.well-known/openid-configuration"
+  scope                    = "openid profile email"
+  response_type            = "code"
+
+  inbound_claim_mapping {
+    sub            = "sub"
+    name           = "name"
+    given_name     = "given_name"
+    family_name    = "family_name"
+    email          = "email"
+    email_verified = "email_verified"
+  }
+}
+`, data.RandomString)
+}
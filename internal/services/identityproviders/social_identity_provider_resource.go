@@ -0,0 +1,191 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package identityproviders
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identity/stable/identityprovider"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+)
+
+func socialIdentityProviderResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: socialIdentityProviderResourceCreate,
+		ReadContext:   socialIdentityProviderResourceRead,
+		UpdateContext: socialIdentityProviderResourceUpdate,
+		DeleteContext: identityProviderResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(validateIdentityProviderId),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"display_name": {
+				Description:  "The display name of the identity provider",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+
+			"identity_provider_type": {
+				Description: "The type of social identity provider",
+				Type:        pluginsdk.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Amazon",
+					"Facebook",
+					"GitHub",
+					"Google",
+					"LinkedIn",
+					"Microsoft",
+					"QQ",
+					"Twitter",
+					"WeChat",
+					"Weibo",
+				}, false),
+			},
+
+			"client_id": {
+				Description:  "The client ID of the application registered with the identity provider",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+
+			"client_secret_wo": {
+				Description:  "The client secret of the application registered with the identity provider. This value is write-only and is never stored in state",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				WriteOnly:    true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+
+			"client_secret_wo_version": {
+				Description: "A version number for the client secret. Changing this value causes the client secret to be sent to the identity provider configuration",
+				Type:        pluginsdk.TypeInt,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func socialIdentityProviderResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).IdentityProviders.IdentityProviderClient
+
+	clientSecret, err := pluginsdk.GetWriteOnlyString(d, "client_secret_wo")
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "client_secret_wo", "Could not retrieve client secret")
+	}
+
+	properties := stable.SocialIdentityProvider{
+		ClientId:             nullable.Value(d.Get("client_id").(string)),
+		ClientSecret:         nullable.Value(clientSecret),
+		DisplayName:          nullable.Value(d.Get("display_name").(string)),
+		IdentityProviderType: nullable.Value(d.Get("identity_provider_type").(string)),
+	}
+
+	resp, err := client.CreateIdentityProvider(ctx, properties, identityprovider.DefaultCreateIdentityProviderOperationOptions())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Creating social identity provider")
+	}
+
+	if resp.Model == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Creating social identity provider")
+	}
+
+	providerId := resp.Model.IdentityProviderBase().Id
+	if providerId == nil || *providerId == "" {
+		return tf.ErrorDiagF(errors.New("API returned identity provider with nil ID"), "Bad API Response")
+	}
+
+	id := stable.NewIdentityIdentityProviderID(*providerId)
+	d.SetId(id.ID())
+
+	if err = waitForIdentityProvider(ctx, client, id); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for creation of %s", id)
+	}
+
+	return socialIdentityProviderResourceRead(ctx, d, meta)
+}
+
+func socialIdentityProviderResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).IdentityProviders.IdentityProviderClient
+
+	id, err := stable.ParseIdentityIdentityProviderID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing ID")
+	}
+
+	properties := stable.SocialIdentityProvider{
+		ClientId:    nullable.Value(d.Get("client_id").(string)),
+		DisplayName: nullable.Value(d.Get("display_name").(string)),
+	}
+
+	if d.HasChange("client_secret_wo_version") {
+		clientSecret, err := pluginsdk.GetWriteOnlyString(d, "client_secret_wo")
+		if err != nil {
+			return tf.ErrorDiagPathF(err, "client_secret_wo", "Could not retrieve client secret")
+		}
+		properties.ClientSecret = nullable.Value(clientSecret)
+	}
+
+	if _, err = client.UpdateIdentityProvider(ctx, *id, properties, identityprovider.DefaultUpdateIdentityProviderOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Updating %s", id)
+	}
+
+	return socialIdentityProviderResourceRead(ctx, d, meta)
+}
+
+func socialIdentityProviderResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).IdentityProviders.IdentityProviderClient
+
+	id, err := stable.ParseIdentityIdentityProviderID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing ID")
+	}
+
+	resp, err := client.GetIdentityProvider(ctx, *id, identityprovider.DefaultGetIdentityProviderOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", id)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving %s", id)
+	}
+
+	if resp.Model == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", id)
+	}
+
+	provider, ok := resp.Model.(stable.SocialIdentityProvider)
+	if !ok {
+		return tf.ErrorDiagF(fmt.Errorf("unexpected identity provider type %q", pointer.From(resp.Model.IdentityProviderBase().ODataType)), "Retrieving %s", id)
+	}
+
+	tf.Set(d, "client_id", provider.ClientId.GetOrZero())
+	tf.Set(d, "display_name", provider.DisplayName.GetOrZero())
+	tf.Set(d, "identity_provider_type", provider.IdentityProviderType.GetOrZero())
+
+	return nil
+}
@@ -0,0 +1,136 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package identityproviders_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identity/stable/identityprovider"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+)
+
+type SocialIdentityProviderResource struct{}
+
+// Only a single social identity provider of each type can exist in a tenant, so these tests must not run in parallel
+func TestAccSocialIdentityProviderResource_serialised(t *testing.T) {
+	testCases := map[string]map[string]func(t *testing.T){
+		"basic": {
+			"google":   testAccSocialIdentityProvider_google,
+			"facebook": testAccSocialIdentityProvider_facebook,
+		},
+		"updates": {
+			"update": testAccSocialIdentityProvider_update,
+		},
+	}
+
+	for group, m := range testCases {
+		m := m
+		t.Run(group, func(t *testing.T) {
+			for name, tc := range m {
+				tc := tc
+				t.Run(name, func(t *testing.T) {
+					tc(t)
+				})
+			}
+		})
+	}
+}
+
+func testAccSocialIdentityProvider_google(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_social_identity_provider", "test")
+	r := SocialIdentityProviderResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "Google", 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("identity_provider_type").HasValue("Google"),
+				check.That(data.ResourceName).Key("client_secret_wo").DoesNotExist(),
+			),
+		},
+		data.ImportStep("client_secret_wo_version"),
+	})
+}
+
+func testAccSocialIdentityProvider_facebook(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_social_identity_provider", "test")
+	r := SocialIdentityProviderResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "Facebook", 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("identity_provider_type").HasValue("Facebook"),
+			),
+		},
+		data.ImportStep("client_secret_wo_version"),
+	})
+}
+
+func testAccSocialIdentityProvider_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_social_identity_provider", "test")
+	r := SocialIdentityProviderResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "Google", 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("client_secret_wo_version"),
+		{
+			Config: r.basic(data, "Google", 2),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("client_secret_wo_version").HasValue("2"),
+			),
+		},
+		data.ImportStep("client_secret_wo_version"),
+	})
+}
+
+func (r SocialIdentityProviderResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.IdentityProviders.IdentityProviderClient
+
+	id, err := stable.ParseIdentityIdentityProviderID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetIdentityProvider(ctx, *id, identityprovider.DefaultGetIdentityProviderOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+
+		return nil, fmt.Errorf("retrieving %s: %v", id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (r SocialIdentityProviderResource) basic(data acceptance.TestData, providerType string, secretVersion int) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_social_identity_provider" "test" {
+  display_name             = "acctest-%[1]s-%[2]s"
+  identity_provider_type   = "%[1]s"
+  client_id                = "acctest-client-%[2]s"
+  client_secret_wo         = "acctest-secret-%[2]s-%[3]d"
+  client_secret_wo_version = %[3]d
+}
+`, providerType, data.RandomString, secretVersion)
+}
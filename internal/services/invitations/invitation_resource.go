@@ -30,11 +30,15 @@ func invitationResource() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		CreateContext: invitationResourceCreate,
 		ReadContext:   invitationResourceRead,
+		UpdateContext: invitationResourceUpdate,
 		DeleteContext: invitationResourceDelete,
 
+		CustomizeDiff: invitationResourceCustomizeDiff,
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
 			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
 		},
 
@@ -48,10 +52,9 @@ func invitationResource() *pluginsdk.Resource {
 			},
 
 			"user_email_address": {
-				Description:  "The email address of the user being invited",
+				Description:  "The email address of the user being invited. When `reset_redemption` is `true`, changing this reissues the invitation for the existing user",
 				Type:         pluginsdk.TypeString,
 				Required:     true,
-				ForceNew:     true,
 				ValidateFunc: validation.StringIsEmailAddress,
 			},
 
@@ -110,6 +113,34 @@ func invitationResource() *pluginsdk.Resource {
 				ValidateFunc: validation.StringInSlice(possibleValuesForInvitedUserType, false),
 			},
 
+			"resend_when_changed": {
+				Description: "Arbitrary map of values that, when changed, will cause the invitation to be sent again",
+				Type:        pluginsdk.TypeMap,
+				Optional:    true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"reset_redemption": {
+				Description: "Whether to reset the redemption status of the invited user when the invitation is sent again, so that the user must redeem the new invitation",
+				Type:        pluginsdk.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+
+			"external_user_state": {
+				Description: "The redemption status of the invitation for the invited user, either `PendingAcceptance` or `Accepted`",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+
+			"external_user_state_change_date_time": {
+				Description: "The timestamp of the latest change to `external_user_state`",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+
 			"redeem_url": {
 				Description: "The URL the user can use to redeem their invitation",
 				Type:        pluginsdk.TypeString,
@@ -125,24 +156,22 @@ func invitationResource() *pluginsdk.Resource {
 	}
 }
 
+func invitationResourceCustomizeDiff(_ context.Context, diff *pluginsdk.ResourceDiff, _ interface{}) error {
+	// The email address of an existing invited user can only be changed by resetting redemption
+	if diff.Id() != "" && diff.HasChange("user_email_address") && !diff.Get("reset_redemption").(bool) {
+		if err := diff.ForceNew("user_email_address"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func invitationResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
 	client := meta.(*clients.Client).Invitations.InvitationClient
 	userClient := meta.(*clients.Client).Invitations.UserClient
 
-	properties := stable.Invitation{
-		InvitedUserEmailAddress: d.Get("user_email_address").(string),
-		InviteRedirectUrl:       d.Get("redirect_url").(string),
-		InvitedUserType:         nullable.Value(d.Get("user_type").(string)),
-	}
-
-	if v, ok := d.GetOk("user_display_name"); ok {
-		properties.InvitedUserDisplayName = nullable.Value(v.(string))
-	}
-
-	if v, ok := d.GetOk("message"); ok {
-		properties.SendInvitationMessage = nullable.Value(true)
-		properties.InvitedUserMessageInfo = expandInvitedUserMessageInfo(v.([]interface{}))
-	}
+	properties := expandInvitation(d)
 
 	resp, err := client.CreateInvitation(ctx, properties, invitation.DefaultCreateInvitationOperationOptions())
 	if err != nil {
@@ -222,7 +251,11 @@ func invitationResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta
 	client := meta.(*clients.Client).Invitations.UserClient
 	userId := stable.NewUserID(d.Get("user_id").(string))
 
-	resp, err := client.GetUser(ctx, userId, user.DefaultGetUserOperationOptions())
+	options := user.GetUserOperationOptions{
+		Select: &[]string{"externalUserState", "externalUserStateChangeDateTime", "id", "mail"},
+	}
+
+	resp, err := client.GetUser(ctx, userId, options)
 	if err != nil {
 		if response.WasNotFound(resp.HttpResponse) {
 			log.Printf("[DEBUG] Invited %s was not found - removing from state!", userId)
@@ -236,12 +269,54 @@ func invitationResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta
 		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving invited %s", userId)
 	}
 
+	tf.Set(d, "external_user_state", resp.Model.ExternalUserState.GetOrZero())
+	tf.Set(d, "external_user_state_change_date_time", resp.Model.ExternalUserStateChangeDateTime.GetOrZero())
 	tf.Set(d, "user_id", userId.UserId)
 	tf.Set(d, "user_email_address", resp.Model.Mail.GetOrZero())
 
 	return nil
 }
 
+func invitationResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Invitations.InvitationClient
+	userId := stable.NewUserID(d.Get("user_id").(string))
+
+	if !d.HasChanges("resend_when_changed", "user_email_address") {
+		return invitationResourceRead(ctx, d, meta)
+	}
+
+	properties := expandInvitation(d)
+
+	if d.Get("reset_redemption").(bool) {
+		properties.ResetRedemption = nullable.Value(true)
+		properties.InvitedUser = &stable.User{
+			Id: pointer.To(userId.UserId),
+		}
+	}
+
+	resp, err := client.CreateInvitation(ctx, properties, invitation.DefaultCreateInvitationOperationOptions())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Reissuing invitation for %s", userId)
+	}
+
+	invite := resp.Model
+	if invite == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Reissuing invitation for %s", userId)
+	}
+
+	// Reissuing an invitation should never result in a new guest user, which would otherwise be orphaned
+	if invite.InvitedUser == nil || invite.InvitedUser.Id == nil || *invite.InvitedUser.Id != userId.UserId {
+		return tf.ErrorDiagF(errors.New("Bad API response"), "Reissued invitation was not for the existing invited %s", userId)
+	}
+
+	if invite.InviteRedeemUrl.GetOrZero() == "" {
+		return tf.ErrorDiagF(errors.New("Bad API response"), "Redeem URL returned for invitation is nil/empty")
+	}
+	tf.Set(d, "redeem_url", invite.InviteRedeemUrl.GetOrZero())
+
+	return invitationResourceRead(ctx, d, meta)
+}
+
 func invitationResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
 	client := meta.(*clients.Client).Invitations.UserClient
 	userId := stable.NewUserID(d.Get("user_id").(string))
@@ -125,6 +125,54 @@ func TestAccInvitation_messageWithLanguage(t *testing.T) {
 	})
 }
 
+func TestAccInvitation_resend(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_invitation", "test")
+	r := InvitationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.resend(data, "1"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("external_user_state").HasValue("PendingAcceptance"),
+				check.That(data.ResourceName).Key("external_user_state_change_date_time").Exists(),
+				check.That(data.ResourceName).Key("redeem_url").Exists(),
+			),
+		},
+		{
+			Config: r.resend(data, "2"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("external_user_state").HasValue("PendingAcceptance"),
+				check.That(data.ResourceName).Key("redeem_url").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccInvitation_resetRedemption(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_invitation", "test")
+	r := InvitationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.resetRedemption(data, "test.com"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("user_id").Exists(),
+			),
+		},
+		{
+			Config: r.resetRedemption(data, "example.com"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("user_email_address").HasValue(fmt.Sprintf("acctest-user-%s@example.com", data.RandomString)),
+				check.That(data.ResourceName).Key("external_user_state").HasValue("PendingAcceptance"),
+			),
+		},
+	})
+}
+
 func TestAccInvitation_withGroupMembership(t *testing.T) {
 	count := 10
 	data := acceptance.BuildTestData(t, "azuread_invitation", fmt.Sprintf("test.%d", count-1))
@@ -215,6 +263,31 @@ resource "azuread_invitation" "test" {
 `, data.RandomString)
 }
 
+func (InvitationResource) resend(data acceptance.TestData, trigger string) string {
+	return fmt.Sprintf(`
+resource "azuread_invitation" "test" {
+  redirect_url       = "https://portal.azure.com"
+  user_email_address = "acctest-user-%[1]s@test.com"
+
+  message {}
+
+  resend_when_changed = {
+    trigger = "%[2]s"
+  }
+}
+`, data.RandomString, trigger)
+}
+
+func (InvitationResource) resetRedemption(data acceptance.TestData, emailDomain string) string {
+	return fmt.Sprintf(`
+resource "azuread_invitation" "test" {
+  redirect_url       = "https://portal.azure.com"
+  user_email_address = "acctest-user-%[1]s@%[2]s"
+  reset_redemption   = true
+}
+`, data.RandomString, emailDomain)
+}
+
 func (InvitationResource) withGroupMembership(data acceptance.TestData, count int) string {
 	return fmt.Sprintf(`
 resource "azuread_group" "test" {
@@ -6,8 +6,28 @@ package invitations
 import (
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
 	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
 )
 
+func expandInvitation(d *pluginsdk.ResourceData) stable.Invitation {
+	properties := stable.Invitation{
+		InvitedUserEmailAddress: d.Get("user_email_address").(string),
+		InviteRedirectUrl:       d.Get("redirect_url").(string),
+		InvitedUserType:         nullable.Value(d.Get("user_type").(string)),
+	}
+
+	if v, ok := d.GetOk("user_display_name"); ok {
+		properties.InvitedUserDisplayName = nullable.Value(v.(string))
+	}
+
+	if v, ok := d.GetOk("message"); ok {
+		properties.SendInvitationMessage = nullable.Value(true)
+		properties.InvitedUserMessageInfo = expandInvitedUserMessageInfo(v.([]interface{}))
+	}
+
+	return properties
+}
+
 func expandInvitedUserMessageInfo(in []interface{}) *stable.InvitedUserMessageInfo {
 	if len(in) == 0 || in[0] == nil {
 		return nil
@@ -0,0 +1,261 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package policies
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/adminconsentrequestpolicy"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/hashicorp/terraform-provider-azuread/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/policies/parse"
+)
+
+type AdminConsentRequestPolicyModel struct {
+	Enabled               bool                                     `tfschema:"enabled"`
+	NotifyReviewers       bool                                     `tfschema:"notify_reviewers"`
+	RemindersEnabled      bool                                     `tfschema:"reminders_enabled"`
+	RequestDurationInDays int64                                    `tfschema:"request_duration_in_days"`
+	Reviewers             []AdminConsentRequestPolicyReviewerModel `tfschema:"reviewer"`
+	Version               int64                                    `tfschema:"version"`
+}
+
+type AdminConsentRequestPolicyReviewerModel struct {
+	Query     string `tfschema:"query"`
+	QueryRoot string `tfschema:"query_root"`
+	QueryType string `tfschema:"query_type"`
+}
+
+var _ sdk.ResourceWithUpdate = AdminConsentRequestPolicyResource{}
+
+type AdminConsentRequestPolicyResource struct{}
+
+func (r AdminConsentRequestPolicyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return parse.ValidateAdminConsentRequestPolicyID
+}
+
+func (r AdminConsentRequestPolicyResource) ResourceType() string {
+	return "azuread_admin_consent_request_policy"
+}
+
+func (r AdminConsentRequestPolicyResource) ModelObject() interface{} {
+	return &AdminConsentRequestPolicyModel{}
+}
+
+func (r AdminConsentRequestPolicyResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"enabled": {
+			Description: "Whether the admin consent request workflow is enabled",
+			Type:        pluginsdk.TypeBool,
+			Required:    true,
+		},
+
+		"notify_reviewers": {
+			Description: "Whether reviewers will receive notifications",
+			Type:        pluginsdk.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+
+		"reminders_enabled": {
+			Description: "Whether reviewers will receive reminder emails",
+			Type:        pluginsdk.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+
+		"request_duration_in_days": {
+			Description:  "The number of days a request is active before it automatically expires if no decision is made",
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      30,
+			ValidateFunc: validation.IntBetween(1, 30),
+		},
+
+		"reviewer": {
+			Description: "One or more reviewers for admin consent requests",
+			Type:        pluginsdk.TypeList,
+			Optional:    true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"query": {
+						Description:  "The query specifying who will be the reviewer, e.g. `/users/00000000-0000-0000-0000-000000000000`",
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"query_root": {
+						Description:  "The relative source of the query, required only for relative queries such as `./manager`",
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"query_type": {
+						Description:  "The type of query",
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      "MicrosoftGraph",
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r AdminConsentRequestPolicyResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"version": {
+			Description: "The version of the policy, which is incremented each time the policy is updated",
+			Type:        pluginsdk.TypeInt,
+			Computed:    true,
+		},
+	}
+}
+
+func (r AdminConsentRequestPolicyResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Policies.AdminConsentRequestPolicyClient
+
+			var model AdminConsentRequestPolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := parse.NewAdminConsentRequestPolicyID()
+
+			// The admin consent request policy always exists, so we configure it in place
+			if _, err := client.UpdateAdminConsentRequestPolicy(ctx, expandAdminConsentRequestPolicy(model), adminconsentrequestpolicy.DefaultUpdateAdminConsentRequestPolicyOperationOptions()); err != nil {
+				return fmt.Errorf("configuring %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r AdminConsentRequestPolicyResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Policies.AdminConsentRequestPolicyClient
+
+			id, err := parse.ParseAdminConsentRequestPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.GetAdminConsentRequestPolicy(ctx, adminconsentrequestpolicy.DefaultGetAdminConsentRequestPolicyOperationOptions())
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			policy := resp.Model
+			if policy == nil {
+				return fmt.Errorf("retrieving %s: model was nil", id)
+			}
+
+			state := AdminConsentRequestPolicyModel{
+				Enabled:               policy.IsEnabled,
+				NotifyReviewers:       policy.NotifyReviewers,
+				RemindersEnabled:      policy.RemindersEnabled,
+				RequestDurationInDays: pointer.From(policy.RequestDurationInDays),
+				Reviewers:             make([]AdminConsentRequestPolicyReviewerModel, 0),
+				Version:               pointer.From(policy.Version),
+			}
+
+			for _, reviewer := range policy.Reviewers {
+				state.Reviewers = append(state.Reviewers, AdminConsentRequestPolicyReviewerModel{
+					Query:     reviewer.Query.GetOrZero(),
+					QueryRoot: reviewer.QueryRoot.GetOrZero(),
+					QueryType: reviewer.QueryType.GetOrZero(),
+				})
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r AdminConsentRequestPolicyResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Policies.AdminConsentRequestPolicyClient
+
+			id, err := parse.ParseAdminConsentRequestPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model AdminConsentRequestPolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if _, err = client.UpdateAdminConsentRequestPolicy(ctx, expandAdminConsentRequestPolicy(model), adminconsentrequestpolicy.DefaultUpdateAdminConsentRequestPolicyOperationOptions()); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r AdminConsentRequestPolicyResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Policies.AdminConsentRequestPolicyClient
+
+			id, err := parse.ParseAdminConsentRequestPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// The admin consent request policy cannot be deleted, so we restore the documented defaults instead, which
+			// disables the workflow and removes all reviewers
+			defaults := AdminConsentRequestPolicyModel{
+				RequestDurationInDays: 30,
+			}
+
+			if _, err = client.UpdateAdminConsentRequestPolicy(ctx, expandAdminConsentRequestPolicy(defaults), adminconsentrequestpolicy.DefaultUpdateAdminConsentRequestPolicyOperationOptions()); err != nil {
+				return fmt.Errorf("restoring defaults for %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandAdminConsentRequestPolicy(model AdminConsentRequestPolicyModel) stable.AdminConsentRequestPolicy {
+	reviewers := make([]stable.AccessReviewReviewerScope, 0)
+	for _, reviewer := range model.Reviewers {
+		reviewers = append(reviewers, stable.AccessReviewReviewerScope{
+			Query:     nullable.Value(reviewer.Query),
+			QueryRoot: nullable.NoZero(reviewer.QueryRoot),
+			QueryType: nullable.NoZero(reviewer.QueryType),
+		})
+	}
+
+	return stable.AdminConsentRequestPolicy{
+		IsEnabled:             model.Enabled,
+		NotifyReviewers:       model.NotifyReviewers,
+		RemindersEnabled:      model.RemindersEnabled,
+		RequestDurationInDays: pointer.To(model.RequestDurationInDays),
+		Reviewers:             reviewers,
+	}
+}
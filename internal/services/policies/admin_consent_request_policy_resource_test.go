@@ -0,0 +1,108 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package policies_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/adminconsentrequestpolicy"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+)
+
+type AdminConsentRequestPolicyResource struct{}
+
+func TestAccAdminConsentRequestPolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_admin_consent_request_policy", "test")
+	r := AdminConsentRequestPolicyResource{}
+
+	// The admin consent request policy is a tenant-wide singleton, so these tests must not run in parallel
+	data.ResourceSequentialTestSkipCheckDestroyed(t, []acceptance.TestStep{
+		{
+			Config: r.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("reviewer.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r AdminConsentRequestPolicyResource) Exists(ctx context.Context, clients *clients.Client, _ *terraform.InstanceState) (*bool, error) {
+	client := clients.Policies.AdminConsentRequestPolicyClient
+
+	if _, err := client.GetAdminConsentRequestPolicy(ctx, adminconsentrequestpolicy.DefaultGetAdminConsentRequestPolicyOperationOptions()); err != nil {
+		return nil, fmt.Errorf("failed to retrieve admin consent request policy: %v", err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (AdminConsentRequestPolicyResource) basic() string {
+	return `
+provider "azuread" {}
+
+resource "azuread_admin_consent_request_policy" "test" {
+  enabled = false
+}
+`
+}
+
+func (AdminConsentRequestPolicyResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser'%[1]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+}
+
+resource "azuread_group" "test" {
+  display_name     = "acctestGroup-%[1]d"
+  security_enabled = true
+}
+
+resource "azuread_admin_consent_request_policy" "test" {
+  enabled                  = true
+  notify_reviewers         = true
+  reminders_enabled        = true
+  request_duration_in_days = 14
+
+  reviewer {
+    query = "/users/${azuread_user.test.object_id}"
+  }
+
+  reviewer {
+    query = "/groups/${azuread_group.test.object_id}/transitiveMembers/microsoft.graph.user"
+  }
+}
+`, data.RandomInteger, data.RandomPassword)
+}
@@ -0,0 +1,148 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package policies
+
+import (
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+)
+
+type AppManagementRestrictionsModel struct {
+	KeyCredentials      []AppManagementKeyCredentialRestrictionModel      `tfschema:"key_credential"`
+	PasswordCredentials []AppManagementPasswordCredentialRestrictionModel `tfschema:"password_credential"`
+}
+
+type AppManagementKeyCredentialRestrictionModel struct {
+	MaxLifetime                 string `tfschema:"max_lifetime"`
+	RestrictForAppsCreatedAfter string `tfschema:"restrict_for_apps_created_after"`
+	RestrictionType             string `tfschema:"restriction_type"`
+	State                       string `tfschema:"state"`
+}
+
+type AppManagementPasswordCredentialRestrictionModel struct {
+	MaxLifetime                 string `tfschema:"max_lifetime"`
+	RestrictForAppsCreatedAfter string `tfschema:"restrict_for_apps_created_after"`
+	RestrictionType             string `tfschema:"restriction_type"`
+	State                       string `tfschema:"state"`
+}
+
+func appManagementRestrictionsSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"key_credential": {
+			Description: "Restrictions on key credentials (certificates)",
+			Type:        pluginsdk.TypeList,
+			Optional:    true,
+			Elem: &pluginsdk.Resource{
+				Schema: appManagementCredentialRestrictionSchema(stable.PossibleValuesForAppKeyCredentialRestrictionType()),
+			},
+		},
+
+		"password_credential": {
+			Description: "Restrictions on password credentials (client secrets)",
+			Type:        pluginsdk.TypeList,
+			Optional:    true,
+			Elem: &pluginsdk.Resource{
+				Schema: appManagementCredentialRestrictionSchema(stable.PossibleValuesForAppCredentialRestrictionType()),
+			},
+		},
+	}
+}
+
+func appManagementCredentialRestrictionSchema(restrictionTypes []string) map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"restriction_type": {
+			Description:  "The type of restriction being applied",
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(restrictionTypes, false),
+		},
+
+		"max_lifetime": {
+			Description:  "The maximum lifetime of a credential, as an ISO8601 duration. Only applicable to lifetime restrictions",
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.ISO8601Duration,
+		},
+
+		"restrict_for_apps_created_after": {
+			Description:  "The restriction is enforced only for applications created after this date, formatted as an RFC3339 date string",
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+
+		"state": {
+			Description:  "Whether this restriction is enforced",
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(stable.AppManagementRestrictionState_Enabled),
+			ValidateFunc: validation.StringInSlice(stable.PossibleValuesForAppManagementRestrictionState(), false),
+		},
+	}
+}
+
+func expandAppManagementKeyCredentialRestrictions(input []AppManagementKeyCredentialRestrictionModel) *[]stable.KeyCredentialConfiguration {
+	result := make([]stable.KeyCredentialConfiguration, 0)
+	for _, restriction := range input {
+		result = append(result, stable.KeyCredentialConfiguration{
+			MaxLifetime:                         nullable.NoZero(restriction.MaxLifetime),
+			RestrictForAppsCreatedAfterDateTime: nullable.NoZero(restriction.RestrictForAppsCreatedAfter),
+			RestrictionType:                     pointer.To(stable.AppKeyCredentialRestrictionType(restriction.RestrictionType)),
+			State:                               pointer.To(stable.AppManagementRestrictionState(restriction.State)),
+		})
+	}
+	return &result
+}
+
+func expandAppManagementPasswordCredentialRestrictions(input []AppManagementPasswordCredentialRestrictionModel) *[]stable.PasswordCredentialConfiguration {
+	result := make([]stable.PasswordCredentialConfiguration, 0)
+	for _, restriction := range input {
+		result = append(result, stable.PasswordCredentialConfiguration{
+			MaxLifetime:                         nullable.NoZero(restriction.MaxLifetime),
+			RestrictForAppsCreatedAfterDateTime: nullable.NoZero(restriction.RestrictForAppsCreatedAfter),
+			RestrictionType:                     pointer.To(stable.AppCredentialRestrictionType(restriction.RestrictionType)),
+			State:                               pointer.To(stable.AppManagementRestrictionState(restriction.State)),
+		})
+	}
+	return &result
+}
+
+func flattenAppManagementKeyCredentialRestrictions(input *[]stable.KeyCredentialConfiguration) []AppManagementKeyCredentialRestrictionModel {
+	result := make([]AppManagementKeyCredentialRestrictionModel, 0)
+	if input == nil {
+		return result
+	}
+
+	for _, restriction := range *input {
+		result = append(result, AppManagementKeyCredentialRestrictionModel{
+			MaxLifetime:                 restriction.MaxLifetime.GetOrZero(),
+			RestrictForAppsCreatedAfter: restriction.RestrictForAppsCreatedAfterDateTime.GetOrZero(),
+			RestrictionType:             string(pointer.From(restriction.RestrictionType)),
+			State:                       string(pointer.From(restriction.State)),
+		})
+	}
+
+	return result
+}
+
+func flattenAppManagementPasswordCredentialRestrictions(input *[]stable.PasswordCredentialConfiguration) []AppManagementPasswordCredentialRestrictionModel {
+	result := make([]AppManagementPasswordCredentialRestrictionModel, 0)
+	if input == nil {
+		return result
+	}
+
+	for _, restriction := range *input {
+		result = append(result, AppManagementPasswordCredentialRestrictionModel{
+			MaxLifetime:                 restriction.MaxLifetime.GetOrZero(),
+			RestrictForAppsCreatedAfter: restriction.RestrictForAppsCreatedAfterDateTime.GetOrZero(),
+			RestrictionType:             string(pointer.From(restriction.RestrictionType)),
+			State:                       string(pointer.From(restriction.State)),
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,299 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package policies
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	applicationAppManagementPolicy "github.com/hashicorp/go-azure-sdk/microsoft-graph/applications/stable/appmanagementpolicy"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	servicePrincipalAppManagementPolicy "github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/appmanagementpolicy"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/msgraph"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/hashicorp/terraform-provider-azuread/internal/sdk"
+)
+
+type AppManagementPolicyAssignmentModel struct {
+	AppManagementPolicyId string `tfschema:"app_management_policy_id"`
+	ApplicationId         string `tfschema:"application_id"`
+	ServicePrincipalId    string `tfschema:"service_principal_id"`
+}
+
+var _ sdk.Resource = AppManagementPolicyAssignmentResource{}
+
+type AppManagementPolicyAssignmentResource struct{}
+
+func (r AppManagementPolicyAssignmentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validation.Any(stable.ValidateApplicationIdAppManagementPolicyID, stable.ValidateServicePrincipalIdAppManagementPolicyID)
+}
+
+func (r AppManagementPolicyAssignmentResource) ResourceType() string {
+	return "azuread_app_management_policy_assignment"
+}
+
+func (r AppManagementPolicyAssignmentResource) ModelObject() interface{} {
+	return &AppManagementPolicyAssignmentModel{}
+}
+
+func (r AppManagementPolicyAssignmentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"app_management_policy_id": {
+			Description:  "The resource ID of the app management policy to assign",
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: stable.ValidatePolicyAppManagementPolicyID,
+		},
+
+		"application_id": {
+			Description:  "The resource ID of the application to which the policy should be assigned",
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ExactlyOneOf: []string{"application_id", "service_principal_id"},
+			ValidateFunc: stable.ValidateApplicationID,
+		},
+
+		"service_principal_id": {
+			Description:  "The resource ID of the service principal to which the policy should be assigned",
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ExactlyOneOf: []string{"application_id", "service_principal_id"},
+			ValidateFunc: stable.ValidateServicePrincipalID,
+		},
+	}
+}
+
+func (r AppManagementPolicyAssignmentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r AppManagementPolicyAssignmentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			applicationClient := metadata.Client.Policies.ApplicationAppManagementPolicyClient
+			servicePrincipalClient := metadata.Client.Policies.ServicePrincipalAppManagementPolicyClient
+
+			var model AppManagementPolicyAssignmentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			policyId, err := stable.ParsePolicyAppManagementPolicyID(model.AppManagementPolicyId)
+			if err != nil {
+				return err
+			}
+
+			ref := stable.ReferenceCreate{
+				ODataId: pointer.To(applicationClient.Client.BaseUri + stable.NewDirectoryObjectID(policyId.AppManagementPolicyId).ID()),
+			}
+
+			if model.ApplicationId != "" {
+				applicationId, err := stable.ParseApplicationID(model.ApplicationId)
+				if err != nil {
+					return err
+				}
+
+				id := stable.NewApplicationIdAppManagementPolicyID(applicationId.ApplicationId, policyId.AppManagementPolicyId)
+
+				options := applicationAppManagementPolicy.AddAppManagementPolicyRefOperationOptions{
+					RetryFunc: appManagementPolicyAssignmentRetryFunc(),
+				}
+
+				if _, err = applicationClient.AddAppManagementPolicyRef(ctx, *applicationId, ref, options); err != nil {
+					return fmt.Errorf("creating %s: %+v", id, err)
+				}
+
+				metadata.SetID(id)
+				return nil
+			}
+
+			servicePrincipalId, err := stable.ParseServicePrincipalID(model.ServicePrincipalId)
+			if err != nil {
+				return err
+			}
+
+			id := stable.NewServicePrincipalIdAppManagementPolicyID(servicePrincipalId.ServicePrincipalId, policyId.AppManagementPolicyId)
+
+			if err = servicePrincipalAddAppManagementPolicyRef(ctx, servicePrincipalClient.Client, *servicePrincipalId, ref); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r AppManagementPolicyAssignmentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			applicationClient := metadata.Client.Policies.ApplicationAppManagementPolicyClient
+			servicePrincipalClient := metadata.Client.Policies.ServicePrincipalAppManagementPolicyClient
+
+			if id, err := stable.ParseApplicationIdAppManagementPolicyID(metadata.ResourceData.Id()); err == nil {
+				applicationId := stable.NewApplicationID(id.ApplicationId)
+
+				resp, err := applicationClient.ListAppManagementPolicies(ctx, applicationId, applicationAppManagementPolicy.DefaultListAppManagementPoliciesOperationOptions())
+				if err != nil {
+					if response.WasNotFound(resp.HttpResponse) {
+						return metadata.MarkAsGone(id)
+					}
+					return fmt.Errorf("listing app management policies for %s: %+v", applicationId, err)
+				}
+
+				if !appManagementPolicyAssigned(resp.Model, id.AppManagementPolicyId) {
+					return metadata.MarkAsGone(id)
+				}
+
+				state := AppManagementPolicyAssignmentModel{
+					AppManagementPolicyId: stable.NewPolicyAppManagementPolicyID(id.AppManagementPolicyId).ID(),
+					ApplicationId:         applicationId.ID(),
+				}
+
+				return metadata.Encode(&state)
+			}
+
+			id, err := stable.ParseServicePrincipalIdAppManagementPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			servicePrincipalId := stable.NewServicePrincipalID(id.ServicePrincipalId)
+
+			resp, err := servicePrincipalClient.ListAppManagementPolicies(ctx, servicePrincipalId, servicePrincipalAppManagementPolicy.DefaultListAppManagementPoliciesOperationOptions())
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("listing app management policies for %s: %+v", servicePrincipalId, err)
+			}
+
+			if !appManagementPolicyAssigned(resp.Model, id.AppManagementPolicyId) {
+				return metadata.MarkAsGone(id)
+			}
+
+			state := AppManagementPolicyAssignmentModel{
+				AppManagementPolicyId: stable.NewPolicyAppManagementPolicyID(id.AppManagementPolicyId).ID(),
+				ServicePrincipalId:    servicePrincipalId.ID(),
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r AppManagementPolicyAssignmentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			applicationClient := metadata.Client.Policies.ApplicationAppManagementPolicyClient
+			servicePrincipalClient := metadata.Client.Policies.ServicePrincipalAppManagementPolicyClient
+
+			if id, err := stable.ParseApplicationIdAppManagementPolicyID(metadata.ResourceData.Id()); err == nil {
+				if _, err = applicationClient.RemoveAppManagementPolicyRef(ctx, *id, applicationAppManagementPolicy.DefaultRemoveAppManagementPolicyRefOperationOptions()); err != nil {
+					return fmt.Errorf("removing %s: %+v", id, err)
+				}
+				return nil
+			}
+
+			id, err := stable.ParseServicePrincipalIdAppManagementPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err = servicePrincipalRemoveAppManagementPolicyRef(ctx, servicePrincipalClient.Client, *id); err != nil {
+				return fmt.Errorf("removing %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func appManagementPolicyAssigned(policies *[]stable.AppManagementPolicy, policyId string) bool {
+	if policies == nil {
+		return false
+	}
+	for _, policy := range *policies {
+		if pointer.From(policy.Id) == policyId {
+			return true
+		}
+	}
+	return false
+}
+
+// appManagementPolicyAssignmentRetryFunc retries when a newly created policy or application has not yet replicated
+func appManagementPolicyAssignmentRetryFunc() client.RequestRetryFunc {
+	return func(resp *http.Response, o *odata.OData) (bool, error) {
+		if response.WasNotFound(resp) {
+			return true, nil
+		} else if response.WasBadRequest(resp) && o != nil && o.Error != nil {
+			return o.Error.Match("does not exist or one of its queried reference-property objects are not present"), nil
+		}
+		return false, nil
+	}
+}
+
+// servicePrincipalAddAppManagementPolicyRef assigns an app management policy to a service principal. The appmanagementpolicy
+// package for service principals only supports listing and retrieving assigned policies, and has no operation for
+// POST /servicePrincipals/{id}/appManagementPolicies/$ref.
+func servicePrincipalAddAppManagementPolicyRef(ctx context.Context, c *msgraph.Client, id stable.ServicePrincipalId, input stable.ReferenceCreate) error {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusCreated,
+			http.StatusNoContent,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodPost,
+		Path:       fmt.Sprintf("%s/appManagementPolicies/$ref", id.ID()),
+		RetryFunc:  appManagementPolicyAssignmentRetryFunc(),
+	}
+
+	req, err := c.NewRequest(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	if err = req.Marshal(input); err != nil {
+		return err
+	}
+
+	_, err = req.Execute(ctx)
+	return err
+}
+
+// servicePrincipalRemoveAppManagementPolicyRef removes an app management policy from a service principal, see
+// servicePrincipalAddAppManagementPolicyRef.
+func servicePrincipalRemoveAppManagementPolicyRef(ctx context.Context, c *msgraph.Client, id stable.ServicePrincipalIdAppManagementPolicyId) error {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusNoContent,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodDelete,
+		Path:       fmt.Sprintf("%s/$ref", id.ID()),
+	}
+
+	req, err := c.NewRequest(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	_, err = req.Execute(ctx)
+	return err
+}
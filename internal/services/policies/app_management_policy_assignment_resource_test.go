@@ -0,0 +1,140 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package policies_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	applicationAppManagementPolicy "github.com/hashicorp/go-azure-sdk/microsoft-graph/applications/stable/appmanagementpolicy"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	servicePrincipalAppManagementPolicy "github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/appmanagementpolicy"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+)
+
+type AppManagementPolicyAssignmentResource struct{}
+
+func TestAccAppManagementPolicyAssignment_application(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_app_management_policy_assignment", "test")
+	r := AppManagementPolicyAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.application(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAppManagementPolicyAssignment_servicePrincipal(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_app_management_policy_assignment", "test")
+	r := AppManagementPolicyAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.servicePrincipal(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r AppManagementPolicyAssignmentResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	var policies *[]stable.AppManagementPolicy
+	var policyId string
+
+	if id, err := stable.ParseApplicationIdAppManagementPolicyID(state.ID); err == nil {
+		policyId = id.AppManagementPolicyId
+		resp, err := clients.Policies.ApplicationAppManagementPolicyClient.ListAppManagementPolicies(ctx, stable.NewApplicationID(id.ApplicationId), applicationAppManagementPolicy.DefaultListAppManagementPoliciesOperationOptions())
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, fmt.Errorf("failed to list app management policies for %s: %v", id, err)
+		}
+		policies = resp.Model
+	} else {
+		id, err := stable.ParseServicePrincipalIdAppManagementPolicyID(state.ID)
+		if err != nil {
+			return nil, err
+		}
+		policyId = id.AppManagementPolicyId
+		resp, err := clients.Policies.ServicePrincipalAppManagementPolicyClient.ListAppManagementPolicies(ctx, stable.NewServicePrincipalID(id.ServicePrincipalId), servicePrincipalAppManagementPolicy.DefaultListAppManagementPoliciesOperationOptions())
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, fmt.Errorf("failed to list app management policies for %s: %v", id, err)
+		}
+		policies = resp.Model
+	}
+
+	if policies != nil {
+		for _, p := range *policies {
+			if pointer.From(p.Id) == policyId {
+				return pointer.To(true), nil
+			}
+		}
+	}
+
+	return pointer.To(false), nil
+}
+
+func (AppManagementPolicyAssignmentResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_app_management_policy" "test" {
+  display_name = "acctest-%[1]s"
+  description  = "Acceptance test app management policy"
+
+  restrictions {
+    password_credential {
+      restriction_type = "passwordAddition"
+    }
+  }
+}
+
+resource "azuread_application_registration" "test" {
+  display_name = "acctest-AppManagementPolicy-%[1]s"
+}
+`, data.RandomString)
+}
+
+func (r AppManagementPolicyAssignmentResource) application(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_app_management_policy_assignment" "test" {
+  app_management_policy_id = azuread_app_management_policy.test.id
+  application_id           = azuread_application_registration.test.id
+}
+`, r.template(data))
+}
+
+func (r AppManagementPolicyAssignmentResource) servicePrincipal(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_service_principal" "test" {
+  client_id = azuread_application_registration.test.client_id
+}
+
+resource "azuread_app_management_policy_assignment" "test" {
+  app_management_policy_id = azuread_app_management_policy.test.id
+  service_principal_id     = azuread_service_principal.test.id
+}
+`, r.template(data))
+}
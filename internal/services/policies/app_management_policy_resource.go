@@ -0,0 +1,226 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package policies
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/appmanagementpolicy"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/hashicorp/terraform-provider-azuread/internal/sdk"
+)
+
+type AppManagementPolicyModel struct {
+	Description  string                           `tfschema:"description"`
+	DisplayName  string                           `tfschema:"display_name"`
+	Enabled      bool                             `tfschema:"enabled"`
+	Restrictions []AppManagementRestrictionsModel `tfschema:"restrictions"`
+}
+
+var _ sdk.ResourceWithUpdate = AppManagementPolicyResource{}
+
+type AppManagementPolicyResource struct{}
+
+func (r AppManagementPolicyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return stable.ValidatePolicyAppManagementPolicyID
+}
+
+func (r AppManagementPolicyResource) ResourceType() string {
+	return "azuread_app_management_policy"
+}
+
+func (r AppManagementPolicyResource) ModelObject() interface{} {
+	return &AppManagementPolicyModel{}
+}
+
+func (r AppManagementPolicyResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"display_name": {
+			Description:  "The display name for the policy",
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"description": {
+			Description:  "The description for the policy",
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"enabled": {
+			Description: "Whether the policy is enabled",
+			Type:        pluginsdk.TypeBool,
+			Optional:    true,
+			Default:     true,
+		},
+
+		"restrictions": {
+			Description: "Restrictions that apply to applications and service principals to which this policy is assigned",
+			Type:        pluginsdk.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &pluginsdk.Resource{
+				Schema: appManagementRestrictionsSchema(),
+			},
+		},
+	}
+}
+
+func (r AppManagementPolicyResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r AppManagementPolicyResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Policies.AppManagementPolicyClient
+
+			var model AppManagementPolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			resp, err := client.CreateAppManagementPolicy(ctx, expandAppManagementPolicy(model), appmanagementpolicy.DefaultCreateAppManagementPolicyOperationOptions())
+			if err != nil {
+				return fmt.Errorf("creating app management policy: %+v", err)
+			}
+
+			policy := resp.Model
+			if policy == nil {
+				return errors.New("creating app management policy: model was nil")
+			}
+			if policy.Id == nil {
+				return errors.New("creating app management policy: model returned with nil ID")
+			}
+
+			id := stable.NewPolicyAppManagementPolicyID(*policy.Id)
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r AppManagementPolicyResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Policies.AppManagementPolicyClient
+
+			id, err := stable.ParsePolicyAppManagementPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.GetAppManagementPolicy(ctx, *id, appmanagementpolicy.DefaultGetAppManagementPolicyOperationOptions())
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			policy := resp.Model
+			if policy == nil {
+				return fmt.Errorf("retrieving %s: model was nil", id)
+			}
+
+			state := AppManagementPolicyModel{
+				Description:  policy.Description.GetOrZero(),
+				DisplayName:  policy.DisplayName.GetOrZero(),
+				Enabled:      pointer.From(policy.IsEnabled),
+				Restrictions: make([]AppManagementRestrictionsModel, 0),
+			}
+
+			if restrictions := policy.Restrictions; restrictions != nil {
+				keyCredentials := flattenAppManagementKeyCredentialRestrictions(restrictions.KeyCredentials)
+				passwordCredentials := flattenAppManagementPasswordCredentialRestrictions(restrictions.PasswordCredentials)
+
+				if len(keyCredentials) > 0 || len(passwordCredentials) > 0 {
+					state.Restrictions = append(state.Restrictions, AppManagementRestrictionsModel{
+						KeyCredentials:      keyCredentials,
+						PasswordCredentials: passwordCredentials,
+					})
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r AppManagementPolicyResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Policies.AppManagementPolicyClient
+
+			id, err := stable.ParsePolicyAppManagementPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model AppManagementPolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if _, err = client.UpdateAppManagementPolicy(ctx, *id, expandAppManagementPolicy(model), appmanagementpolicy.DefaultUpdateAppManagementPolicyOperationOptions()); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r AppManagementPolicyResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Policies.AppManagementPolicyClient
+
+			id, err := stable.ParsePolicyAppManagementPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err = client.DeleteAppManagementPolicy(ctx, *id, appmanagementpolicy.DefaultDeleteAppManagementPolicyOperationOptions()); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandAppManagementPolicy(model AppManagementPolicyModel) stable.AppManagementPolicy {
+	restrictions := stable.CustomAppManagementConfiguration{
+		KeyCredentials:      &[]stable.KeyCredentialConfiguration{},
+		PasswordCredentials: &[]stable.PasswordCredentialConfiguration{},
+	}
+
+	if len(model.Restrictions) > 0 {
+		restrictions.KeyCredentials = expandAppManagementKeyCredentialRestrictions(model.Restrictions[0].KeyCredentials)
+		restrictions.PasswordCredentials = expandAppManagementPasswordCredentialRestrictions(model.Restrictions[0].PasswordCredentials)
+	}
+
+	return stable.AppManagementPolicy{
+		Description:  nullable.Value(model.Description),
+		DisplayName:  nullable.Value(model.DisplayName),
+		IsEnabled:    pointer.To(model.Enabled),
+		Restrictions: &restrictions,
+	}
+}
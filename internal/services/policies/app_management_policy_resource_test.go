@@ -0,0 +1,143 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package policies_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/appmanagementpolicy"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+)
+
+type AppManagementPolicyResource struct{}
+
+func TestAccAppManagementPolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_app_management_policy", "test")
+	r := AppManagementPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAppManagementPolicy_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_app_management_policy", "test")
+	r := AppManagementPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("restrictions.0.password_credential.#").HasValue("2"),
+				check.That(data.ResourceName).Key("restrictions.0.key_credential.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAppManagementPolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_app_management_policy", "test")
+	r := AppManagementPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r AppManagementPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Policies.AppManagementPolicyClient
+
+	id, err := stable.ParsePolicyAppManagementPolicyID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetAppManagementPolicy(ctx, *id, appmanagementpolicy.DefaultGetAppManagementPolicyOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to retrieve %s: %v", id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (AppManagementPolicyResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_app_management_policy" "test" {
+  display_name = "acctest-%[1]s"
+  description  = "Acceptance test app management policy"
+}
+`, data.RandomString)
+}
+
+func (AppManagementPolicyResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_app_management_policy" "test" {
+  display_name = "acctest-%[1]s-updated"
+  description  = "Acceptance test app management policy with restrictions"
+  enabled      = true
+
+  restrictions {
+    password_credential {
+      restriction_type                = "passwordAddition"
+      restrict_for_apps_created_after = "2019-10-19T10:37:00Z"
+    }
+
+    password_credential {
+      restriction_type                = "passwordLifetime"
+      max_lifetime                    = "P90D"
+      restrict_for_apps_created_after = "2019-10-19T10:37:00Z"
+    }
+
+    key_credential {
+      restriction_type                = "asymmetricKeyLifetime"
+      max_lifetime                    = "P180D"
+      restrict_for_apps_created_after = "2019-10-19T10:37:00Z"
+    }
+  }
+}
+`, data.RandomString)
+}
@@ -0,0 +1,376 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package policies
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/authorizationpolicy"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/hashicorp/terraform-provider-azuread/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/policies/parse"
+)
+
+// guestUserRoleTemplateId is the role template ID for the built-in Guest User role, which is assigned to guests by default
+const guestUserRoleTemplateId = "10dae51f-b6af-4016-8d66-8c2a99b929b3"
+
+type AuthorizationPolicyModel struct {
+	AllowEmailVerifiedUsersToJoinOrganization bool                              `tfschema:"allow_email_verified_users_to_join_organization"`
+	AllowInvitesFrom                          string                            `tfschema:"allow_invites_from"`
+	AllowUserConsentForRiskyApps              bool                              `tfschema:"allow_user_consent_for_risky_apps"`
+	AllowedToSignUpEmailBasedSubscriptions    bool                              `tfschema:"allowed_to_sign_up_email_based_subscriptions"`
+	AllowedToUseSSPR                          bool                              `tfschema:"allowed_to_use_sspr"`
+	BlockMsolPowerShell                       bool                              `tfschema:"block_msol_powershell"`
+	DefaultUserRolePermissions                []DefaultUserRolePermissionsModel `tfschema:"default_user_role_permissions"`
+	Description                               string                            `tfschema:"description"`
+	DisplayName                               string                            `tfschema:"display_name"`
+	GuestUserRoleId                           string                            `tfschema:"guest_user_role_id"`
+}
+
+type DefaultUserRolePermissionsModel struct {
+	AllowedToCreateApps                      bool     `tfschema:"allowed_to_create_apps"`
+	AllowedToCreateSecurityGroups            bool     `tfschema:"allowed_to_create_security_groups"`
+	AllowedToCreateTenants                   bool     `tfschema:"allowed_to_create_tenants"`
+	AllowedToReadBitlockerKeysForOwnedDevice bool     `tfschema:"allowed_to_read_bitlocker_keys_for_owned_device"`
+	AllowedToReadOtherUsers                  bool     `tfschema:"allowed_to_read_other_users"`
+	PermissionGrantPoliciesAssigned          []string `tfschema:"permission_grant_policies_assigned"`
+}
+
+var _ sdk.ResourceWithUpdate = AuthorizationPolicyResource{}
+
+type AuthorizationPolicyResource struct{}
+
+func (r AuthorizationPolicyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return parse.ValidateAuthorizationPolicyID
+}
+
+func (r AuthorizationPolicyResource) ResourceType() string {
+	return "azuread_authorization_policy"
+}
+
+func (r AuthorizationPolicyResource) ModelObject() interface{} {
+	return &AuthorizationPolicyModel{}
+}
+
+func (r AuthorizationPolicyResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"allow_email_verified_users_to_join_organization": {
+			Description: "Whether a user can join the tenant by email validation",
+			Type:        pluginsdk.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+
+		"allow_invites_from": {
+			Description:  "Who can invite guests to the organization",
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice(stable.PossibleValuesForAllowInvitesFrom(), false),
+		},
+
+		"allow_user_consent_for_risky_apps": {
+			Description: "Whether users can consent to applications which have been flagged as risky",
+			Type:        pluginsdk.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+
+		"allowed_to_sign_up_email_based_subscriptions": {
+			Description: "Whether users can sign up for email based subscriptions",
+			Type:        pluginsdk.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+
+		"allowed_to_use_sspr": {
+			Description: "Whether administrators of the tenant can use Self-Service Password Reset",
+			Type:        pluginsdk.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+
+		"block_msol_powershell": {
+			Description: "Whether the use of MSOL PowerShell is blocked",
+			Type:        pluginsdk.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+
+		"default_user_role_permissions": {
+			Description: "The permissions granted to the default user role",
+			Type:        pluginsdk.TypeList,
+			Optional:    true,
+			Computed:    true,
+			MaxItems:    1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"allowed_to_create_apps": {
+						Description: "Whether users can register applications",
+						Type:        pluginsdk.TypeBool,
+						Optional:    true,
+						Computed:    true,
+					},
+
+					"allowed_to_create_security_groups": {
+						Description: "Whether users can create security groups",
+						Type:        pluginsdk.TypeBool,
+						Optional:    true,
+						Computed:    true,
+					},
+
+					"allowed_to_create_tenants": {
+						Description: "Whether users can create tenants",
+						Type:        pluginsdk.TypeBool,
+						Optional:    true,
+						Computed:    true,
+					},
+
+					"allowed_to_read_bitlocker_keys_for_owned_device": {
+						Description: "Whether the registered owners of a device can read their own BitLocker recovery keys",
+						Type:        pluginsdk.TypeBool,
+						Optional:    true,
+						Computed:    true,
+					},
+
+					"allowed_to_read_other_users": {
+						Description: "Whether users can read other users",
+						Type:        pluginsdk.TypeBool,
+						Optional:    true,
+						Computed:    true,
+					},
+
+					"permission_grant_policies_assigned": {
+						Description: "The permission grant policies which govern user consent to applications. An empty list disables user consent",
+						Type:        pluginsdk.TypeList,
+						Optional:    true,
+						Computed:    true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+		},
+
+		"guest_user_role_id": {
+			Description:  "The role template ID of the role that should be granted to guests",
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+	}
+}
+
+func (r AuthorizationPolicyResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"display_name": {
+			Description: "The display name of the policy",
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+		},
+
+		"description": {
+			Description: "The description of the policy",
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+		},
+	}
+}
+
+func (r AuthorizationPolicyResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Policies.AuthorizationPolicyClient
+
+			id := parse.NewAuthorizationPolicyID()
+
+			// The authorization policy always exists, so we configure it in place
+			if _, err := client.UpdateAuthorizationPolicy(ctx, expandAuthorizationPolicy(metadata.ResourceData), authorizationpolicy.DefaultUpdateAuthorizationPolicyOperationOptions()); err != nil {
+				return fmt.Errorf("configuring %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r AuthorizationPolicyResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Policies.AuthorizationPolicyClient
+
+			id, err := parse.ParseAuthorizationPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.GetAuthorizationPolicy(ctx, authorizationpolicy.DefaultGetAuthorizationPolicyOperationOptions())
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			policy := resp.Model
+			if policy == nil {
+				return fmt.Errorf("retrieving %s: model was nil", id)
+			}
+
+			state := AuthorizationPolicyModel{
+				AllowEmailVerifiedUsersToJoinOrganization: pointer.From(policy.AllowEmailVerifiedUsersToJoinOrganization),
+				AllowInvitesFrom:                       string(pointer.From(policy.AllowInvitesFrom)),
+				AllowUserConsentForRiskyApps:           policy.AllowUserConsentForRiskyApps.GetOrZero(),
+				AllowedToSignUpEmailBasedSubscriptions: pointer.From(policy.AllowedToSignUpEmailBasedSubscriptions),
+				AllowedToUseSSPR:                       pointer.From(policy.AllowedToUseSSPR),
+				BlockMsolPowerShell:                    policy.BlockMsolPowerShell.GetOrZero(),
+				DefaultUserRolePermissions:             make([]DefaultUserRolePermissionsModel, 0),
+				Description:                            policy.Description.GetOrZero(),
+				DisplayName:                            policy.DisplayName.GetOrZero(),
+				GuestUserRoleId:                        policy.GuestUserRoleId.GetOrZero(),
+			}
+
+			if permissions := policy.DefaultUserRolePermissions; permissions != nil {
+				state.DefaultUserRolePermissions = append(state.DefaultUserRolePermissions, DefaultUserRolePermissionsModel{
+					AllowedToCreateApps:                      pointer.From(permissions.AllowedToCreateApps),
+					AllowedToCreateSecurityGroups:            pointer.From(permissions.AllowedToCreateSecurityGroups),
+					AllowedToCreateTenants:                   permissions.AllowedToCreateTenants.GetOrZero(),
+					AllowedToReadBitlockerKeysForOwnedDevice: permissions.AllowedToReadBitlockerKeysForOwnedDevice.GetOrZero(),
+					AllowedToReadOtherUsers:                  pointer.From(permissions.AllowedToReadOtherUsers),
+					PermissionGrantPoliciesAssigned:          pointer.From(permissions.PermissionGrantPoliciesAssigned),
+				})
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r AuthorizationPolicyResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Policies.AuthorizationPolicyClient
+
+			id, err := parse.ParseAuthorizationPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err = client.UpdateAuthorizationPolicy(ctx, expandAuthorizationPolicy(metadata.ResourceData), authorizationpolicy.DefaultUpdateAuthorizationPolicyOperationOptions()); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r AuthorizationPolicyResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Policies.AuthorizationPolicyClient
+
+			id, err := parse.ParseAuthorizationPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// The authorization policy cannot be deleted, so we restore the documented defaults instead
+			if _, err = client.UpdateAuthorizationPolicy(ctx, defaultAuthorizationPolicy(), authorizationpolicy.DefaultUpdateAuthorizationPolicyOperationOptions()); err != nil {
+				return fmt.Errorf("restoring defaults for %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// expandAuthorizationPolicy builds a policy containing only the properties which are present in the configuration, so
+// that settings not managed by Terraform are left untouched
+func expandAuthorizationPolicy(d *pluginsdk.ResourceData) stable.AuthorizationPolicy {
+	policy := stable.AuthorizationPolicy{}
+
+	if v, ok := d.GetOkExists("allow_email_verified_users_to_join_organization"); ok { //nolint:staticcheck // needed to detect unset booleans
+		policy.AllowEmailVerifiedUsersToJoinOrganization = pointer.To(v.(bool))
+	}
+	if v, ok := d.GetOk("allow_invites_from"); ok {
+		policy.AllowInvitesFrom = pointer.To(stable.AllowInvitesFrom(v.(string)))
+	}
+	if v, ok := d.GetOkExists("allow_user_consent_for_risky_apps"); ok { //nolint:staticcheck // needed to detect unset booleans
+		policy.AllowUserConsentForRiskyApps = nullable.Value(v.(bool))
+	}
+	if v, ok := d.GetOkExists("allowed_to_sign_up_email_based_subscriptions"); ok { //nolint:staticcheck // needed to detect unset booleans
+		policy.AllowedToSignUpEmailBasedSubscriptions = pointer.To(v.(bool))
+	}
+	if v, ok := d.GetOkExists("allowed_to_use_sspr"); ok { //nolint:staticcheck // needed to detect unset booleans
+		policy.AllowedToUseSSPR = pointer.To(v.(bool))
+	}
+	if v, ok := d.GetOkExists("block_msol_powershell"); ok { //nolint:staticcheck // needed to detect unset booleans
+		policy.BlockMsolPowerShell = nullable.Value(v.(bool))
+	}
+	if v, ok := d.GetOk("guest_user_role_id"); ok {
+		policy.GuestUserRoleId = nullable.Value(v.(string))
+	}
+
+	if _, ok := d.GetOk("default_user_role_permissions"); ok {
+		permissions := stable.DefaultUserRolePermissions{}
+
+		if v, ok := d.GetOkExists("default_user_role_permissions.0.allowed_to_create_apps"); ok { //nolint:staticcheck // needed to detect unset booleans
+			permissions.AllowedToCreateApps = pointer.To(v.(bool))
+		}
+		if v, ok := d.GetOkExists("default_user_role_permissions.0.allowed_to_create_security_groups"); ok { //nolint:staticcheck // needed to detect unset booleans
+			permissions.AllowedToCreateSecurityGroups = pointer.To(v.(bool))
+		}
+		if v, ok := d.GetOkExists("default_user_role_permissions.0.allowed_to_create_tenants"); ok { //nolint:staticcheck // needed to detect unset booleans
+			permissions.AllowedToCreateTenants = nullable.Value(v.(bool))
+		}
+		if v, ok := d.GetOkExists("default_user_role_permissions.0.allowed_to_read_bitlocker_keys_for_owned_device"); ok { //nolint:staticcheck // needed to detect unset booleans
+			permissions.AllowedToReadBitlockerKeysForOwnedDevice = nullable.Value(v.(bool))
+		}
+		if v, ok := d.GetOkExists("default_user_role_permissions.0.allowed_to_read_other_users"); ok { //nolint:staticcheck // needed to detect unset booleans
+			permissions.AllowedToReadOtherUsers = pointer.To(v.(bool))
+		}
+		if v, ok := d.GetOkExists("default_user_role_permissions.0.permission_grant_policies_assigned"); ok { //nolint:staticcheck // needed to detect empty lists
+			permissions.PermissionGrantPoliciesAssigned = tf.ExpandStringSlicePtr(v.([]interface{}))
+		}
+
+		policy.DefaultUserRolePermissions = &permissions
+	}
+
+	return policy
+}
+
+// defaultAuthorizationPolicy returns the documented default settings for the authorization policy
+func defaultAuthorizationPolicy() stable.AuthorizationPolicy {
+	return stable.AuthorizationPolicy{
+		AllowEmailVerifiedUsersToJoinOrganization: pointer.To(true),
+		AllowInvitesFrom:                       pointer.To(stable.AllowInvitesFrom_Everyone),
+		AllowUserConsentForRiskyApps:           nullable.Value(false),
+		AllowedToSignUpEmailBasedSubscriptions: pointer.To(true),
+		AllowedToUseSSPR:                       pointer.To(true),
+		BlockMsolPowerShell:                    nullable.Value(false),
+		DefaultUserRolePermissions: &stable.DefaultUserRolePermissions{
+			AllowedToCreateApps:                      pointer.To(true),
+			AllowedToCreateSecurityGroups:            pointer.To(true),
+			AllowedToCreateTenants:                   nullable.Value(true),
+			AllowedToReadBitlockerKeysForOwnedDevice: nullable.Value(true),
+			AllowedToReadOtherUsers:                  pointer.To(true),
+			PermissionGrantPoliciesAssigned:          pointer.To([]string{"ManagePermissionGrantsForSelf.microsoft-user-default-legacy"}),
+		},
+		GuestUserRoleId: nullable.Value(guestUserRoleTemplateId),
+	}
+}
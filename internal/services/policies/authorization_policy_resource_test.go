@@ -0,0 +1,102 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package policies_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/authorizationpolicy"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+)
+
+type AuthorizationPolicyResource struct{}
+
+func TestAccAuthorizationPolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_authorization_policy", "test")
+	r := AuthorizationPolicyResource{}
+
+	// The authorization policy is a tenant-wide singleton, so these tests must not run in parallel
+	data.ResourceSequentialTestSkipCheckDestroyed(t, []acceptance.TestStep{
+		{
+			Config: r.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("display_name").Exists(),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("allow_invites_from").HasValue("adminsAndGuestInviters"),
+				check.That(data.ResourceName).Key("default_user_role_permissions.0.allowed_to_create_apps").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r AuthorizationPolicyResource) Exists(ctx context.Context, clients *clients.Client, _ *terraform.InstanceState) (*bool, error) {
+	client := clients.Policies.AuthorizationPolicyClient
+
+	if _, err := client.GetAuthorizationPolicy(ctx, authorizationpolicy.DefaultGetAuthorizationPolicyOperationOptions()); err != nil {
+		return nil, fmt.Errorf("failed to retrieve authorization policy: %v", err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (AuthorizationPolicyResource) basic() string {
+	return `
+provider "azuread" {}
+
+resource "azuread_authorization_policy" "test" {
+  allow_invites_from    = "everyone"
+  block_msol_powershell = false
+
+  default_user_role_permissions {
+    allowed_to_create_apps = true
+  }
+}
+`
+}
+
+func (AuthorizationPolicyResource) complete() string {
+	return `
+provider "azuread" {}
+
+resource "azuread_authorization_policy" "test" {
+  allow_email_verified_users_to_join_organization = false
+  allow_invites_from                              = "adminsAndGuestInviters"
+  allow_user_consent_for_risky_apps               = false
+  allowed_to_sign_up_email_based_subscriptions    = false
+  allowed_to_use_sspr                             = true
+  block_msol_powershell                           = true
+  guest_user_role_id                              = "2af84b1e-32c8-42b7-82bc-daa82404023b"
+
+  default_user_role_permissions {
+    allowed_to_create_apps            = false
+    allowed_to_create_security_groups = false
+    allowed_to_create_tenants         = false
+    allowed_to_read_other_users       = true
+
+    permission_grant_policies_assigned = ["ManagePermissionGrantsForSelf.microsoft-user-default-low"]
+  }
+}
+`
+}
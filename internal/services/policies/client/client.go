@@ -4,33 +4,107 @@
 package client
 
 import (
+	applicationAppManagementPolicy "github.com/hashicorp/go-azure-sdk/microsoft-graph/applications/stable/appmanagementpolicy"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/adminconsentrequestpolicy"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/appmanagementpolicy"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/authenticationstrengthpolicy"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/authorizationpolicy"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/claimsmappingpolicy"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/defaultappmanagementpolicy"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/homerealmdiscoverypolicy"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/permissiongrantpolicy"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/permissiongrantpolicyexclude"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/permissiongrantpolicyinclude"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/rolemanagementpolicy"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/rolemanagementpolicyassignment"
+	servicePrincipalAppManagementPolicy "github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/appmanagementpolicy"
 	"github.com/hashicorp/terraform-provider-azuread/internal/common"
 )
 
 type Client struct {
-	AuthenticationStrengthPolicyClient   *authenticationstrengthpolicy.AuthenticationStrengthPolicyClient
-	ClaimsMappingPolicyClient            *claimsmappingpolicy.ClaimsMappingPolicyClient
-	RoleManagementPolicyAssignmentClient *rolemanagementpolicyassignment.RoleManagementPolicyAssignmentClient
-	RoleManagementPolicyClient           *rolemanagementpolicy.RoleManagementPolicyClient
+	AdminConsentRequestPolicyClient           *adminconsentrequestpolicy.AdminConsentRequestPolicyClient
+	AppManagementPolicyClient                 *appmanagementpolicy.AppManagementPolicyClient
+	ApplicationAppManagementPolicyClient      *applicationAppManagementPolicy.AppManagementPolicyClient
+	AuthenticationStrengthPolicyClient        *authenticationstrengthpolicy.AuthenticationStrengthPolicyClient
+	AuthorizationPolicyClient                 *authorizationpolicy.AuthorizationPolicyClient
+	ClaimsMappingPolicyClient                 *claimsmappingpolicy.ClaimsMappingPolicyClient
+	DefaultAppManagementPolicyClient          *defaultappmanagementpolicy.DefaultAppManagementPolicyClient
+	HomeRealmDiscoveryPolicyClient            *homerealmdiscoverypolicy.HomeRealmDiscoveryPolicyClient
+	PermissionGrantPolicyClient               *permissiongrantpolicy.PermissionGrantPolicyClient
+	PermissionGrantPolicyExcludeClient        *permissiongrantpolicyexclude.PermissionGrantPolicyExcludeClient
+	PermissionGrantPolicyIncludeClient        *permissiongrantpolicyinclude.PermissionGrantPolicyIncludeClient
+	RoleManagementPolicyAssignmentClient      *rolemanagementpolicyassignment.RoleManagementPolicyAssignmentClient
+	RoleManagementPolicyClient                *rolemanagementpolicy.RoleManagementPolicyClient
+	ServicePrincipalAppManagementPolicyClient *servicePrincipalAppManagementPolicy.AppManagementPolicyClient
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
+	adminConsentRequestPolicyClient, err := adminconsentrequestpolicy.NewAdminConsentRequestPolicyClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(adminConsentRequestPolicyClient.Client)
+
+	appManagementPolicyClient, err := appmanagementpolicy.NewAppManagementPolicyClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(appManagementPolicyClient.Client)
+
+	applicationAppManagementPolicyClient, err := applicationAppManagementPolicy.NewAppManagementPolicyClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(applicationAppManagementPolicyClient.Client)
+
 	authenticationStrengthpolicyClient, err := authenticationstrengthpolicy.NewAuthenticationStrengthPolicyClientWithBaseURI(o.Environment.MicrosoftGraph)
 	if err != nil {
 		return nil, err
 	}
 	o.Configure(authenticationStrengthpolicyClient.Client)
 
+	authorizationPolicyClient, err := authorizationpolicy.NewAuthorizationPolicyClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(authorizationPolicyClient.Client)
+
 	claimsMappingPolicyClient, err := claimsmappingpolicy.NewClaimsMappingPolicyClientWithBaseURI(o.Environment.MicrosoftGraph)
 	if err != nil {
 		return nil, err
 	}
 	o.Configure(claimsMappingPolicyClient.Client)
 
+	defaultAppManagementPolicyClient, err := defaultappmanagementpolicy.NewDefaultAppManagementPolicyClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(defaultAppManagementPolicyClient.Client)
+
+	homeRealmDiscoveryPolicyClient, err := homerealmdiscoverypolicy.NewHomeRealmDiscoveryPolicyClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(homeRealmDiscoveryPolicyClient.Client)
+
+	permissionGrantPolicyClient, err := permissiongrantpolicy.NewPermissionGrantPolicyClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(permissionGrantPolicyClient.Client)
+
+	permissionGrantPolicyExcludeClient, err := permissiongrantpolicyexclude.NewPermissionGrantPolicyExcludeClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(permissionGrantPolicyExcludeClient.Client)
+
+	permissionGrantPolicyIncludeClient, err := permissiongrantpolicyinclude.NewPermissionGrantPolicyIncludeClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(permissionGrantPolicyIncludeClient.Client)
+
 	roleManagementPolicyAssignmentClient, err := rolemanagementpolicyassignment.NewRoleManagementPolicyAssignmentClientWithBaseURI(o.Environment.MicrosoftGraph)
 	if err != nil {
 		return nil, err
@@ -43,10 +117,26 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(roleManagementPolicyClient.Client)
 
+	servicePrincipalAppManagementPolicyClient, err := servicePrincipalAppManagementPolicy.NewAppManagementPolicyClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(servicePrincipalAppManagementPolicyClient.Client)
+
 	return &Client{
-		AuthenticationStrengthPolicyClient:   authenticationStrengthpolicyClient,
-		ClaimsMappingPolicyClient:            claimsMappingPolicyClient,
-		RoleManagementPolicyAssignmentClient: roleManagementPolicyAssignmentClient,
-		RoleManagementPolicyClient:           roleManagementPolicyClient,
+		AdminConsentRequestPolicyClient:           adminConsentRequestPolicyClient,
+		AppManagementPolicyClient:                 appManagementPolicyClient,
+		ApplicationAppManagementPolicyClient:      applicationAppManagementPolicyClient,
+		AuthenticationStrengthPolicyClient:        authenticationStrengthpolicyClient,
+		AuthorizationPolicyClient:                 authorizationPolicyClient,
+		ClaimsMappingPolicyClient:                 claimsMappingPolicyClient,
+		DefaultAppManagementPolicyClient:          defaultAppManagementPolicyClient,
+		HomeRealmDiscoveryPolicyClient:            homeRealmDiscoveryPolicyClient,
+		PermissionGrantPolicyClient:               permissionGrantPolicyClient,
+		PermissionGrantPolicyExcludeClient:        permissionGrantPolicyExcludeClient,
+		PermissionGrantPolicyIncludeClient:        permissionGrantPolicyIncludeClient,
+		RoleManagementPolicyAssignmentClient:      roleManagementPolicyAssignmentClient,
+		RoleManagementPolicyClient:                roleManagementPolicyClient,
+		ServicePrincipalAppManagementPolicyClient: servicePrincipalAppManagementPolicyClient,
 	}, nil
 }
@@ -0,0 +1,248 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package policies
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/homerealmdiscoverypolicy"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+)
+
+// homeRealmDiscoveryPolicyDefinition is the JSON document stored in the `definition` of a Home Realm Discovery Policy
+type homeRealmDiscoveryPolicyDefinition struct {
+	HomeRealmDiscoveryPolicy homeRealmDiscoveryPolicySettings `json:"HomeRealmDiscoveryPolicy"`
+}
+
+type homeRealmDiscoveryPolicySettings struct {
+	AccelerateToFederatedDomain  bool   `json:"AccelerateToFederatedDomain"`
+	AllowCloudPasswordValidation bool   `json:"AllowCloudPasswordValidation"`
+	PreferredDomain              string `json:"PreferredDomain,omitempty"`
+}
+
+func homeRealmDiscoveryPolicyResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: homeRealmDiscoveryPolicyResourceCreate,
+		ReadContext:   homeRealmDiscoveryPolicyResourceRead,
+		UpdateContext: homeRealmDiscoveryPolicyResourceUpdate,
+		DeleteContext: homeRealmDiscoveryPolicyResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			if _, errs := stable.ValidatePolicyHomeRealmDiscoveryPolicyID(id, "id"); len(errs) > 0 {
+				out := ""
+				for _, err := range errs {
+					out += err.Error()
+				}
+				return errors.New(out)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"display_name": {
+				Description:  "Display name for this policy",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"description": {
+				Description: "Description for this policy",
+				Type:        pluginsdk.TypeString,
+				Optional:    true,
+			},
+
+			"accelerate_to_federated_domain": {
+				Description: "Whether users should be sent directly to the federated identity provider for sign-in, bypassing the username entry page",
+				Type:        pluginsdk.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+
+			"allow_cloud_password_validation": {
+				Description: "Whether users of a federated domain can authenticate with a username and password directly against Azure Active Directory",
+				Type:        pluginsdk.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+
+			"preferred_domain": {
+				Description:  "The federated domain to which users should be accelerated when the tenant has more than one federated domain",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"is_organization_default": {
+				Description: "Whether this policy should be applied as the default for the organization",
+				Type:        pluginsdk.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+}
+
+func homeRealmDiscoveryPolicyResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.HomeRealmDiscoveryPolicyClient
+
+	definition, err := expandHomeRealmDiscoveryPolicyDefinition(d)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Building definition for Home Realm Discovery Policy")
+	}
+
+	properties := stable.HomeRealmDiscoveryPolicy{
+		Definition:            definition,
+		Description:           nullable.NoZero(d.Get("description").(string)),
+		DisplayName:           nullable.Value(d.Get("display_name").(string)),
+		IsOrganizationDefault: nullable.Value(d.Get("is_organization_default").(bool)),
+	}
+
+	resp, err := client.CreateHomeRealmDiscoveryPolicy(ctx, properties, homerealmdiscoverypolicy.DefaultCreateHomeRealmDiscoveryPolicyOperationOptions())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Could not create Home Realm Discovery Policy")
+	}
+
+	homeRealmDiscoveryPolicy := resp.Model
+	if homeRealmDiscoveryPolicy == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Could not create Home Realm Discovery Policy")
+	}
+	if homeRealmDiscoveryPolicy.Id == nil {
+		return tf.ErrorDiagF(errors.New("model return with nil ID"), "Could not create Home Realm Discovery Policy")
+	}
+
+	id := stable.NewPolicyHomeRealmDiscoveryPolicyID(*homeRealmDiscoveryPolicy.Id)
+	d.SetId(id.ID())
+
+	return homeRealmDiscoveryPolicyResourceRead(ctx, d, meta)
+}
+
+func homeRealmDiscoveryPolicyResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.HomeRealmDiscoveryPolicyClient
+
+	id, err := stable.ParsePolicyHomeRealmDiscoveryPolicyID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing ID")
+	}
+
+	resp, err := client.GetHomeRealmDiscoveryPolicy(ctx, *id, homerealmdiscoverypolicy.DefaultGetHomeRealmDiscoveryPolicyOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s - removing from state!", id)
+			d.SetId("")
+			return nil
+		}
+
+		return tf.ErrorDiagF(err, "retrieving %s", id)
+	}
+
+	homeRealmDiscoveryPolicy := resp.Model
+	if homeRealmDiscoveryPolicy == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", id)
+	}
+
+	settings, err := flattenHomeRealmDiscoveryPolicyDefinition(homeRealmDiscoveryPolicy.Definition)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Parsing definition for %s", id)
+	}
+
+	tf.Set(d, "accelerate_to_federated_domain", settings.AccelerateToFederatedDomain)
+	tf.Set(d, "allow_cloud_password_validation", settings.AllowCloudPasswordValidation)
+	tf.Set(d, "description", homeRealmDiscoveryPolicy.Description.GetOrZero())
+	tf.Set(d, "display_name", homeRealmDiscoveryPolicy.DisplayName.GetOrZero())
+	tf.Set(d, "is_organization_default", homeRealmDiscoveryPolicy.IsOrganizationDefault.GetOrZero())
+	tf.Set(d, "preferred_domain", settings.PreferredDomain)
+
+	return nil
+}
+
+func homeRealmDiscoveryPolicyResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.HomeRealmDiscoveryPolicyClient
+
+	id, err := stable.ParsePolicyHomeRealmDiscoveryPolicyID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing ID")
+	}
+
+	definition, err := expandHomeRealmDiscoveryPolicyDefinition(d)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Building definition for %s", id)
+	}
+
+	properties := stable.HomeRealmDiscoveryPolicy{
+		Definition:            definition,
+		Description:           nullable.NoZero(d.Get("description").(string)),
+		DisplayName:           nullable.Value(d.Get("display_name").(string)),
+		IsOrganizationDefault: nullable.Value(d.Get("is_organization_default").(bool)),
+	}
+
+	if _, err := client.UpdateHomeRealmDiscoveryPolicy(ctx, *id, properties, homerealmdiscoverypolicy.DefaultUpdateHomeRealmDiscoveryPolicyOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Could not update %s", id)
+	}
+
+	return homeRealmDiscoveryPolicyResourceRead(ctx, d, meta)
+}
+
+func homeRealmDiscoveryPolicyResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.HomeRealmDiscoveryPolicyClient
+
+	id, err := stable.ParsePolicyHomeRealmDiscoveryPolicyID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing ID")
+	}
+
+	if _, err := client.DeleteHomeRealmDiscoveryPolicy(ctx, *id, homerealmdiscoverypolicy.DefaultDeleteHomeRealmDiscoveryPolicyOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Deleting %s", id)
+	}
+
+	return nil
+}
+
+func expandHomeRealmDiscoveryPolicyDefinition(d *pluginsdk.ResourceData) ([]string, error) {
+	definition := homeRealmDiscoveryPolicyDefinition{
+		HomeRealmDiscoveryPolicy: homeRealmDiscoveryPolicySettings{
+			AccelerateToFederatedDomain:  d.Get("accelerate_to_federated_domain").(bool),
+			AllowCloudPasswordValidation: d.Get("allow_cloud_password_validation").(bool),
+			PreferredDomain:              d.Get("preferred_domain").(string),
+		},
+	}
+
+	b, err := json.Marshal(definition)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling definition: %v", err)
+	}
+
+	return []string{string(b)}, nil
+}
+
+func flattenHomeRealmDiscoveryPolicyDefinition(input []string) (*homeRealmDiscoveryPolicySettings, error) {
+	if len(input) == 0 {
+		return &homeRealmDiscoveryPolicySettings{}, nil
+	}
+
+	var definition homeRealmDiscoveryPolicyDefinition
+	if err := json.Unmarshal([]byte(input[0]), &definition); err != nil {
+		return nil, fmt.Errorf("unmarshalling definition: %v", err)
+	}
+
+	return &definition.HomeRealmDiscoveryPolicy, nil
+}
@@ -0,0 +1,112 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package policies_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/homerealmdiscoverypolicy"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+)
+
+type HomeRealmDiscoveryPolicyResource struct{}
+
+func TestAccHomeRealmDiscoveryPolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_home_realm_discovery_policy", "test")
+	r := HomeRealmDiscoveryPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("accelerate_to_federated_domain").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccHomeRealmDiscoveryPolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_home_realm_discovery_policy", "test")
+	r := HomeRealmDiscoveryPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("allow_cloud_password_validation").HasValue("true"),
+				check.That(data.ResourceName).Key("preferred_domain").HasValue("federated.example.com"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r HomeRealmDiscoveryPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Policies.HomeRealmDiscoveryPolicyClient
+
+	id, err := stable.ParsePolicyHomeRealmDiscoveryPolicyID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetHomeRealmDiscoveryPolicy(ctx, *id, homerealmdiscoverypolicy.DefaultGetHomeRealmDiscoveryPolicyOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to retrieve %s: %v", id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (HomeRealmDiscoveryPolicyResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_home_realm_discovery_policy" "test" {
+  display_name                   = "acctest-%[1]s"
+  accelerate_to_federated_domain = true
+}
+`, data.RandomString)
+}
+
+func (HomeRealmDiscoveryPolicyResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_home_realm_discovery_policy" "test" {
+  display_name                    = "acctest-%[1]s-updated"
+  description                     = "Acceptance test HRD policy"
+  accelerate_to_federated_domain  = true
+  allow_cloud_password_validation = true
+  preferred_domain                = "federated.example.com"
+}
+`, data.RandomString)
+}
@@ -0,0 +1,62 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+// AdminConsentRequestPolicyId is the ID of the tenant-wide admin consent request policy, of which there is only one
+type AdminConsentRequestPolicyId struct{}
+
+func NewAdminConsentRequestPolicyID() AdminConsentRequestPolicyId {
+	return AdminConsentRequestPolicyId{}
+}
+
+// ParseAdminConsentRequestPolicyID parses 'input' into a AdminConsentRequestPolicyId
+func ParseAdminConsentRequestPolicyID(input string) (*AdminConsentRequestPolicyId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&AdminConsentRequestPolicyId{})
+	if _, err := parser.Parse(input, false); err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	return &AdminConsentRequestPolicyId{}, nil
+}
+
+// ValidateAdminConsentRequestPolicyID checks that 'input' can be parsed as a AdminConsentRequestPolicyId
+func ValidateAdminConsentRequestPolicyID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseAdminConsentRequestPolicyID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+func (id AdminConsentRequestPolicyId) ID() string {
+	return "/policies/adminConsentRequestPolicy"
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this ID
+func (id AdminConsentRequestPolicyId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("policies", "policies", "policies"),
+		resourceids.StaticSegment("adminConsentRequestPolicy", "adminConsentRequestPolicy", "adminConsentRequestPolicy"),
+	}
+}
+
+func (id AdminConsentRequestPolicyId) String() string {
+	return "Admin Consent Request Policy"
+}
+
+func (id *AdminConsentRequestPolicyId) FromParseResult(_ resourceids.ParseResult) error {
+	return nil
+}
@@ -0,0 +1,62 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+// AuthorizationPolicyId is the ID of the tenant-wide authorization policy, of which there is only one
+type AuthorizationPolicyId struct{}
+
+func NewAuthorizationPolicyID() AuthorizationPolicyId {
+	return AuthorizationPolicyId{}
+}
+
+// ParseAuthorizationPolicyID parses 'input' into a AuthorizationPolicyId
+func ParseAuthorizationPolicyID(input string) (*AuthorizationPolicyId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&AuthorizationPolicyId{})
+	if _, err := parser.Parse(input, false); err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	return &AuthorizationPolicyId{}, nil
+}
+
+// ValidateAuthorizationPolicyID checks that 'input' can be parsed as a AuthorizationPolicyId
+func ValidateAuthorizationPolicyID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseAuthorizationPolicyID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+func (id AuthorizationPolicyId) ID() string {
+	return "/policies/authorizationPolicy"
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this ID
+func (id AuthorizationPolicyId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("policies", "policies", "policies"),
+		resourceids.StaticSegment("authorizationPolicy", "authorizationPolicy", "authorizationPolicy"),
+	}
+}
+
+func (id AuthorizationPolicyId) String() string {
+	return "Authorization Policy"
+}
+
+func (id *AuthorizationPolicyId) FromParseResult(_ resourceids.ParseResult) error {
+	return nil
+}
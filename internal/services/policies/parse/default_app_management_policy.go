@@ -0,0 +1,62 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+// DefaultAppManagementPolicyId is the ID of the tenant-wide default app management policy, of which there is only one
+type DefaultAppManagementPolicyId struct{}
+
+func NewDefaultAppManagementPolicyID() DefaultAppManagementPolicyId {
+	return DefaultAppManagementPolicyId{}
+}
+
+// ParseDefaultAppManagementPolicyID parses 'input' into a DefaultAppManagementPolicyId
+func ParseDefaultAppManagementPolicyID(input string) (*DefaultAppManagementPolicyId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&DefaultAppManagementPolicyId{})
+	if _, err := parser.Parse(input, false); err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	return &DefaultAppManagementPolicyId{}, nil
+}
+
+// ValidateDefaultAppManagementPolicyID checks that 'input' can be parsed as a DefaultAppManagementPolicyId
+func ValidateDefaultAppManagementPolicyID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseDefaultAppManagementPolicyID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+func (id DefaultAppManagementPolicyId) ID() string {
+	return "/policies/defaultAppManagementPolicy"
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this ID
+func (id DefaultAppManagementPolicyId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("policies", "policies", "policies"),
+		resourceids.StaticSegment("defaultAppManagementPolicy", "defaultAppManagementPolicy", "defaultAppManagementPolicy"),
+	}
+}
+
+func (id DefaultAppManagementPolicyId) String() string {
+	return "Default App Management Policy"
+}
+
+func (id *DefaultAppManagementPolicyId) FromParseResult(_ resourceids.ParseResult) error {
+	return nil
+}
@@ -0,0 +1,510 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package policies
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/permissiongrantpolicy"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/permissiongrantpolicyexclude"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/permissiongrantpolicyinclude"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/hashicorp/terraform-provider-azuread/internal/sdk"
+)
+
+const (
+	permissionGrantConditionAll = "all"
+	permissionGrantConditionAny = "any"
+)
+
+type PermissionGrantPolicyModel struct {
+	Description string                             `tfschema:"description"`
+	DisplayName string                             `tfschema:"display_name"`
+	Excludes    []PermissionGrantConditionSetModel `tfschema:"exclude"`
+	Includes    []PermissionGrantConditionSetModel `tfschema:"include"`
+	PolicyId    string                             `tfschema:"policy_id"`
+}
+
+type PermissionGrantConditionSetModel struct {
+	ClientApplicationIds                        []string `tfschema:"client_application_ids"`
+	ClientApplicationPublisherIds               []string `tfschema:"client_application_publisher_ids"`
+	ClientApplicationTenantIds                  []string `tfschema:"client_application_tenant_ids"`
+	ClientApplicationsFromVerifiedPublisherOnly bool     `tfschema:"client_applications_from_verified_publisher_only"`
+	PermissionClassification                    string   `tfschema:"permission_classification"`
+	PermissionType                              string   `tfschema:"permission_type"`
+	Permissions                                 []string `tfschema:"permissions"`
+	ResourceApplication                         string   `tfschema:"resource_application"`
+}
+
+var _ sdk.ResourceWithUpdate = PermissionGrantPolicyResource{}
+
+type PermissionGrantPolicyResource struct{}
+
+func (r PermissionGrantPolicyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return stable.ValidatePolicyPermissionGrantPolicyID
+}
+
+func (r PermissionGrantPolicyResource) ResourceType() string {
+	return "azuread_permission_grant_policy"
+}
+
+func (r PermissionGrantPolicyResource) ModelObject() interface{} {
+	return &PermissionGrantPolicyModel{}
+}
+
+func (r PermissionGrantPolicyResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"policy_id": {
+			Description:  "The ID for the permission grant policy",
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"display_name": {
+			Description:  "The display name for the permission grant policy",
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"description": {
+			Description:  "The description for the permission grant policy",
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"include": {
+			Description: "A condition set describing permission grants which are included by this policy",
+			Type:        pluginsdk.TypeSet,
+			Optional:    true,
+			Elem: &pluginsdk.Resource{
+				Schema: permissionGrantConditionSetSchema(),
+			},
+		},
+
+		"exclude": {
+			Description: "A condition set describing permission grants which are excluded by this policy",
+			Type:        pluginsdk.TypeSet,
+			Optional:    true,
+			Elem: &pluginsdk.Resource{
+				Schema: permissionGrantConditionSetSchema(),
+			},
+		},
+	}
+}
+
+func permissionGrantConditionSetSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"permission_type": {
+			Description:  "The permission type of the permission being granted",
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(stable.PossibleValuesForPermissionType(), false),
+		},
+
+		"client_application_ids": {
+			Description: "A set of client application IDs to scope consent operation down to",
+			Type:        pluginsdk.TypeSet,
+			Optional:    true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.IsUUID,
+			},
+		},
+
+		"client_application_publisher_ids": {
+			Description: "A set of Microsoft Partner Network (MPN) IDs for verified publishers of the client application",
+			Type:        pluginsdk.TypeSet,
+			Optional:    true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"client_application_tenant_ids": {
+			Description: "A set of tenant IDs in which the client application is registered",
+			Type:        pluginsdk.TypeSet,
+			Optional:    true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.IsUUID,
+			},
+		},
+
+		"client_applications_from_verified_publisher_only": {
+			Description: "Whether to only match client applications with a verified publisher",
+			Type:        pluginsdk.TypeBool,
+			Optional:    true,
+		},
+
+		"permission_classification": {
+			Description:  "The permission classification for the permission being granted",
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(stable.PossibleValuesForPermissionClassificationType(), false),
+		},
+
+		"permissions": {
+			Description: "A set of permission IDs for the specific application or delegated permissions to match",
+			Type:        pluginsdk.TypeSet,
+			Optional:    true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.IsUUID,
+			},
+		},
+
+		"resource_application": {
+			Description:  "The client ID of the resource application for which the permission is being granted",
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+	}
+}
+
+func (r PermissionGrantPolicyResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r PermissionGrantPolicyResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 10 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Policies.PermissionGrantPolicyClient
+
+			var model PermissionGrantPolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := stable.NewPolicyPermissionGrantPolicyID(model.PolicyId)
+
+			properties := stable.PermissionGrantPolicy{
+				Id:          pointer.To(model.PolicyId),
+				Description: nullable.Value(model.Description),
+				DisplayName: nullable.Value(model.DisplayName),
+			}
+
+			resp, err := client.CreatePermissionGrantPolicy(ctx, properties, permissiongrantpolicy.DefaultCreatePermissionGrantPolicyOperationOptions())
+			if err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+			if resp.Model == nil {
+				return fmt.Errorf("creating %s: model was nil", id)
+			}
+
+			metadata.SetID(id)
+
+			if err = reconcilePermissionGrantPolicyConditionSets(ctx, metadata, id, model); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r PermissionGrantPolicyResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Policies.PermissionGrantPolicyClient
+			includeClient := metadata.Client.Policies.PermissionGrantPolicyIncludeClient
+			excludeClient := metadata.Client.Policies.PermissionGrantPolicyExcludeClient
+
+			id, err := stable.ParsePolicyPermissionGrantPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.GetPermissionGrantPolicy(ctx, *id, permissiongrantpolicy.DefaultGetPermissionGrantPolicyOperationOptions())
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			policy := resp.Model
+			if policy == nil {
+				return fmt.Errorf("retrieving %s: model was nil", id)
+			}
+
+			includesResp, err := includeClient.ListPermissionGrantPolicyIncludes(ctx, *id, permissiongrantpolicyinclude.DefaultListPermissionGrantPolicyIncludesOperationOptions())
+			if err != nil {
+				return fmt.Errorf("listing included condition sets for %s: %+v", id, err)
+			}
+
+			excludesResp, err := excludeClient.ListPermissionGrantPolicyExcludes(ctx, *id, permissiongrantpolicyexclude.DefaultListPermissionGrantPolicyExcludesOperationOptions())
+			if err != nil {
+				return fmt.Errorf("listing excluded condition sets for %s: %+v", id, err)
+			}
+
+			state := PermissionGrantPolicyModel{
+				Description: policy.Description.GetOrZero(),
+				DisplayName: policy.DisplayName.GetOrZero(),
+				Excludes:    flattenPermissionGrantConditionSets(excludesResp.Model),
+				Includes:    flattenPermissionGrantConditionSets(includesResp.Model),
+				PolicyId:    id.PermissionGrantPolicyId,
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r PermissionGrantPolicyResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 10 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Policies.PermissionGrantPolicyClient
+
+			id, err := stable.ParsePolicyPermissionGrantPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model PermissionGrantPolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChanges("description", "display_name") {
+				properties := stable.PermissionGrantPolicy{
+					Description: nullable.Value(model.Description),
+					DisplayName: nullable.Value(model.DisplayName),
+				}
+
+				if _, err = client.UpdatePermissionGrantPolicy(ctx, *id, properties, permissiongrantpolicy.DefaultUpdatePermissionGrantPolicyOperationOptions()); err != nil {
+					return fmt.Errorf("updating %s: %+v", id, err)
+				}
+			}
+
+			if metadata.ResourceData.HasChanges("include", "exclude") {
+				if err = reconcilePermissionGrantPolicyConditionSets(ctx, metadata, *id, model); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r PermissionGrantPolicyResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Policies.PermissionGrantPolicyClient
+
+			id, err := stable.ParsePolicyPermissionGrantPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err = client.DeletePermissionGrantPolicy(ctx, *id, permissiongrantpolicy.DefaultDeletePermissionGrantPolicyOperationOptions()); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// reconcilePermissionGrantPolicyConditionSets reconciles the include and exclude condition sets for a permission grant
+// policy with the configuration. Condition sets are not addressable by any user-facing identifier, so they are matched
+// on their conditions. Any new condition sets are created before stale ones are removed, so that the policy continues
+// to match permission grants whilst it is being updated.
+func reconcilePermissionGrantPolicyConditionSets(ctx context.Context, metadata sdk.ResourceMetaData, id stable.PolicyPermissionGrantPolicyId, model PermissionGrantPolicyModel) error {
+	includeClient := metadata.Client.Policies.PermissionGrantPolicyIncludeClient
+	excludeClient := metadata.Client.Policies.PermissionGrantPolicyExcludeClient
+
+	includesResp, err := includeClient.ListPermissionGrantPolicyIncludes(ctx, id, permissiongrantpolicyinclude.DefaultListPermissionGrantPolicyIncludesOperationOptions())
+	if err != nil {
+		return fmt.Errorf("listing included condition sets for %s: %+v", id, err)
+	}
+	existingIncludes, err := permissionGrantConditionSetIds(includesResp.Model)
+	if err != nil {
+		return fmt.Errorf("listing included condition sets for %s: %+v", id, err)
+	}
+
+	excludesResp, err := excludeClient.ListPermissionGrantPolicyExcludes(ctx, id, permissiongrantpolicyexclude.DefaultListPermissionGrantPolicyExcludesOperationOptions())
+	if err != nil {
+		return fmt.Errorf("listing excluded condition sets for %s: %+v", id, err)
+	}
+	existingExcludes, err := permissionGrantConditionSetIds(excludesResp.Model)
+	if err != nil {
+		return fmt.Errorf("listing excluded condition sets for %s: %+v", id, err)
+	}
+
+	for _, conditionSet := range model.Includes {
+		key := permissionGrantConditionSetKey(conditionSet)
+		if len(existingIncludes[key]) > 0 {
+			// Retain one matching condition set, any duplicates are removed below
+			existingIncludes[key] = existingIncludes[key][1:]
+			continue
+		}
+		if _, err = includeClient.CreatePermissionGrantPolicyInclude(ctx, id, expandPermissionGrantConditionSet(conditionSet), permissiongrantpolicyinclude.DefaultCreatePermissionGrantPolicyIncludeOperationOptions()); err != nil {
+			return fmt.Errorf("adding included condition set for %s: %+v", id, err)
+		}
+	}
+
+	for _, conditionSet := range model.Excludes {
+		key := permissionGrantConditionSetKey(conditionSet)
+		if len(existingExcludes[key]) > 0 {
+			existingExcludes[key] = existingExcludes[key][1:]
+			continue
+		}
+		if _, err = excludeClient.CreatePermissionGrantPolicyExclude(ctx, id, expandPermissionGrantConditionSet(conditionSet), permissiongrantpolicyexclude.DefaultCreatePermissionGrantPolicyExcludeOperationOptions()); err != nil {
+			return fmt.Errorf("adding excluded condition set for %s: %+v", id, err)
+		}
+	}
+
+	for _, conditionSetIds := range existingIncludes {
+		for _, conditionSetId := range conditionSetIds {
+			includeId := stable.NewPolicyPermissionGrantPolicyIdIncludeID(id.PermissionGrantPolicyId, conditionSetId)
+			if _, err = includeClient.DeletePermissionGrantPolicyInclude(ctx, includeId, permissiongrantpolicyinclude.DefaultDeletePermissionGrantPolicyIncludeOperationOptions()); err != nil {
+				return fmt.Errorf("removing %s: %+v", includeId, err)
+			}
+		}
+	}
+
+	for _, conditionSetIds := range existingExcludes {
+		for _, conditionSetId := range conditionSetIds {
+			excludeId := stable.NewPolicyPermissionGrantPolicyIdExcludeID(id.PermissionGrantPolicyId, conditionSetId)
+			if _, err = excludeClient.DeletePermissionGrantPolicyExclude(ctx, excludeId, permissiongrantpolicyexclude.DefaultDeletePermissionGrantPolicyExcludeOperationOptions()); err != nil {
+				return fmt.Errorf("removing %s: %+v", excludeId, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// permissionGrantConditionSetIds returns the IDs of the specified condition sets, grouped by their conditions
+func permissionGrantConditionSetIds(input *[]stable.PermissionGrantConditionSet) (map[string][]string, error) {
+	result := make(map[string][]string)
+	for _, conditionSet := range pointer.From(input) {
+		if conditionSet.Id == nil {
+			return nil, errors.New("condition set returned with nil ID")
+		}
+		key := permissionGrantConditionSetKey(flattenPermissionGrantConditionSets(&[]stable.PermissionGrantConditionSet{conditionSet})[0])
+		result[key] = append(result[key], *conditionSet.Id)
+	}
+	return result, nil
+}
+
+// permissionGrantConditionSetKey returns a string which uniquely identifies the conditions of a condition set
+func permissionGrantConditionSetKey(input PermissionGrantConditionSetModel) string {
+	normalize := func(in []string) string {
+		out := make([]string, 0, len(in))
+		for _, v := range in {
+			out = append(out, strings.ToLower(v))
+		}
+		sort.Strings(out)
+		return strings.Join(out, ",")
+	}
+
+	return strings.ToLower(strings.Join([]string{
+		normalize(input.ClientApplicationIds),
+		normalize(input.ClientApplicationPublisherIds),
+		normalize(input.ClientApplicationTenantIds),
+		strconv.FormatBool(input.ClientApplicationsFromVerifiedPublisherOnly),
+		input.PermissionClassification,
+		input.PermissionType,
+		normalize(input.Permissions),
+		input.ResourceApplication,
+	}, "|"))
+}
+
+func expandPermissionGrantConditionSet(input PermissionGrantConditionSetModel) stable.PermissionGrantConditionSet {
+	// Omitted conditions are sent as their documented wildcard values, so that the API does not need to infer them
+	result := stable.PermissionGrantConditionSet{
+		ClientApplicationIds:                        pointer.To([]string{permissionGrantConditionAll}),
+		ClientApplicationPublisherIds:               pointer.To([]string{permissionGrantConditionAll}),
+		ClientApplicationTenantIds:                  pointer.To([]string{permissionGrantConditionAll}),
+		ClientApplicationsFromVerifiedPublisherOnly: nullable.Value(input.ClientApplicationsFromVerifiedPublisherOnly),
+		PermissionClassification:                    nullable.Value(permissionGrantConditionAll),
+		PermissionType:                              stable.PermissionType(input.PermissionType),
+		Permissions:                                 pointer.To([]string{permissionGrantConditionAll}),
+		ResourceApplication:                         nullable.Value(permissionGrantConditionAny),
+	}
+
+	if len(input.ClientApplicationIds) > 0 {
+		result.ClientApplicationIds = pointer.To(input.ClientApplicationIds)
+	}
+	if len(input.ClientApplicationPublisherIds) > 0 {
+		result.ClientApplicationPublisherIds = pointer.To(input.ClientApplicationPublisherIds)
+	}
+	if len(input.ClientApplicationTenantIds) > 0 {
+		result.ClientApplicationTenantIds = pointer.To(input.ClientApplicationTenantIds)
+	}
+	if input.PermissionClassification != "" {
+		result.PermissionClassification = nullable.Value(input.PermissionClassification)
+	}
+	if len(input.Permissions) > 0 {
+		result.Permissions = pointer.To(input.Permissions)
+	}
+	if input.ResourceApplication != "" {
+		result.ResourceApplication = nullable.Value(input.ResourceApplication)
+	}
+
+	return result
+}
+
+func flattenPermissionGrantConditionSets(input *[]stable.PermissionGrantConditionSet) []PermissionGrantConditionSetModel {
+	result := make([]PermissionGrantConditionSetModel, 0)
+	if input == nil {
+		return result
+	}
+
+	// Wildcard values are returned for omitted conditions, these are flattened to empty values to match the configuration
+	withoutWildcard := func(in *[]string) []string {
+		out := make([]string, 0)
+		for _, v := range pointer.From(in) {
+			if v != permissionGrantConditionAll {
+				out = append(out, v)
+			}
+		}
+		return out
+	}
+
+	for _, conditionSet := range *input {
+		model := PermissionGrantConditionSetModel{
+			ClientApplicationIds:                        withoutWildcard(conditionSet.ClientApplicationIds),
+			ClientApplicationPublisherIds:               withoutWildcard(conditionSet.ClientApplicationPublisherIds),
+			ClientApplicationTenantIds:                  withoutWildcard(conditionSet.ClientApplicationTenantIds),
+			ClientApplicationsFromVerifiedPublisherOnly: conditionSet.ClientApplicationsFromVerifiedPublisherOnly.GetOrZero(),
+			PermissionType:                              string(conditionSet.PermissionType),
+			Permissions:                                 withoutWildcard(conditionSet.Permissions),
+		}
+
+		if v := conditionSet.PermissionClassification.GetOrZero(); v != permissionGrantConditionAll {
+			model.PermissionClassification = v
+		}
+		if v := conditionSet.ResourceApplication.GetOrZero(); v != permissionGrantConditionAny {
+			model.ResourceApplication = v
+		}
+
+		result = append(result, model)
+	}
+
+	return result
+}
@@ -0,0 +1,139 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package policies_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/permissiongrantpolicy"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+)
+
+type PermissionGrantPolicyResource struct{}
+
+func TestAccPermissionGrantPolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_permission_grant_policy", "test")
+	r := PermissionGrantPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("include.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccPermissionGrantPolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_permission_grant_policy", "test")
+	r := PermissionGrantPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("include.#").HasValue("2"),
+				check.That(data.ResourceName).Key("exclude.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("exclude.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r PermissionGrantPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Policies.PermissionGrantPolicyClient
+
+	id, err := stable.ParsePolicyPermissionGrantPolicyID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetPermissionGrantPolicy(ctx, *id, permissiongrantpolicy.DefaultGetPermissionGrantPolicyOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to retrieve %s: %v", id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (PermissionGrantPolicyResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_permission_grant_policy" "test" {
+  policy_id    = "acctest-%[1]s"
+  display_name = "acctest-%[1]s"
+  description  = "Low risk delegated permissions from verified publishers"
+
+  include {
+    permission_type                                  = "delegated"
+    permission_classification                        = "low"
+    client_applications_from_verified_publisher_only = true
+  }
+}
+`, data.RandomString)
+}
+
+func (PermissionGrantPolicyResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+data "azuread_client_config" "test" {}
+
+data "azuread_application_published_app_ids" "well_known" {}
+
+resource "azuread_permission_grant_policy" "test" {
+  policy_id    = "acctest-%[1]s"
+  display_name = "acctest-%[1]s-updated"
+  description  = "Low risk delegated permissions, and Microsoft Graph permissions for applications in this tenant"
+
+  include {
+    permission_type                                  = "delegated"
+    permission_classification                        = "low"
+    client_applications_from_verified_publisher_only = true
+  }
+
+  include {
+    permission_type               = "delegated"
+    resource_application          = data.azuread_application_published_app_ids.well_known.result.MicrosoftGraph
+    client_application_tenant_ids = [data.azuread_client_config.test.tenant_id]
+  }
+
+  exclude {
+    permission_type           = "delegated"
+    permission_classification = "high"
+  }
+}
+`, data.RandomString)
+}
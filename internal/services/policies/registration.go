@@ -37,6 +37,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
 		"azuread_authentication_strength_policy": authenticationStrengthPolicyResource(),
 		"azuread_claims_mapping_policy":          claimsMappingPolicyResource(),
+		"azuread_home_realm_discovery_policy":    homeRealmDiscoveryPolicyResource(),
 	}
 }
 
@@ -50,6 +51,12 @@ func (r Registration) DataSources() []sdk.DataSource {
 // Resources returns the typed Resources supported by this service
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
+		AdminConsentRequestPolicyResource{},
+		AppManagementPolicyAssignmentResource{},
+		AppManagementPolicyResource{},
+		AuthorizationPolicyResource{},
 		GroupRoleManagementPolicyResource{},
+		PermissionGrantPolicyResource{},
+		TenantAppManagementPolicyResource{},
 	}
 }
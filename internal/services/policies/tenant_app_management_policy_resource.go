@@ -0,0 +1,242 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package policies
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/defaultappmanagementpolicy"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/policies/parse"
+)
+
+type TenantAppManagementPolicyModel struct {
+	ApplicationRestrictions      []AppManagementRestrictionsModel `tfschema:"application_restrictions"`
+	Description                  string                           `tfschema:"description"`
+	DisplayName                  string                           `tfschema:"display_name"`
+	Enabled                      bool                             `tfschema:"enabled"`
+	ServicePrincipalRestrictions []AppManagementRestrictionsModel `tfschema:"service_principal_restrictions"`
+}
+
+var _ sdk.ResourceWithUpdate = TenantAppManagementPolicyResource{}
+
+type TenantAppManagementPolicyResource struct{}
+
+func (r TenantAppManagementPolicyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return parse.ValidateDefaultAppManagementPolicyID
+}
+
+func (r TenantAppManagementPolicyResource) ResourceType() string {
+	return "azuread_tenant_app_management_policy"
+}
+
+func (r TenantAppManagementPolicyResource) ModelObject() interface{} {
+	return &TenantAppManagementPolicyModel{}
+}
+
+func (r TenantAppManagementPolicyResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"enabled": {
+			Description: "Whether the tenant-wide default policy is enabled",
+			Type:        pluginsdk.TypeBool,
+			Required:    true,
+		},
+
+		"application_restrictions": {
+			Description: "Restrictions that apply to all applications in the tenant",
+			Type:        pluginsdk.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &pluginsdk.Resource{
+				Schema: appManagementRestrictionsSchema(),
+			},
+		},
+
+		"service_principal_restrictions": {
+			Description: "Restrictions that apply to all service principals in the tenant",
+			Type:        pluginsdk.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &pluginsdk.Resource{
+				Schema: appManagementRestrictionsSchema(),
+			},
+		},
+	}
+}
+
+func (r TenantAppManagementPolicyResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"display_name": {
+			Description: "The display name of the policy",
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+		},
+
+		"description": {
+			Description: "The description of the policy",
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+		},
+	}
+}
+
+func (r TenantAppManagementPolicyResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Policies.DefaultAppManagementPolicyClient
+
+			var model TenantAppManagementPolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := parse.NewDefaultAppManagementPolicyID()
+
+			// The default policy always exists, so we configure it in place
+			if _, err := client.UpdateDefaultAppManagementPolicy(ctx, expandTenantAppManagementPolicy(model), defaultappmanagementpolicy.DefaultUpdateDefaultAppManagementPolicyOperationOptions()); err != nil {
+				return fmt.Errorf("configuring %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r TenantAppManagementPolicyResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Policies.DefaultAppManagementPolicyClient
+
+			id, err := parse.ParseDefaultAppManagementPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.GetDefaultAppManagementPolicy(ctx, defaultappmanagementpolicy.DefaultGetDefaultAppManagementPolicyOperationOptions())
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			policy := resp.Model
+			if policy == nil {
+				return fmt.Errorf("retrieving %s: model was nil", id)
+			}
+
+			state := TenantAppManagementPolicyModel{
+				ApplicationRestrictions:      make([]AppManagementRestrictionsModel, 0),
+				Description:                  policy.Description.GetOrZero(),
+				DisplayName:                  policy.DisplayName.GetOrZero(),
+				Enabled:                      pointer.From(policy.IsEnabled),
+				ServicePrincipalRestrictions: make([]AppManagementRestrictionsModel, 0),
+			}
+
+			if restrictions := policy.ApplicationRestrictions; restrictions != nil {
+				keyCredentials := flattenAppManagementKeyCredentialRestrictions(restrictions.KeyCredentials)
+				passwordCredentials := flattenAppManagementPasswordCredentialRestrictions(restrictions.PasswordCredentials)
+
+				if len(keyCredentials) > 0 || len(passwordCredentials) > 0 {
+					state.ApplicationRestrictions = append(state.ApplicationRestrictions, AppManagementRestrictionsModel{
+						KeyCredentials:      keyCredentials,
+						PasswordCredentials: passwordCredentials,
+					})
+				}
+			}
+
+			if restrictions := policy.ServicePrincipalRestrictions; restrictions != nil {
+				keyCredentials := flattenAppManagementKeyCredentialRestrictions(restrictions.KeyCredentials)
+				passwordCredentials := flattenAppManagementPasswordCredentialRestrictions(restrictions.PasswordCredentials)
+
+				if len(keyCredentials) > 0 || len(passwordCredentials) > 0 {
+					state.ServicePrincipalRestrictions = append(state.ServicePrincipalRestrictions, AppManagementRestrictionsModel{
+						KeyCredentials:      keyCredentials,
+						PasswordCredentials: passwordCredentials,
+					})
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r TenantAppManagementPolicyResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Policies.DefaultAppManagementPolicyClient
+
+			id, err := parse.ParseDefaultAppManagementPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model TenantAppManagementPolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if _, err = client.UpdateDefaultAppManagementPolicy(ctx, expandTenantAppManagementPolicy(model), defaultappmanagementpolicy.DefaultUpdateDefaultAppManagementPolicyOperationOptions()); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r TenantAppManagementPolicyResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Policies.DefaultAppManagementPolicyClient
+
+			id, err := parse.ParseDefaultAppManagementPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// The default policy cannot be deleted, so we disable it and remove all restrictions instead
+			if _, err = client.UpdateDefaultAppManagementPolicy(ctx, expandTenantAppManagementPolicy(TenantAppManagementPolicyModel{}), defaultappmanagementpolicy.DefaultUpdateDefaultAppManagementPolicyOperationOptions()); err != nil {
+				return fmt.Errorf("resetting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandTenantAppManagementPolicy(model TenantAppManagementPolicyModel) stable.TenantAppManagementPolicy {
+	applicationRestrictions := stable.AppManagementApplicationConfiguration{
+		KeyCredentials:      &[]stable.KeyCredentialConfiguration{},
+		PasswordCredentials: &[]stable.PasswordCredentialConfiguration{},
+	}
+	if len(model.ApplicationRestrictions) > 0 {
+		applicationRestrictions.KeyCredentials = expandAppManagementKeyCredentialRestrictions(model.ApplicationRestrictions[0].KeyCredentials)
+		applicationRestrictions.PasswordCredentials = expandAppManagementPasswordCredentialRestrictions(model.ApplicationRestrictions[0].PasswordCredentials)
+	}
+
+	servicePrincipalRestrictions := stable.AppManagementServicePrincipalConfiguration{
+		KeyCredentials:      &[]stable.KeyCredentialConfiguration{},
+		PasswordCredentials: &[]stable.PasswordCredentialConfiguration{},
+	}
+	if len(model.ServicePrincipalRestrictions) > 0 {
+		servicePrincipalRestrictions.KeyCredentials = expandAppManagementKeyCredentialRestrictions(model.ServicePrincipalRestrictions[0].KeyCredentials)
+		servicePrincipalRestrictions.PasswordCredentials = expandAppManagementPasswordCredentialRestrictions(model.ServicePrincipalRestrictions[0].PasswordCredentials)
+	}
+
+	return stable.TenantAppManagementPolicy{
+		ApplicationRestrictions:      &applicationRestrictions,
+		IsEnabled:                    pointer.To(model.Enabled),
+		ServicePrincipalRestrictions: &servicePrincipalRestrictions,
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package policies_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/defaultappmanagementpolicy"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+)
+
+type TenantAppManagementPolicyResource struct{}
+
+func TestAccTenantAppManagementPolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_tenant_app_management_policy", "test")
+	r := TenantAppManagementPolicyResource{}
+
+	// The default policy is a tenant-wide singleton, so these tests must not run in parallel
+	data.ResourceSequentialTestSkipCheckDestroyed(t, []acceptance.TestStep{
+		{
+			Config: r.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r TenantAppManagementPolicyResource) Exists(ctx context.Context, clients *clients.Client, _ *terraform.InstanceState) (*bool, error) {
+	client := clients.Policies.DefaultAppManagementPolicyClient
+
+	if _, err := client.GetDefaultAppManagementPolicy(ctx, defaultappmanagementpolicy.DefaultGetDefaultAppManagementPolicyOperationOptions()); err != nil {
+		return nil, fmt.Errorf("failed to retrieve default app management policy: %v", err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (TenantAppManagementPolicyResource) basic() string {
+	return `
+provider "azuread" {}
+
+resource "azuread_tenant_app_management_policy" "test" {
+  enabled = false
+}
+`
+}
+
+func (TenantAppManagementPolicyResource) complete() string {
+	return `
+provider "azuread" {}
+
+resource "azuread_tenant_app_management_policy" "test" {
+  enabled = true
+
+  application_restrictions {
+    password_credential {
+      restriction_type                = "passwordLifetime"
+      max_lifetime                    = "P365D"
+      restrict_for_apps_created_after = "2019-10-19T10:37:00Z"
+    }
+
+    key_credential {
+      restriction_type                = "asymmetricKeyLifetime"
+      max_lifetime                    = "P365D"
+      restrict_for_apps_created_after = "2019-10-19T10:37:00Z"
+    }
+  }
+
+  service_principal_restrictions {
+    password_credential {
+      restriction_type                = "passwordLifetime"
+      max_lifetime                    = "P365D"
+      restrict_for_apps_created_after = "2019-10-19T10:37:00Z"
+    }
+  }
+}
+`
+}
@@ -38,12 +38,13 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azuread_service_principal":                                  servicePrincipalResource(),
-		"azuread_service_principal_certificate":                      servicePrincipalCertificateResource(),
-		"azuread_service_principal_claims_mapping_policy_assignment": servicePrincipalClaimsMappingPolicyAssignmentResource(),
-		"azuread_service_principal_delegated_permission_grant":       servicePrincipalDelegatedPermissionGrantResource(),
-		"azuread_service_principal_password":                         servicePrincipalPasswordResource(),
-		"azuread_service_principal_token_signing_certificate":        servicePrincipalTokenSigningCertificateResource(),
+		"azuread_service_principal":                                        servicePrincipalResource(),
+		"azuread_service_principal_certificate":                            servicePrincipalCertificateResource(),
+		"azuread_service_principal_claims_mapping_policy_assignment":       servicePrincipalClaimsMappingPolicyAssignmentResource(),
+		"azuread_service_principal_delegated_permission_grant":             servicePrincipalDelegatedPermissionGrantResource(),
+		"azuread_service_principal_home_realm_discovery_policy_assignment": servicePrincipalHomeRealmDiscoveryPolicyAssignmentResource(),
+		"azuread_service_principal_password":                               servicePrincipalPasswordResource(),
+		"azuread_service_principal_token_signing_certificate":              servicePrincipalTokenSigningCertificateResource(),
 	}
 }
 
@@ -56,5 +57,7 @@ func (r Registration) DataSources() []sdk.DataSource {
 
 // Resources returns the typed Resources supported by this service
 func (r Registration) Resources() []sdk.Resource {
-	return []sdk.Resource{}
+	return []sdk.Resource{
+		ServicePrincipalDelegatedPermissionClassificationResource{},
+	}
 }
@@ -0,0 +1,197 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package serviceprincipals
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/delegatedpermissionclassification"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/hashicorp/terraform-provider-azuread/internal/sdk"
+)
+
+type ServicePrincipalDelegatedPermissionClassificationModel struct {
+	Classification     string `tfschema:"classification"`
+	PermissionId       string `tfschema:"permission_id"`
+	PermissionName     string `tfschema:"permission_name"`
+	ServicePrincipalId string `tfschema:"service_principal_id"`
+}
+
+var _ sdk.Resource = ServicePrincipalDelegatedPermissionClassificationResource{}
+
+type ServicePrincipalDelegatedPermissionClassificationResource struct{}
+
+func (r ServicePrincipalDelegatedPermissionClassificationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return stable.ValidateServicePrincipalIdDelegatedPermissionClassificationID
+}
+
+func (r ServicePrincipalDelegatedPermissionClassificationResource) ResourceType() string {
+	return "azuread_service_principal_delegated_permission_classification"
+}
+
+func (r ServicePrincipalDelegatedPermissionClassificationResource) ModelObject() interface{} {
+	return &ServicePrincipalDelegatedPermissionClassificationModel{}
+}
+
+func (r ServicePrincipalDelegatedPermissionClassificationResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"service_principal_id": {
+			Description:  "The resource ID of the service principal which exposes the delegated permission",
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: stable.ValidateServicePrincipalID,
+		},
+
+		"classification": {
+			Description:  "The classification for the delegated permission",
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(stable.PossibleValuesForPermissionClassificationType(), false),
+		},
+
+		"permission_id": {
+			Description:  "The ID of the delegated permission to classify",
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ExactlyOneOf: []string{"permission_id", "permission_name"},
+			ValidateFunc: validation.IsUUID,
+		},
+
+		"permission_name": {
+			Description:  "The claim value (scope name) of the delegated permission to classify",
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ExactlyOneOf: []string{"permission_id", "permission_name"},
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r ServicePrincipalDelegatedPermissionClassificationResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ServicePrincipalDelegatedPermissionClassificationResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ServicePrincipals.DelegatedPermissionClassificationClient
+
+			var model ServicePrincipalDelegatedPermissionClassificationModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			servicePrincipalId, err := stable.ParseServicePrincipalID(model.ServicePrincipalId)
+			if err != nil {
+				return err
+			}
+
+			properties := stable.DelegatedPermissionClassification{
+				Classification: pointer.To(stable.PermissionClassificationType(model.Classification)),
+				PermissionId:   nullable.NoZero(model.PermissionId),
+				PermissionName: nullable.NoZero(model.PermissionName),
+			}
+
+			resp, err := client.CreateDelegatedPermissionClassification(ctx, *servicePrincipalId, properties, delegatedpermissionclassification.DefaultCreateDelegatedPermissionClassificationOperationOptions())
+			if err != nil {
+				return fmt.Errorf("creating delegated permission classification for %s: %+v", servicePrincipalId, err)
+			}
+
+			classification := resp.Model
+			if classification == nil {
+				return fmt.Errorf("creating delegated permission classification for %s: model was nil", servicePrincipalId)
+			}
+			if classification.Id == nil {
+				return errors.New("creating delegated permission classification: model returned with nil ID")
+			}
+
+			id := stable.NewServicePrincipalIdDelegatedPermissionClassificationID(servicePrincipalId.ServicePrincipalId, *classification.Id)
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r ServicePrincipalDelegatedPermissionClassificationResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ServicePrincipals.DelegatedPermissionClassificationClient
+
+			id, err := stable.ParseServicePrincipalIdDelegatedPermissionClassificationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			servicePrincipalId := stable.NewServicePrincipalID(id.ServicePrincipalId)
+
+			// Individual classifications cannot be retrieved, so we list them and look for a match
+			resp, err := client.ListDelegatedPermissionClassifications(ctx, servicePrincipalId, delegatedpermissionclassification.DefaultListDelegatedPermissionClassificationsOperationOptions())
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("listing delegated permission classifications for %s: %+v", servicePrincipalId, err)
+			}
+
+			var classification *stable.DelegatedPermissionClassification
+			if resp.Model != nil {
+				for _, c := range *resp.Model {
+					if pointer.From(c.Id) == id.DelegatedPermissionClassificationId {
+						classification = &c
+						break
+					}
+				}
+			}
+			if classification == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			state := ServicePrincipalDelegatedPermissionClassificationModel{
+				Classification:     string(pointer.From(classification.Classification)),
+				PermissionId:       classification.PermissionId.GetOrZero(),
+				PermissionName:     classification.PermissionName.GetOrZero(),
+				ServicePrincipalId: servicePrincipalId.ID(),
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ServicePrincipalDelegatedPermissionClassificationResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ServicePrincipals.DelegatedPermissionClassificationClient
+
+			id, err := stable.ParseServicePrincipalIdDelegatedPermissionClassificationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err = client.DeleteDelegatedPermissionClassification(ctx, *id, delegatedpermissionclassification.DefaultDeleteDelegatedPermissionClassificationOperationOptions()); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
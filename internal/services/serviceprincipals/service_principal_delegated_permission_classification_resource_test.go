@@ -0,0 +1,129 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package serviceprincipals_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/delegatedpermissionclassification"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+)
+
+type ServicePrincipalDelegatedPermissionClassificationResource struct{}
+
+func TestAccServicePrincipalDelegatedPermissionClassification_byId(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_service_principal_delegated_permission_classification", "test")
+	r := ServicePrincipalDelegatedPermissionClassificationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.byId(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("permission_name").HasValue("user_impersonation"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccServicePrincipalDelegatedPermissionClassification_byName(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_service_principal_delegated_permission_classification", "test")
+	r := ServicePrincipalDelegatedPermissionClassificationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.byName(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("permission_id").IsUuid(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ServicePrincipalDelegatedPermissionClassificationResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.ServicePrincipals.DelegatedPermissionClassificationClient
+
+	id, err := stable.ParseServicePrincipalIdDelegatedPermissionClassificationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.ListDelegatedPermissionClassifications(ctx, stable.NewServicePrincipalID(id.ServicePrincipalId), delegatedpermissionclassification.DefaultListDelegatedPermissionClassificationsOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to list delegated permission classifications for %s: %+v", id, err)
+	}
+
+	if resp.Model != nil {
+		for _, classification := range *resp.Model {
+			if pointer.From(classification.Id) == id.DelegatedPermissionClassificationId {
+				return pointer.To(true), nil
+			}
+		}
+	}
+
+	return pointer.To(false), nil
+}
+
+func (ServicePrincipalDelegatedPermissionClassificationResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_application" "test" {
+  display_name = "acctest-APP-%[1]d"
+
+  api {
+    oauth2_permission_scope {
+      admin_consent_description  = "Administer the application"
+      admin_consent_display_name = "Administer"
+      enabled                    = true
+      id                         = "%[2]s"
+      type                       = "User"
+      value                      = "user_impersonation"
+    }
+  }
+}
+
+resource "azuread_service_principal" "test" {
+  client_id = azuread_application.test.client_id
+}
+`, data.RandomInteger, data.UUID())
+}
+
+func (r ServicePrincipalDelegatedPermissionClassificationResource) byId(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_service_principal_delegated_permission_classification" "test" {
+  service_principal_id = azuread_service_principal.test.id
+  permission_id        = azuread_service_principal.test.oauth2_permission_scope_ids["user_impersonation"]
+  classification       = "low"
+}
+`, r.template(data))
+}
+
+func (r ServicePrincipalDelegatedPermissionClassificationResource) byName(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_service_principal_delegated_permission_classification" "test" {
+  service_principal_id = azuread_service_principal.test.id
+  permission_name      = "user_impersonation"
+  classification       = "medium"
+}
+`, r.template(data))
+}
@@ -0,0 +1,152 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package serviceprincipals
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/homerealmdiscoverypolicy"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+)
+
+func servicePrincipalHomeRealmDiscoveryPolicyAssignmentResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: servicePrincipalHomeRealmDiscoveryPolicyAssignmentResourceCreate,
+		ReadContext:   servicePrincipalHomeRealmDiscoveryPolicyAssignmentResourceRead,
+		DeleteContext: servicePrincipalHomeRealmDiscoveryPolicyAssignmentResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			if _, errs := stable.ValidateServicePrincipalIdHomeRealmDiscoveryPolicyID(id, "id"); len(errs) > 0 {
+				out := ""
+				for _, err := range errs {
+					out += err.Error()
+				}
+				return errors.New(out)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"home_realm_discovery_policy_id": {
+				Description:  "ID of the home realm discovery policy to assign",
+				Type:         pluginsdk.TypeString,
+				ForceNew:     true,
+				Required:     true,
+				ValidateFunc: stable.ValidatePolicyHomeRealmDiscoveryPolicyID,
+			},
+
+			"service_principal_id": {
+				Description:  "ID of the service principal for which to assign the policy",
+				Type:         pluginsdk.TypeString,
+				ForceNew:     true,
+				Required:     true,
+				ValidateFunc: stable.ValidateServicePrincipalID,
+			},
+		},
+	}
+}
+
+func servicePrincipalHomeRealmDiscoveryPolicyAssignmentResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).ServicePrincipals.HomeRealmDiscoveryPolicyClient
+
+	servicePrincipalId, err := stable.ParseServicePrincipalID(d.Get("service_principal_id").(string))
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "service_principal_id", "Parsing `service_principal_id`")
+	}
+
+	policyId, err := stable.ParsePolicyHomeRealmDiscoveryPolicyID(d.Get("home_realm_discovery_policy_id").(string))
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "home_realm_discovery_policy_id", "Parsing `home_realm_discovery_policy_id`")
+	}
+
+	ref := stable.ReferenceCreate{
+		ODataId: pointer.To(client.Client.BaseUri + stable.NewDirectoryObjectID(policyId.HomeRealmDiscoveryPolicyId).ID()),
+	}
+
+	if _, err := client.AddHomeRealmDiscoveryPolicyRef(ctx, *servicePrincipalId, ref, homerealmdiscoverypolicy.DefaultAddHomeRealmDiscoveryPolicyRefOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Creating HomeRealmDiscoveryPolicyAssignment for %s", servicePrincipalId)
+	}
+
+	id := stable.NewServicePrincipalIdHomeRealmDiscoveryPolicyID(servicePrincipalId.ServicePrincipalId, policyId.HomeRealmDiscoveryPolicyId)
+	d.SetId(id.ID())
+
+	return servicePrincipalHomeRealmDiscoveryPolicyAssignmentResourceRead(ctx, d, meta)
+}
+
+func servicePrincipalHomeRealmDiscoveryPolicyAssignmentResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).ServicePrincipals.HomeRealmDiscoveryPolicyClient
+
+	id, err := stable.ParseServicePrincipalIdHomeRealmDiscoveryPolicyID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Home Realm Discovery Policy Assignment ID %q", d.Id())
+	}
+
+	policyId := stable.NewPolicyHomeRealmDiscoveryPolicyID(id.HomeRealmDiscoveryPolicyId)
+	servicePrincipalId := stable.NewServicePrincipalID(id.ServicePrincipalId)
+
+	resp, err := client.ListHomeRealmDiscoveryPolicies(ctx, servicePrincipalId, homerealmdiscoverypolicy.DefaultListHomeRealmDiscoveryPoliciesOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing home realm discovery policy assignment from state!", servicePrincipalId)
+			d.SetId("")
+			return nil
+		}
+
+		return tf.ErrorDiagF(err, "listing Home Realm Discovery Policy Assignments for %s", servicePrincipalId)
+	}
+
+	policies := resp.Model
+	if policies == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "listing Home Realm Discovery Policy Assignments for %s", servicePrincipalId)
+	}
+
+	var policy *stable.HomeRealmDiscoveryPolicy
+
+	// Check the assignment is found in the currently assigned policies
+	for _, p := range *policies {
+		if pointer.From(p.Id) == id.HomeRealmDiscoveryPolicyId {
+			policy = &p
+			break
+		}
+	}
+	if policy == nil {
+		d.SetId("")
+		log.Printf("[DEBUG] Home Realm Discovery Policy with Object ID %q was not found - removing assignment from state!", id.HomeRealmDiscoveryPolicyId)
+		return nil
+	}
+
+	tf.Set(d, "service_principal_id", servicePrincipalId.ID())
+	tf.Set(d, "home_realm_discovery_policy_id", policyId.ID())
+
+	return nil
+}
+
+func servicePrincipalHomeRealmDiscoveryPolicyAssignmentResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).ServicePrincipals.HomeRealmDiscoveryPolicyClient
+
+	id, err := stable.ParseServicePrincipalIdHomeRealmDiscoveryPolicyID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Home Realm Discovery Policy Assignment ID %q", d.Id())
+	}
+
+	if _, err = client.RemoveHomeRealmDiscoveryPolicyRef(ctx, *id, homerealmdiscoverypolicy.DefaultRemoveHomeRealmDiscoveryPolicyRefOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "removing %s", id)
+	}
+
+	return servicePrincipalHomeRealmDiscoveryPolicyAssignmentResourceRead(ctx, d, meta)
+}
@@ -14,18 +14,50 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
-	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/beta"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
 	serviceprincipalBeta "github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/beta/serviceprincipal"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/serviceprincipal"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
 	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/lookup"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
 )
 
+// servicePrincipalsFilterProperties are the service principal properties which can be referenced in an advanced query filter
+var servicePrincipalsFilterProperties = []string{
+	"accountEnabled",
+	"alternativeNames",
+	"appDisplayName",
+	"appId",
+	"appOwnerOrganizationId",
+	"appRoleAssignmentRequired",
+	"createdDateTime",
+	"description",
+	"displayName",
+	"homepage",
+	"id",
+	"notificationEmailAddresses",
+	"preferredSingleSignOnMode",
+	"publisherName",
+	"replyUrls",
+	"servicePrincipalNames",
+	"servicePrincipalType",
+	"signInAudience",
+	"tags",
+	"verifiedPublisher",
+}
+
+// servicePrincipalsSearchProperties are the service principal properties which can be referenced in a search expression
+var servicePrincipalsSearchProperties = []string{
+	"appDisplayName",
+	"description",
+	"displayName",
+	"publisherName",
+}
+
 func servicePrincipalsDataSource() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		ReadContext: servicePrincipalsDataSourceRead,
@@ -36,11 +68,12 @@ func servicePrincipalsDataSource() *pluginsdk.Resource {
 
 		Schema: map[string]*pluginsdk.Schema{
 			"client_ids": {
-				Description:  "The client IDs of the applications associated with the service principals",
-				Type:         pluginsdk.TypeList,
-				Optional:     true,
-				Computed:     true,
-				ExactlyOneOf: []string{"client_ids", "display_names", "object_ids", "return_all"},
+				Description:   "The client IDs of the applications associated with the service principals",
+				Type:          pluginsdk.TypeList,
+				Optional:      true,
+				Computed:      true,
+				AtLeastOneOf:  []string{"client_ids", "display_names", "object_ids", "filter", "return_all", "search"},
+				ConflictsWith: []string{"display_names", "object_ids", "filter", "return_all", "search"},
 				Elem: &pluginsdk.Schema{
 					Type:         pluginsdk.TypeString,
 					ValidateFunc: validation.IsUUID,
@@ -48,11 +81,12 @@ func servicePrincipalsDataSource() *pluginsdk.Resource {
 			},
 
 			"display_names": {
-				Description:  "The display names of the applications associated with the service principals",
-				Type:         pluginsdk.TypeList,
-				Optional:     true,
-				Computed:     true,
-				ExactlyOneOf: []string{"client_ids", "display_names", "object_ids", "return_all"},
+				Description:   "The display names of the applications associated with the service principals",
+				Type:          pluginsdk.TypeList,
+				Optional:      true,
+				Computed:      true,
+				AtLeastOneOf:  []string{"client_ids", "display_names", "object_ids", "filter", "return_all", "search"},
+				ConflictsWith: []string{"client_ids", "object_ids", "filter", "return_all", "search"},
 				Elem: &pluginsdk.Schema{
 					Type:         pluginsdk.TypeString,
 					ValidateFunc: validation.StringIsNotEmpty,
@@ -60,23 +94,42 @@ func servicePrincipalsDataSource() *pluginsdk.Resource {
 			},
 
 			"object_ids": {
-				Description:  "The object IDs of the service principals",
-				Type:         pluginsdk.TypeList,
-				Optional:     true,
-				Computed:     true,
-				ExactlyOneOf: []string{"client_ids", "display_names", "object_ids", "return_all"},
+				Description:   "The object IDs of the service principals",
+				Type:          pluginsdk.TypeList,
+				Optional:      true,
+				Computed:      true,
+				AtLeastOneOf:  []string{"client_ids", "display_names", "object_ids", "filter", "return_all", "search"},
+				ConflictsWith: []string{"client_ids", "display_names", "filter", "return_all", "search"},
 				Elem: &pluginsdk.Schema{
 					Type:         pluginsdk.TypeString,
 					ValidateFunc: validation.IsUUID,
 				},
 			},
 
+			"filter": {
+				Description:   "An OData `$filter` expression used to find service principals. This is sent as an advanced query, using the `ConsistencyLevel: eventual` header and `$count`, so that additional properties and operators can be used",
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				AtLeastOneOf:  []string{"client_ids", "display_names", "object_ids", "filter", "return_all", "search"},
+				ConflictsWith: []string{"client_ids", "display_names", "object_ids", "return_all"},
+				ValidateFunc:  validation.ODataFilter(servicePrincipalsFilterProperties),
+			},
+
+			"search": {
+				Description:   "An OData `$search` expression used to find service principals, in the form `\"property:value\"`. This is sent using the `ConsistencyLevel: eventual` header and `$count`, and is combined with `filter` when both are specified",
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				AtLeastOneOf:  []string{"client_ids", "display_names", "object_ids", "filter", "return_all", "search"},
+				ConflictsWith: []string{"client_ids", "display_names", "object_ids", "return_all"},
+				ValidateFunc:  validation.ODataSearch(servicePrincipalsSearchProperties),
+			},
+
 			"ignore_missing": {
 				Description:   "Ignore missing service principals and return the service principals that were found. The data source will still fail if no service principals are found",
 				Type:          pluginsdk.TypeBool,
 				Optional:      true,
 				Default:       false,
-				ConflictsWith: []string{"return_all"},
+				ConflictsWith: []string{"filter", "return_all", "search"},
 			},
 
 			"return_all": {
@@ -84,8 +137,8 @@ func servicePrincipalsDataSource() *pluginsdk.Resource {
 				Type:          pluginsdk.TypeBool,
 				Optional:      true,
 				Default:       false,
-				ConflictsWith: []string{"ignore_missing"},
-				ExactlyOneOf:  []string{"client_ids", "display_names", "object_ids", "return_all"},
+				AtLeastOneOf:  []string{"client_ids", "display_names", "object_ids", "filter", "return_all", "search"},
+				ConflictsWith: []string{"ignore_missing", "client_ids", "display_names", "object_ids", "filter", "search"},
 			},
 
 			"service_principals": {
@@ -186,6 +239,8 @@ func servicePrincipalsDataSourceRead(ctx context.Context, d *pluginsdk.ResourceD
 	var expectedCount int
 	ignoreMissing := d.Get("ignore_missing").(bool)
 	returnAll := d.Get("return_all").(bool)
+	filter := d.Get("filter").(string)
+	search := d.Get("search").(string)
 
 	fieldsToSelect := []string{
 		"accountEnabled",
@@ -216,83 +271,110 @@ func servicePrincipalsDataSourceRead(ctx context.Context, d *pluginsdk.ResourceD
 
 		servicePrincipals = append(servicePrincipals, *resp.Model...)
 
-	} else if len(clientIdsToSearch) > 0 {
-		expectedCount = len(clientIdsToSearch)
-		for _, v := range clientIdsToSearch {
-			options := serviceprincipal.ListServicePrincipalsOperationOptions{
-				Filter: pointer.To(fmt.Sprintf("appId eq '%s'", odata.EscapeSingleQuote(v))),
-				Select: &fieldsToSelect,
-			}
-			resp, err := client.ListServicePrincipals(ctx, options)
-			if err != nil {
-				return tf.ErrorDiagF(err, "Finding service principals with application ID: %q", v)
+	} else if filter != "" || search != "" {
+		// Advanced queries require the ConsistencyLevel header and a $count parameter
+		options := serviceprincipal.ListServicePrincipalsOperationOptions{
+			ConsistencyLevel: pointer.To(odata.ConsistencyLevelEventual),
+			Count:            pointer.To(true),
+			Select:           &fieldsToSelect,
+		}
+		if filter != "" {
+			options.Filter = pointer.To(filter)
+		}
+		if search != "" {
+			options.Search = pointer.To(search)
+		}
+
+		resp, err := client.ListServicePrincipals(ctx, options)
+		if err != nil {
+			if filter == "" {
+				return tf.ErrorDiagPathF(err, "search", "Could not retrieve service principals matching search: %q", search)
 			}
-			if resp.Model == nil {
-				return tf.ErrorDiagF(errors.New("API returned nil result"), "Bad API Response")
+			return tf.ErrorDiagPathF(err, "filter", "Could not retrieve service principals matching filter: %q", filter)
+		}
+		if resp.Model == nil {
+			return tf.ErrorDiagF(errors.New("API returned nil result"), "Bad API Response")
+		}
+		if len(*resp.Model) == 0 {
+			if filter == "" {
+				return tf.ErrorDiagPathF(nil, "search", "No service principals found matching search: %q", search)
 			}
+			return tf.ErrorDiagPathF(nil, "filter", "No service principals found matching filter: %q", filter)
+		}
 
-			count := len(*resp.Model)
+		servicePrincipals = append(servicePrincipals, *resp.Model...)
+
+	} else if len(clientIdsToSearch) > 0 {
+		expectedCount = len(clientIdsToSearch)
+		results, err := listServicePrincipalsByProperty(ctx, client, "appId", clientIdsToSearch, fieldsToSelect, func(s stable.ServicePrincipal) string {
+			return s.AppId.GetOrZero()
+		})
+		if err != nil {
+			return tf.ErrorDiagF(err, "Finding service principals by application ID")
+		}
+
+		for _, v := range clientIdsToSearch {
+			matches := results[strings.ToLower(v)]
+			count := len(matches)
 			if count > 1 {
-				return tf.ErrorDiagPathF(nil, "mail_nicknames", "More than one service principal found with application ID: %q", v)
+				return tf.ErrorDiagPathF(nil, "client_ids", "More than one service principal found with application ID: %q", v)
 			} else if count == 0 {
 				if ignoreMissing {
 					continue
 				}
-				return tf.ErrorDiagPathF(err, "mail_nicknames", "Service principal not found with application ID: %q", v)
+				return tf.ErrorDiagPathF(nil, "client_ids", "Service principal not found with application ID: %q", v)
 			}
 
-			servicePrincipals = append(servicePrincipals, (*resp.Model)[0])
+			servicePrincipals = append(servicePrincipals, matches[0])
 		}
 
 	} else if displayNames, ok := d.Get("display_names").([]interface{}); ok && len(displayNames) > 0 {
 		expectedCount = len(displayNames)
+		results, err := listServicePrincipalsByProperty(ctx, client, "displayName", tf.ExpandStringSlice(displayNames), fieldsToSelect, func(s stable.ServicePrincipal) string {
+			return s.DisplayName.GetOrZero()
+		})
+		if err != nil {
+			return tf.ErrorDiagF(err, "Finding service principals by display name")
+		}
+
 		for _, v := range tf.ExpandStringSlice(displayNames) {
-			options := serviceprincipal.ListServicePrincipalsOperationOptions{
-				Filter: pointer.To(fmt.Sprintf("displayName eq '%s'", odata.EscapeSingleQuote(v))),
-				Select: &fieldsToSelect,
-			}
-			resp, err := client.ListServicePrincipals(ctx, options)
-			if err != nil {
-				return tf.ErrorDiagF(err, "Finding service principals with display name: %q", v)
-			}
-			if resp.Model == nil {
-				return tf.ErrorDiagF(errors.New("API returned nil result"), "Bad API Response")
-			}
-			if l := len(*resp.Model); l > 1 {
+			matches := results[strings.ToLower(v)]
+			if l := len(matches); l > 1 {
 				return tf.ErrorDiagF(errors.New("more than one service principal returned with this display name"), "Finding service principals with display name: %q", v)
 			} else if l == 0 {
 				if ignoreMissing {
 					continue
 				}
-				return tf.ErrorDiagPathF(err, "display_names", "No service principals with display name %q were found", v)
+				return tf.ErrorDiagPathF(nil, "display_names", "No service principals with display name %q were found", v)
 			}
 
-			servicePrincipals = append(servicePrincipals, (*resp.Model)[0])
+			servicePrincipals = append(servicePrincipals, matches[0])
 		}
 
 	} else if objectIds, ok := d.Get("object_ids").([]interface{}); ok && len(objectIds) > 0 {
 		expectedCount = len(objectIds)
-		for _, v := range objectIds {
-			resp, err := client.GetServicePrincipal(ctx, stable.NewServicePrincipalID(v.(string)), serviceprincipal.GetServicePrincipalOperationOptions{Select: &fieldsToSelect})
-			if err != nil {
-				if response.WasNotFound(resp.HttpResponse) {
-					if ignoreMissing {
-						continue
-					}
-					return tf.ErrorDiagPathF(nil, "object_id", "Service principal not found with object ID: %q", v)
+		results, err := listServicePrincipalsByProperty(ctx, client, "id", tf.ExpandStringSlice(objectIds), fieldsToSelect, func(s stable.ServicePrincipal) string {
+			return pointer.From(s.Id)
+		})
+		if err != nil {
+			return tf.ErrorDiagF(err, "Retrieving service principals by object ID")
+		}
+
+		for _, v := range tf.ExpandStringSlice(objectIds) {
+			matches := results[strings.ToLower(v)]
+			if len(matches) == 0 {
+				if ignoreMissing {
+					continue
 				}
-				return tf.ErrorDiagF(err, "Retrieving service principal with object ID: %q", v)
-			}
-			if resp.Model == nil {
 				return tf.ErrorDiagPathF(nil, "object_id", "Service principal not found with object ID: %q", v)
 			}
 
-			servicePrincipals = append(servicePrincipals, *resp.Model)
+			servicePrincipals = append(servicePrincipals, matches[0])
 		}
 	}
 
 	// Check that the right number of service principals were returned
-	if !returnAll && !ignoreMissing && len(servicePrincipals) != expectedCount {
+	if !returnAll && !ignoreMissing && filter == "" && search == "" && len(servicePrincipals) != expectedCount {
 		return tf.ErrorDiagF(fmt.Errorf("expected: %d, actual: %d", expectedCount, len(servicePrincipals)), "Unexpected number of service principals returned")
 	}
 
@@ -364,3 +446,22 @@ func servicePrincipalsDataSourceRead(ctx context.Context, d *pluginsdk.ResourceD
 
 	return nil
 }
+
+// listServicePrincipalsByProperty retrieves service principals where the specified property matches any of the provided
+// values, keyed by the lower-cased value returned by key. See lookup.ByProperty.
+func listServicePrincipalsByProperty(ctx context.Context, client *serviceprincipal.ServicePrincipalClient, property string, values []string, fieldsToSelect []string, key func(stable.ServicePrincipal) string) (map[string][]stable.ServicePrincipal, error) {
+	return lookup.ByProperty(ctx, property, values, func(ctx context.Context, filter string) ([]stable.ServicePrincipal, error) {
+		options := serviceprincipal.ListServicePrincipalsOperationOptions{
+			Filter: pointer.To(filter),
+			Select: &fieldsToSelect,
+		}
+		resp, err := client.ListServicePrincipals(ctx, options)
+		if err != nil {
+			return nil, fmt.Errorf("listing service principals with filter %q: %+v", filter, err)
+		}
+		if resp.Model == nil {
+			return nil, errors.New("API returned nil result")
+		}
+		return *resp.Model, nil
+	}, key)
+}
@@ -119,6 +119,20 @@ func TestAccServicePrincipalsDataSource_returnAll(t *testing.T) {
 	}})
 }
 
+func TestAccServicePrincipalsDataSource_byFilter(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_service_principals", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{{
+		Config: ServicePrincipalsDataSource{}.byFilter(data),
+		Check: acceptance.ComposeTestCheckFunc(
+			check.That(data.ResourceName).Key("client_ids.#").HasValue("2"),
+			check.That(data.ResourceName).Key("display_names.#").HasValue("2"),
+			check.That(data.ResourceName).Key("object_ids.#").HasValue("2"),
+			check.That(data.ResourceName).Key("service_principals.#").HasValue("2"),
+		),
+	}})
+}
+
 func (ServicePrincipalsDataSource) byDisplayNames(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %[1]s
@@ -207,6 +221,35 @@ data "azuread_service_principals" "test" {
 `, ServicePrincipalResource{}.threeServicePrincipalsABC(data), data.RandomInteger)
 }
 
+func (ServicePrincipalsDataSource) byFilter(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_application" "testA" {
+  display_name = "acctestServicePrincipalA-%[1]d"
+}
+
+resource "azuread_application" "testB" {
+  display_name = "acctestServicePrincipalB-%[1]d"
+}
+
+resource "azuread_service_principal" "testA" {
+  client_id = azuread_application.testA.client_id
+  tags      = ["acctest%[1]d"]
+}
+
+resource "azuread_service_principal" "testB" {
+  client_id = azuread_application.testB.client_id
+  tags      = ["acctest%[1]d"]
+}
+
+data "azuread_service_principals" "test" {
+  filter     = "tags/any(t:t eq 'acctest%[1]d')"
+  depends_on = [azuread_service_principal.testA, azuread_service_principal.testB]
+}
+`, data.RandomInteger)
+}
+
 func (ServicePrincipalsDataSource) noNames() string {
 	return `
 data "azuread_service_principals" "test" {
@@ -29,7 +29,9 @@ func (r Registration) WebsiteCategories() []string {
 
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
-	return map[string]*pluginsdk.Resource{}
+	return map[string]*pluginsdk.Resource{
+		"azuread_synchronization_job": synchronizationJobDataSource(),
+	}
 }
 
 // SupportedResources returns the supported Resources supported by this Service
@@ -37,6 +39,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
 		"azuread_synchronization_job":                     synchronizationJobResource(),
 		"azuread_synchronization_job_provision_on_demand": synchronizationJobProvisionOnDemandResource(),
+		"azuread_synchronization_schema":                  synchronizationSchemaResource(),
 		"azuread_synchronization_secret":                  synchronizationSecretResource(),
 	}
 }
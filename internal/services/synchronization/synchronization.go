@@ -4,14 +4,22 @@
 package synchronization
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"net/http"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/synchronizationjob"
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
 )
 
 const servicePrincipalResourceName = "azuread_service_principal"
@@ -105,6 +113,300 @@ func flattenSynchronizationSchedule(in *stable.SynchronizationSchedule) []map[st
 	}}
 }
 
+func synchronizationTaskExecutionSchema(description string) *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Description: description,
+		Type:        pluginsdk.TypeList,
+		Computed:    true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"state": {
+					Description: "The result of the execution",
+					Type:        pluginsdk.TypeString,
+					Computed:    true,
+				},
+
+				"time_began": {
+					Description: "The time when the execution started",
+					Type:        pluginsdk.TypeString,
+					Computed:    true,
+				},
+
+				"time_ended": {
+					Description: "The time when the execution ended",
+					Type:        pluginsdk.TypeString,
+					Computed:    true,
+				},
+
+				"count_entitled": {
+					Description: "The number of processed entries that were assigned for the application",
+					Type:        pluginsdk.TypeInt,
+					Computed:    true,
+				},
+
+				"count_escrowed": {
+					Description: "The number of entries that were escrowed (errors)",
+					Type:        pluginsdk.TypeInt,
+					Computed:    true,
+				},
+
+				"count_exported": {
+					Description: "The number of exported entries",
+					Type:        pluginsdk.TypeInt,
+					Computed:    true,
+				},
+
+				"count_imported": {
+					Description: "The number of imported entries",
+					Type:        pluginsdk.TypeInt,
+					Computed:    true,
+				},
+
+				"error_code": {
+					Description: "The error code of the error encountered during the execution, if any",
+					Type:        pluginsdk.TypeString,
+					Computed:    true,
+				},
+
+				"error_message": {
+					Description: "The error message of the error encountered during the execution, if any",
+					Type:        pluginsdk.TypeString,
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+func synchronizationJobStatusSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Description: "The status of the synchronization job",
+		Type:        pluginsdk.TypeList,
+		Computed:    true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"code": {
+					Description: "High-level status code of the synchronization job",
+					Type:        pluginsdk.TypeString,
+					Computed:    true,
+				},
+
+				"count_successive_complete_failures": {
+					Description: "The number of consecutive times this job failed",
+					Type:        pluginsdk.TypeInt,
+					Computed:    true,
+				},
+
+				"escrows_pruned": {
+					Description: "Whether the job's escrows (object-level errors) were pruned during initial synchronization",
+					Type:        pluginsdk.TypeBool,
+					Computed:    true,
+				},
+
+				"last_execution": synchronizationTaskExecutionSchema("Details of the last execution of the job"),
+
+				"last_successful_execution": synchronizationTaskExecutionSchema("Details of the last successful execution of the job"),
+
+				"progress": {
+					Description: "Details of the progress of the job toward completion",
+					Type:        pluginsdk.TypeList,
+					Computed:    true,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"completed_units": {
+								Description: "The numerator of the progress ratio",
+								Type:        pluginsdk.TypeInt,
+								Computed:    true,
+							},
+
+							"observation_time": {
+								Description: "The time when the progress was observed",
+								Type:        pluginsdk.TypeString,
+								Computed:    true,
+							},
+
+							"total_units": {
+								Description: "The denominator of the progress ratio",
+								Type:        pluginsdk.TypeInt,
+								Computed:    true,
+							},
+
+							"units": {
+								Description: "A human-readable description of the units",
+								Type:        pluginsdk.TypeString,
+								Computed:    true,
+							},
+						},
+					},
+				},
+
+				"quarantine": {
+					Description: "Details of the quarantine, if the job is currently in quarantine",
+					Type:        pluginsdk.TypeList,
+					Computed:    true,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"reason": {
+								Description: "The reason for the quarantine",
+								Type:        pluginsdk.TypeString,
+								Computed:    true,
+							},
+
+							"current_began": {
+								Description: "The time when the current quarantine began",
+								Type:        pluginsdk.TypeString,
+								Computed:    true,
+							},
+
+							"next_attempt": {
+								Description: "The time when the next attempt to re-evaluate the quarantine will be made",
+								Type:        pluginsdk.TypeString,
+								Computed:    true,
+							},
+
+							"series_began": {
+								Description: "The time when the quarantine was first imposed in this series",
+								Type:        pluginsdk.TypeString,
+								Computed:    true,
+							},
+
+							"series_count": {
+								Description: "The number of times in this series the quarantine was re-evaluated and left in effect",
+								Type:        pluginsdk.TypeInt,
+								Computed:    true,
+							},
+
+							"error_code": {
+								Description: "The error code of the error that caused the quarantine",
+								Type:        pluginsdk.TypeString,
+								Computed:    true,
+							},
+
+							"error_message": {
+								Description: "The error message of the error that caused the quarantine",
+								Type:        pluginsdk.TypeString,
+								Computed:    true,
+							},
+						},
+					},
+				},
+
+				"steady_state_first_achieved_time": {
+					Description: "The time when steady state (no more changes to process) was first achieved",
+					Type:        pluginsdk.TypeString,
+					Computed:    true,
+				},
+
+				"steady_state_last_achieved_time": {
+					Description: "The time when steady state (no more changes to process) was last achieved",
+					Type:        pluginsdk.TypeString,
+					Computed:    true,
+				},
+
+				"synchronized_entry_counts": {
+					Description: "The count of synchronized objects, keyed by object type",
+					Type:        pluginsdk.TypeMap,
+					Computed:    true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeInt,
+					},
+				},
+
+				"troubleshooting_url": {
+					Description: "A link to troubleshooting documentation for the current status",
+					Type:        pluginsdk.TypeString,
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+func flattenSynchronizationTaskExecution(in *stable.SynchronizationTaskExecution) []map[string]interface{} {
+	if in == nil {
+		return []map[string]interface{}{}
+	}
+
+	result := map[string]interface{}{
+		"count_entitled": int(pointer.From(in.CountEntitled)),
+		"count_escrowed": int(pointer.From(in.CountEscrowed)),
+		"count_exported": int(pointer.From(in.CountExported)),
+		"count_imported": int(pointer.From(in.CountImported)),
+		"error_code":     "",
+		"error_message":  "",
+		"state":          pointer.FromEnum(in.State),
+		"time_began":     pointer.From(in.TimeBegan),
+		"time_ended":     pointer.From(in.TimeEnded),
+	}
+
+	if in.Error != nil {
+		result["error_code"] = in.Error.Code.GetOrZero()
+		result["error_message"] = in.Error.Message.GetOrZero()
+	}
+
+	return []map[string]interface{}{result}
+}
+
+func flattenSynchronizationQuarantine(in *stable.SynchronizationQuarantine) []map[string]interface{} {
+	if in == nil {
+		return []map[string]interface{}{}
+	}
+
+	result := map[string]interface{}{
+		"current_began": pointer.From(in.CurrentBegan),
+		"error_code":    "",
+		"error_message": "",
+		"next_attempt":  pointer.From(in.NextAttempt),
+		"reason":        pointer.FromEnum(in.Reason),
+		"series_began":  pointer.From(in.SeriesBegan),
+		"series_count":  int(pointer.From(in.SeriesCount)),
+	}
+
+	if in.Error != nil {
+		result["error_code"] = in.Error.Code.GetOrZero()
+		result["error_message"] = in.Error.Message.GetOrZero()
+	}
+
+	return []map[string]interface{}{result}
+}
+
+func flattenSynchronizationStatus(in *stable.SynchronizationStatus) []map[string]interface{} {
+	if in == nil {
+		return []map[string]interface{}{}
+	}
+
+	progress := make([]map[string]interface{}, 0)
+	for _, item := range pointer.From(in.Progress) {
+		progress = append(progress, map[string]interface{}{
+			"completed_units":  int(pointer.From(item.CompletedUnits)),
+			"observation_time": pointer.From(item.ProgressObservationDateTime),
+			"total_units":      int(pointer.From(item.TotalUnits)),
+			"units":            item.Units.GetOrZero(),
+		})
+	}
+
+	entryCounts := make(map[string]interface{})
+	for _, item := range pointer.From(in.SynchronizedEntryCountByType) {
+		if key := item.Key.GetOrZero(); key != "" {
+			entryCounts[key] = int(pointer.From(item.Value))
+		}
+	}
+
+	return []map[string]interface{}{{
+		"code":                               pointer.FromEnum(in.Code),
+		"count_successive_complete_failures": int(pointer.From(in.CountSuccessiveCompleteFailures)),
+		"escrows_pruned":                     pointer.From(in.EscrowsPruned),
+		"last_execution":                     flattenSynchronizationTaskExecution(in.LastExecution),
+		"last_successful_execution":          flattenSynchronizationTaskExecution(in.LastSuccessfulExecution),
+		"progress":                           progress,
+		"quarantine":                         flattenSynchronizationQuarantine(in.Quarantine),
+		"steady_state_first_achieved_time":   pointer.From(in.SteadyStateFirstAchievedTime),
+		"steady_state_last_achieved_time":    pointer.From(in.SteadyStateLastAchievedTime),
+		"synchronized_entry_counts":          entryCounts,
+		"troubleshooting_url":                in.TroubleshootingUrl.GetOrZero(),
+	}}
+}
+
 func flattenSynchronizationSecretKeyStringValuePair(in *[]stable.SynchronizationSecretKeyStringValuePair, current []interface{}) []interface{} {
 	if in == nil {
 		return []interface{}{}
@@ -146,3 +448,390 @@ func allCredentialsRemoved(in []stable.SynchronizationSecretKeyStringValuePair,
 
 	return true
 }
+
+func findSynchronizationRule(schema stable.SynchronizationSchema, ruleId string) (*stable.SynchronizationRule, error) {
+	rules := pointer.From(schema.SynchronizationRules)
+	if len(rules) == 0 {
+		return nil, fmt.Errorf("no synchronization rules were found in the schema")
+	}
+
+	if ruleId == "" {
+		return &rules[0], nil
+	}
+
+	for i := range rules {
+		if strings.EqualFold(rules[i].Id.GetOrZero(), ruleId) {
+			return &rules[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("synchronization rule %q was not found in the schema", ruleId)
+}
+
+func synchronizationObjectMappingMatches(in stable.ObjectMapping, sourceObjectName, targetObjectName string) bool {
+	return strings.EqualFold(in.SourceObjectName.GetOrZero(), sourceObjectName) && strings.EqualFold(in.TargetObjectName.GetOrZero(), targetObjectName)
+}
+
+// mergeSynchronizationObjectMappings replaces existing object mappings with those specified, retaining any properties
+// not managed by the provider, and appends any object mappings that do not already exist. Object mappings that are not
+// specified are left unchanged.
+func mergeSynchronizationObjectMappings(existing []stable.ObjectMapping, in []stable.ObjectMapping) []stable.ObjectMapping {
+	result := make([]stable.ObjectMapping, len(existing))
+	copy(result, existing)
+
+	for _, objectMapping := range in {
+		found := false
+		for i, existingMapping := range result {
+			if !synchronizationObjectMappingMatches(existingMapping, objectMapping.SourceObjectName.GetOrZero(), objectMapping.TargetObjectName.GetOrZero()) {
+				continue
+			}
+
+			existingMapping.AttributeMappings = objectMapping.AttributeMappings
+			existingMapping.Enabled = objectMapping.Enabled
+			if objectMapping.FlowTypes != nil {
+				existingMapping.FlowTypes = objectMapping.FlowTypes
+			}
+			if existingMapping.Scope == nil {
+				existingMapping.Scope = &stable.Filter{}
+			}
+			existingMapping.Scope.Groups = objectMapping.Scope.Groups
+
+			result[i] = existingMapping
+			found = true
+			break
+		}
+
+		if !found {
+			if objectMapping.FlowTypes == nil {
+				objectMapping.FlowTypes = pointer.To(stable.ObjectFlowTypes(strings.Join([]string{
+					string(stable.ObjectFlowTypes_Add),
+					string(stable.ObjectFlowTypes_Update),
+					string(stable.ObjectFlowTypes_Delete),
+				}, ", ")))
+			}
+			result = append(result, objectMapping)
+		}
+	}
+
+	return result
+}
+
+// removedSynchronizationObjectMappings returns the object mappings in the old configuration which are no longer
+// present in the new configuration, matched using their source and target object names
+func removedSynchronizationObjectMappings(old, new []interface{}) []interface{} {
+	result := make([]interface{}, 0)
+
+	for _, rawOld := range old {
+		if rawOld == nil {
+			continue
+		}
+		oldMapping := rawOld.(map[string]interface{})
+
+		found := false
+		for _, rawNew := range new {
+			if rawNew == nil {
+				continue
+			}
+			newMapping := rawNew.(map[string]interface{})
+			if strings.EqualFold(oldMapping["source_object_name"].(string), newMapping["source_object_name"].(string)) && strings.EqualFold(oldMapping["target_object_name"].(string), newMapping["target_object_name"].(string)) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			result = append(result, oldMapping)
+		}
+	}
+
+	return result
+}
+
+// restoreSynchronizationObjectMappings returns the existing object mappings, with any that match the source and target
+// object names of those specified replaced by the matching default object mapping from the synchronization template.
+// Specified object mappings without a default are removed.
+func restoreSynchronizationObjectMappings(existing []stable.ObjectMapping, defaults []stable.ObjectMapping, in []interface{}) []stable.ObjectMapping {
+	result := make([]stable.ObjectMapping, 0)
+
+	for _, existingMapping := range existing {
+		found := false
+		for _, raw := range in {
+			if raw == nil {
+				continue
+			}
+			item := raw.(map[string]interface{})
+			if synchronizationObjectMappingMatches(existingMapping, item["source_object_name"].(string), item["target_object_name"].(string)) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			result = append(result, existingMapping)
+			continue
+		}
+
+		for _, defaultMapping := range defaults {
+			if synchronizationObjectMappingMatches(defaultMapping, existingMapping.SourceObjectName.GetOrZero(), existingMapping.TargetObjectName.GetOrZero()) {
+				result = append(result, defaultMapping)
+				break
+			}
+		}
+	}
+
+	return result
+}
+
+func expandSynchronizationObjectFlowTypes(in []interface{}) *stable.ObjectFlowTypes {
+	if len(in) == 0 {
+		return nil
+	}
+
+	flowTypes := make([]string, 0)
+	for _, raw := range in {
+		flowTypes = append(flowTypes, raw.(string))
+	}
+	sort.Strings(flowTypes)
+
+	return pointer.To(stable.ObjectFlowTypes(strings.Join(flowTypes, ", ")))
+}
+
+func expandSynchronizationScopingFilters(in []interface{}) *stable.Filter {
+	groups := make([]stable.FilterGroup, 0)
+
+	for _, raw := range in {
+		if raw == nil {
+			continue
+		}
+		item := raw.(map[string]interface{})
+
+		clauses := make([]stable.FilterClause, 0)
+		for _, rawClause := range item["clause"].([]interface{}) {
+			if rawClause == nil {
+				continue
+			}
+			clause := rawClause.(map[string]interface{})
+
+			values := make([]string, 0)
+			for _, value := range clause["values"].([]interface{}) {
+				values = append(values, value.(string))
+			}
+
+			clauses = append(clauses, stable.FilterClause{
+				OperatorName:      nullable.Value(clause["operator"].(string)),
+				SourceOperandName: nullable.Value(clause["source_attribute_name"].(string)),
+				TargetOperand: &stable.FilterOperand{
+					Values: &values,
+				},
+			})
+		}
+
+		groups = append(groups, stable.FilterGroup{
+			Clauses: &clauses,
+			Name:    nullable.NoZero(item["name"].(string)),
+		})
+	}
+
+	return &stable.Filter{
+		Groups: &groups,
+	}
+}
+
+func flattenSynchronizationObjectFlowTypes(in *stable.ObjectFlowTypes) []interface{} {
+	result := make([]interface{}, 0)
+
+	for _, flowType := range strings.Split(string(pointer.From(in)), ",") {
+		if flowType = strings.TrimSpace(flowType); flowType != "" && flowType != string(stable.ObjectFlowTypes_None) {
+			result = append(result, flowType)
+		}
+	}
+
+	return result
+}
+
+func flattenSynchronizationScopingFilters(in *stable.Filter) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0)
+	if in == nil {
+		return result
+	}
+
+	for _, group := range pointer.From(in.Groups) {
+		clauses := make([]map[string]interface{}, 0)
+		for _, clause := range pointer.From(group.Clauses) {
+			values := make([]string, 0)
+			if clause.TargetOperand != nil {
+				values = pointer.From(clause.TargetOperand.Values)
+			}
+
+			clauses = append(clauses, map[string]interface{}{
+				"operator":              clause.OperatorName.GetOrZero(),
+				"source_attribute_name": clause.SourceOperandName.GetOrZero(),
+				"values":                values,
+			})
+		}
+
+		result = append(result, map[string]interface{}{
+			"clause": clauses,
+			"name":   group.Name.GetOrZero(),
+		})
+	}
+
+	return result
+}
+
+func flattenSynchronizationAttributeMapping(in stable.AttributeMapping) map[string]interface{} {
+	result := map[string]interface{}{
+		"constant_value":            "",
+		"default_value":             in.DefaultValue.GetOrZero(),
+		"export_missing_references": pointer.From(in.ExportMissingReferences),
+		"expression":                "",
+		"flow_behavior":             pointer.FromEnum(in.FlowBehavior),
+		"flow_type":                 pointer.FromEnum(in.FlowType),
+		"matching_precedence":       int(pointer.From(in.MatchingPriority)),
+		"source_attribute_name":     "",
+		"target_attribute_name":     in.TargetAttributeName.GetOrZero(),
+	}
+
+	if in.Source != nil {
+		switch pointer.From(in.Source.Type) {
+		case stable.AttributeMappingSourceType_Attribute:
+			result["source_attribute_name"] = in.Source.Name.GetOrZero()
+		case stable.AttributeMappingSourceType_Constant:
+			result["constant_value"] = in.Source.Name.GetOrZero()
+		case stable.AttributeMappingSourceType_Function:
+			result["expression"] = in.Source.Expression.GetOrZero()
+		}
+	}
+
+	return result
+}
+
+// flattenSynchronizationAttributeMappings returns the attribute mappings for an object mapping, ordered to match the
+// configuration so that diffs are stable. Any attribute mappings that are not configured are appended in the order
+// they are returned by the API.
+func flattenSynchronizationAttributeMappings(in *[]stable.AttributeMapping, configured []interface{}) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0)
+	if in == nil {
+		return result
+	}
+
+	used := make(map[int]bool)
+	for _, raw := range configured {
+		if raw == nil {
+			continue
+		}
+		targetAttributeName := raw.(map[string]interface{})["target_attribute_name"].(string)
+
+		for i, attributeMapping := range *in {
+			if !used[i] && strings.EqualFold(attributeMapping.TargetAttributeName.GetOrZero(), targetAttributeName) {
+				result = append(result, flattenSynchronizationAttributeMapping(attributeMapping))
+				used[i] = true
+				break
+			}
+		}
+	}
+
+	for i, attributeMapping := range *in {
+		if !used[i] {
+			result = append(result, flattenSynchronizationAttributeMapping(attributeMapping))
+		}
+	}
+
+	return result
+}
+
+func flattenSynchronizationObjectMapping(in stable.ObjectMapping, configuredAttributeMappings []interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"attribute_mapping":  flattenSynchronizationAttributeMappings(in.AttributeMappings, configuredAttributeMappings),
+		"enabled":            pointer.From(in.Enabled),
+		"flow_types":         flattenSynchronizationObjectFlowTypes(in.FlowTypes),
+		"scoping_filter":     flattenSynchronizationScopingFilters(in.Scope),
+		"source_object_name": in.SourceObjectName.GetOrZero(),
+		"target_object_name": in.TargetObjectName.GetOrZero(),
+	}
+}
+
+// flattenSynchronizationObjectMappings returns the object mappings for a synchronization rule, limited to those which
+// are specified in the configuration. When nothing is configured, such as during import, all object mappings are returned.
+func flattenSynchronizationObjectMappings(in *[]stable.ObjectMapping, configured []interface{}) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0)
+	if in == nil {
+		return result
+	}
+
+	if len(configured) == 0 {
+		for _, objectMapping := range *in {
+			result = append(result, flattenSynchronizationObjectMapping(objectMapping, nil))
+		}
+		return result
+	}
+
+	for _, raw := range configured {
+		if raw == nil {
+			continue
+		}
+		item := raw.(map[string]interface{})
+
+		for _, objectMapping := range *in {
+			if synchronizationObjectMappingMatches(objectMapping, item["source_object_name"].(string), item["target_object_name"].(string)) {
+				result = append(result, flattenSynchronizationObjectMapping(objectMapping, item["attribute_mapping"].([]interface{})))
+				break
+			}
+		}
+	}
+
+	return result
+}
+
+// waitForSynchronizationJobInitialCycle polls a synchronization job until its first synchronization cycle has completed,
+// returning an error if the job is placed into quarantine or the cycle fails
+func waitForSynchronizationJobInitialCycle(ctx context.Context, client *synchronizationjob.SynchronizationJobClient, id stable.ServicePrincipalIdSynchronizationJobId) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return errors.New("context has no deadline")
+	}
+
+	_, err := (&pluginsdk.StateChangeConf{ //nolint:staticcheck
+		Pending:    []string{"Waiting"},
+		Target:     []string{"Completed"},
+		Timeout:    time.Until(deadline),
+		MinTimeout: 30 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.GetSynchronizationJob(ctx, id, synchronizationjob.GetSynchronizationJobOperationOptions{RetryFunc: synchronizationRetryFunc()})
+			if err != nil {
+				return nil, "Error", err
+			}
+			if resp.Model == nil || resp.Model.Status == nil {
+				return "stub", "Waiting", nil
+			}
+			status := resp.Model.Status
+
+			if pointer.From(status.Code) == stable.SynchronizationStatusCode_Quarantine && status.Quarantine != nil {
+				return nil, "Error", synchronizationQuarantineError(*status.Quarantine)
+			}
+
+			if execution := status.LastExecution; execution != nil && pointer.From(execution.TimeEnded) != "" {
+				if pointer.From(execution.State) == stable.SynchronizationTaskExecutionResult_Failed {
+					message := "the synchronization cycle failed"
+					if execution.Error != nil {
+						message = fmt.Sprintf("%s: %s: %s", message, execution.Error.Code.GetOrZero(), execution.Error.Message.GetOrZero())
+					}
+					return nil, "Error", errors.New(message)
+				}
+				return "stub", "Completed", nil
+			}
+
+			return "stub", "Waiting", nil
+		},
+	}).WaitForStateContext(ctx)
+
+	return err
+}
+
+func synchronizationQuarantineError(in stable.SynchronizationQuarantine) error {
+	message := fmt.Sprintf("the synchronization job was placed into quarantine (reason: %s)", pointer.FromEnum(in.Reason))
+	if in.Error != nil {
+		message = fmt.Sprintf("%s: %s: %s", message, in.Error.Code.GetOrZero(), in.Error.Message.GetOrZero())
+	}
+	return errors.New(message)
+}
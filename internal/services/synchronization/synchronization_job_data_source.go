@@ -0,0 +1,161 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package synchronization
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/synchronizationjob"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+)
+
+func synchronizationJobDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		ReadContext: synchronizationJobDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"service_principal_id": {
+				Description:  "The ID of the service principal for which the synchronization job exists",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: stable.ValidateServicePrincipalID,
+			},
+
+			"job_id": {
+				Description:  "The ID of the synchronization job",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"job_id", "template_id"},
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"template_id": {
+				Description:  "Identifier of the synchronization template the job is based on",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"job_id", "template_id"},
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"enabled": {
+				Description: "Whether the synchronization job is enabled",
+				Type:        pluginsdk.TypeBool,
+				Computed:    true,
+			},
+
+			"schedule": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"expiration": {
+							Description: "Date and time when this job will expire, formatted as an RFC3339 date string (e.g. `2018-01-01T01:02:03Z`).",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"interval": {
+							Description: "The interval between synchronization iterations ISO8601. E.g. PT40M run every 40 minutes.",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"state": {
+							Description: "State.",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+
+			"status": synchronizationJobStatusSchema(),
+		},
+	}
+}
+
+func synchronizationJobDataSourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Synchronization.SynchronizationJobClient
+
+	servicePrincipalId, err := stable.ParseServicePrincipalID(d.Get("service_principal_id").(string))
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "service_principal_id", "Parsing `service_principal_id`")
+	}
+
+	var synchronizationJob *stable.SynchronizationJob
+
+	if jobId := d.Get("job_id").(string); jobId != "" {
+		id := stable.NewServicePrincipalIdSynchronizationJobID(servicePrincipalId.ServicePrincipalId, jobId)
+
+		resp, err := client.GetSynchronizationJob(ctx, id, synchronizationjob.GetSynchronizationJobOperationOptions{RetryFunc: synchronizationRetryFunc()})
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return tf.ErrorDiagPathF(nil, "job_id", "%s was not found", id)
+			}
+			return tf.ErrorDiagF(err, "Retrieving %s", id)
+		}
+		synchronizationJob = resp.Model
+
+	} else {
+		templateId := d.Get("template_id").(string)
+
+		resp, err := client.ListSynchronizationJobs(ctx, *servicePrincipalId, synchronizationjob.ListSynchronizationJobsOperationOptions{RetryFunc: synchronizationRetryFunc()})
+		if err != nil {
+			return tf.ErrorDiagF(err, "Listing synchronization jobs for %s", servicePrincipalId)
+		}
+
+		for _, job := range pointer.From(resp.Model) {
+			if strings.EqualFold(job.TemplateId.GetOrZero(), templateId) {
+				if synchronizationJob != nil {
+					return tf.ErrorDiagPathF(fmt.Errorf("more than one synchronization job found with template ID %q", templateId), "template_id", "Unexpected number of results")
+				}
+				synchronizationJob = pointer.To(job)
+			}
+		}
+
+		if synchronizationJob == nil {
+			return tf.ErrorDiagPathF(nil, "template_id", "No synchronization job found with template ID %q for %s", templateId, servicePrincipalId)
+		}
+	}
+
+	if synchronizationJob == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving synchronization job for %s", servicePrincipalId)
+	}
+	if synchronizationJob.Id == nil {
+		return tf.ErrorDiagF(errors.New("model has nil ID"), "Retrieving synchronization job for %s", servicePrincipalId)
+	}
+
+	id := stable.NewServicePrincipalIdSynchronizationJobID(servicePrincipalId.ServicePrincipalId, *synchronizationJob.Id)
+	d.SetId(id.ID())
+
+	tf.Set(d, "job_id", id.SynchronizationJobId)
+	tf.Set(d, "service_principal_id", servicePrincipalId.ID())
+	tf.Set(d, "template_id", synchronizationJob.TemplateId.GetOrZero())
+	tf.Set(d, "schedule", flattenSynchronizationSchedule(synchronizationJob.Schedule))
+	tf.Set(d, "status", flattenSynchronizationStatus(synchronizationJob.Status))
+
+	enabled := false
+	if synchronizationJob.Schedule != nil {
+		enabled = pointer.From(synchronizationJob.Schedule.State) == stable.SynchronizationScheduleState_Active
+	}
+	tf.Set(d, "enabled", enabled)
+
+	return nil
+}
@@ -0,0 +1,79 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package synchronization_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type SynchronizationJobDataSource struct{}
+
+func TestAccSynchronizationJobDataSource(t *testing.T) {
+	acceptance.RunTestsInSequence(t, map[string]map[string]func(t *testing.T){
+		"synchronizationJobDataSource": {
+			"byJobId":      testAccSynchronizationJobDataSource_byJobId,
+			"byTemplateId": testAccSynchronizationJobDataSource_byTemplateId,
+		},
+	})
+}
+
+func testAccSynchronizationJobDataSource_byJobId(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_synchronization_job", "test")
+	r := SynchronizationJobDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.byJobId(data),
+			Check:  r.testCheckFunc(data),
+		},
+	})
+}
+
+func testAccSynchronizationJobDataSource_byTemplateId(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_synchronization_job", "test")
+	r := SynchronizationJobDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.byTemplateId(data),
+			Check:  r.testCheckFunc(data),
+		},
+	})
+}
+
+func (SynchronizationJobDataSource) testCheckFunc(data acceptance.TestData) acceptance.TestCheckFunc {
+	return acceptance.ComposeTestCheckFunc(
+		check.That(data.ResourceName).Key("job_id").Exists(),
+		check.That(data.ResourceName).Key("template_id").HasValue("dataBricks"),
+		check.That(data.ResourceName).Key("enabled").HasValue("false"),
+		check.That(data.ResourceName).Key("status.#").HasValue("1"),
+		check.That(data.ResourceName).Key("status.0.code").Exists(),
+	)
+}
+
+func (SynchronizationJobDataSource) byJobId(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_synchronization_job" "test" {
+  service_principal_id = azuread_synchronization_job.test.service_principal_id
+  job_id               = split("/", azuread_synchronization_job.test.id)[4]
+}
+`, SynchronizationJobResource{}.disabled(data))
+}
+
+func (SynchronizationJobDataSource) byTemplateId(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_synchronization_job" "test" {
+  service_principal_id = azuread_synchronization_job.test.service_principal_id
+  template_id          = azuread_synchronization_job.test.template_id
+}
+`, SynchronizationJobResource{}.disabled(data))
+}
@@ -5,7 +5,12 @@ package synchronization
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
@@ -98,10 +103,114 @@ func synchronizationJobProvisionOnDemandResource() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+
+			"fail_on_error": {
+				Description: "Whether to return an error when any of the provisioning steps fail",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+			},
+
+			"provisioning_step": {
+				Description: "The steps taken to provision the objects on demand",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description: "The name of the provisioning step",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+
+						"type": {
+							Description: "The type of the provisioning step, e.g. `Import`, `Scoping`, `Matching`, `Processing` or `Export`",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+
+						"status": {
+							Description: "The status of the provisioning step, e.g. `Success`, `Warning`, `Failure` or `Skipped`",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+
+						"description": {
+							Description: "A summary of what occurred during the provisioning step",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+
+						"timestamp": {
+							Description: "The time when the provisioning step occurred",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+
+						"details": {
+							Description: "Details of what occurred during the provisioning step",
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+
+			"modified_property": {
+				Description: "The properties of the target object that were modified",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description: "The name of the modified property",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+
+						"old_value": {
+							Description: "The value of the property before provisioning",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+
+						"new_value": {
+							Description: "The value of the property after provisioning",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+// provisionOnDemandResult is the result of provisioning an object on demand, which the API returns as a JSON-encoded string
+type provisionOnDemandResult struct {
+	ProvisioningSteps  []provisionOnDemandStep             `json:"provisioningSteps"`
+	ModifiedProperties []provisionOnDemandModifiedProperty `json:"modifiedProperties"`
+}
+
+type provisionOnDemandStep struct {
+	Name        string                 `json:"name"`
+	Type        string                 `json:"type"`
+	Status      string                 `json:"status"`
+	Description string                 `json:"description"`
+	Timestamp   string                 `json:"timestamp"`
+	Details     map[string]interface{} `json:"details"`
+}
+
+type provisionOnDemandModifiedProperty struct {
+	DisplayName string      `json:"displayName"`
+	OldValue    interface{} `json:"oldValue"`
+	NewValue    interface{} `json:"newValue"`
+}
+
 func synchronizationProvisionOnDemandResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*clients.Client).ServicePrincipals.SynchronizationJobClient
 	servicePrincipalClient := meta.(*clients.Client).ServicePrincipals.ServicePrincipalClient
@@ -155,13 +264,35 @@ func synchronizationProvisionOnDemandResourceCreate(ctx context.Context, d *sche
 		Parameters: expandSynchronizationJobApplicationParameters(d.Get("parameter").([]interface{})),
 	}
 
-	if _, err = client.ProvisionSynchronizationJobOnDemand(ctx, *jobId, properties, synchronizationjob.DefaultProvisionSynchronizationJobOnDemandOperationOptions()); err != nil {
+	resp, err := client.ProvisionSynchronizationJobOnDemand(ctx, *jobId, properties, synchronizationjob.DefaultProvisionSynchronizationJobOnDemandOperationOptions())
+	if err != nil {
 		return tf.ErrorDiagF(err, "Provisioning %s", jobId)
 	}
 
+	failOnError := d.Get("fail_on_error").(bool)
+
+	results := make([]provisionOnDemandResult, 0)
+	if resp.Model != nil {
+		if results, err = parseProvisionOnDemandResults(resp.Model.Value.GetOrZero()); err != nil {
+			if failOnError {
+				return tf.ErrorDiagF(err, "Parsing results of provisioning %s", jobId)
+			}
+			log.Printf("[WARN] Unable to parse results of provisioning %s: %v", jobId, err)
+		}
+	}
+
+	if failOnError {
+		if diags := provisionOnDemandFailedStepDiagnostics(results); diags.HasError() {
+			return diags
+		}
+	}
+
 	id, _ := uuid.GenerateUUID()
 	d.SetId(id)
 
+	tf.Set(d, "provisioning_step", flattenProvisionOnDemandSteps(results))
+	tf.Set(d, "modified_property", flattenProvisionOnDemandModifiedProperties(results))
+
 	return synchronizationProvisionOnDemandResourceRead(ctx, d, meta)
 }
 
@@ -174,3 +305,111 @@ func synchronizationProvisionOnDemandResourceDelete(_ context.Context, _ *schema
 	// Nothing to destroy
 	return nil
 }
+
+// parseProvisionOnDemandResults decodes the provisioning results, which are returned as either a single JSON-encoded
+// object, or an array of objects when more than one object was provisioned
+func parseProvisionOnDemandResults(in string) ([]provisionOnDemandResult, error) {
+	results := make([]provisionOnDemandResult, 0)
+
+	in = strings.TrimSpace(in)
+	if in == "" {
+		return results, nil
+	}
+
+	if strings.HasPrefix(in, "[") {
+		if err := json.Unmarshal([]byte(in), &results); err != nil {
+			return nil, fmt.Errorf("unmarshaling provisioning results: %v", err)
+		}
+		return results, nil
+	}
+
+	var result provisionOnDemandResult
+	if err := json.Unmarshal([]byte(in), &result); err != nil {
+		return nil, fmt.Errorf("unmarshaling provisioning result: %v", err)
+	}
+
+	return append(results, result), nil
+}
+
+func provisionOnDemandValueString(in interface{}) string {
+	switch v := in.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	default:
+		if b, err := json.Marshal(v); err == nil {
+			return string(b)
+		}
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+func provisionOnDemandFailedStepDiagnostics(in []provisionOnDemandResult) (diags diag.Diagnostics) {
+	for _, result := range in {
+		for _, step := range result.ProvisioningSteps {
+			if !strings.EqualFold(step.Status, "Failure") {
+				continue
+			}
+
+			keys := make([]string, 0, len(step.Details))
+			for key := range step.Details {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+
+			detail := step.Description
+			for _, key := range keys {
+				detail += fmt.Sprintf("\n  %s: %s", key, provisionOnDemandValueString(step.Details[key]))
+			}
+
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Provisioning step %q (%s) failed", step.Name, step.Type),
+				Detail:   detail,
+			})
+		}
+	}
+
+	return
+}
+
+func flattenProvisionOnDemandSteps(in []provisionOnDemandResult) []map[string]interface{} {
+	steps := make([]map[string]interface{}, 0)
+
+	for _, result := range in {
+		for _, step := range result.ProvisioningSteps {
+			details := make(map[string]interface{})
+			for key, value := range step.Details {
+				details[key] = provisionOnDemandValueString(value)
+			}
+
+			steps = append(steps, map[string]interface{}{
+				"description": step.Description,
+				"details":     details,
+				"name":        step.Name,
+				"status":      step.Status,
+				"timestamp":   step.Timestamp,
+				"type":        step.Type,
+			})
+		}
+	}
+
+	return steps
+}
+
+func flattenProvisionOnDemandModifiedProperties(in []provisionOnDemandResult) []map[string]interface{} {
+	properties := make([]map[string]interface{}, 0)
+
+	for _, result := range in {
+		for _, property := range result.ModifiedProperties {
+			properties = append(properties, map[string]interface{}{
+				"name":      property.DisplayName,
+				"new_value": provisionOnDemandValueString(property.NewValue),
+				"old_value": provisionOnDemandValueString(property.OldValue),
+			})
+		}
+	}
+
+	return properties
+}
@@ -30,6 +30,19 @@ func TestAccSynchronizationJobProvisionOnDemand_basic(t *testing.T) {
 	})
 }
 
+func TestAccSynchronizationJobProvisionOnDemand_failOnError(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_synchronization_job_provision_on_demand", "test")
+	r := SynchronizationJobProvisionOnDemandResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			// The provisioned app isn't actually integrated so this will never work
+			Config:      r.failOnError(data),
+			ExpectError: regexp.MustCompile("CredentialsMissing|Provisioning step"),
+		},
+	})
+}
+
 func (r SynchronizationJobProvisionOnDemandResource) Exists(_ context.Context, _ *clients.Client, _ *terraform.InstanceState) (*bool, error) {
 	// Nothing to read
 	return pointer.To(true), nil
@@ -87,3 +100,24 @@ resource "azuread_synchronization_job_provision_on_demand" "test" {
 
 `, r.template(data))
 }
+
+func (r SynchronizationJobProvisionOnDemandResource) failOnError(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_synchronization_job_provision_on_demand" "test" {
+  service_principal_id   = azuread_synchronization_job.test.service_principal_id
+  synchronization_job_id = azuread_synchronization_job.test.id
+  fail_on_error          = true
+
+  parameter {
+    rule_id = "03f7d90d-bf71-41b1-bda6-aaf0ddbee5d8"
+
+    subject {
+      object_id        = azuread_group.test.id
+      object_type_name = "Group"
+    }
+  }
+}
+`, r.template(data))
+}
@@ -30,6 +30,8 @@ func synchronizationJobResource() *pluginsdk.Resource {
 		UpdateContext: synchronizationJobResourceUpdate,
 		DeleteContext: synchronizationJobResourceDelete,
 
+		CustomizeDiff: synchronizationJobResourceCustomizeDiff,
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(15 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -80,6 +82,13 @@ func synchronizationJobResource() *pluginsdk.Resource {
 				Optional:    true,
 			},
 
+			"wait_for_initial_cycle": {
+				Description: "Whether to wait for the first synchronization cycle to complete when creating the synchronization job",
+				Type:        pluginsdk.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+
 			"schedule": {
 				Type:     pluginsdk.TypeList,
 				Computed: true,
@@ -105,10 +114,20 @@ func synchronizationJobResource() *pluginsdk.Resource {
 					},
 				},
 			},
+
+			"status": synchronizationJobStatusSchema(),
 		},
 	}
 }
 
+func synchronizationJobResourceCustomizeDiff(_ context.Context, diff *pluginsdk.ResourceDiff, _ interface{}) error {
+	if diff.Id() == "" && diff.Get("wait_for_initial_cycle").(bool) && !diff.Get("enabled").(bool) {
+		return errors.New("`wait_for_initial_cycle` can only be specified when `enabled` is true")
+	}
+
+	return nil
+}
+
 func synchronizationJobResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
 	client := meta.(*clients.Client).Synchronization.SynchronizationJobClient
 	servicePrincipalClient := meta.(*clients.Client).Synchronization.ServicePrincipalClient
@@ -172,6 +191,12 @@ func synchronizationJobResourceCreate(ctx context.Context, d *pluginsdk.Resource
 		if _, err = client.StartSynchronizationJob(ctx, id, synchronizationjob.StartSynchronizationJobOperationOptions{RetryFunc: synchronizationRetryFunc()}); err != nil {
 			return tf.ErrorDiagF(err, "Starting %s", id)
 		}
+
+		if d.Get("wait_for_initial_cycle").(bool) {
+			if err = waitForSynchronizationJobInitialCycle(ctx, client, id); err != nil {
+				return tf.ErrorDiagF(err, "Waiting for initial synchronization cycle of %s", id)
+			}
+		}
 	}
 
 	return synchronizationJobResourceRead(ctx, d, meta)
@@ -206,6 +231,7 @@ func synchronizationJobResourceRead(ctx context.Context, d *pluginsdk.ResourceDa
 	tf.Set(d, "schedule", flattenSynchronizationSchedule(synchronizationJob.Schedule))
 	tf.Set(d, "template_id", synchronizationJob.TemplateId.GetOrZero())
 	tf.Set(d, "enabled", pointer.From(synchronizationJob.Schedule.State) == stable.SynchronizationScheduleState_Active)
+	tf.Set(d, "status", flattenSynchronizationStatus(synchronizationJob.Status))
 	return nil
 }
 
@@ -6,6 +6,7 @@ package synchronization_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
@@ -23,8 +24,9 @@ type SynchronizationJobResource struct{}
 func TestAccSynchronizationJob(t *testing.T) {
 	acceptance.RunTestsInSequence(t, map[string]map[string]func(t *testing.T){
 		"synchronizationJob": {
-			"basic":    testAccSynchronizationJob_basic,
-			"disabled": testAccSynchronizationJob_disabled,
+			"basic":               testAccSynchronizationJob_basic,
+			"disabled":            testAccSynchronizationJob_disabled,
+			"waitForInitialCycle": testAccSynchronizationJob_waitForInitialCycle,
 		},
 	})
 }
@@ -42,7 +44,7 @@ func testAccSynchronizationJob_basic(t *testing.T) {
 				check.That(data.ResourceName).Key("enabled").HasValue("true"),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStep("status", "wait_for_initial_cycle"),
 	})
 }
 
@@ -59,7 +61,20 @@ func testAccSynchronizationJob_disabled(t *testing.T) {
 				check.That(data.ResourceName).Key("enabled").HasValue("false"),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStep("status", "wait_for_initial_cycle"),
+	})
+}
+
+func testAccSynchronizationJob_waitForInitialCycle(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_synchronization_job", "test")
+	r := SynchronizationJobResource{}
+
+	// The target application is not reachable with these credentials, so the job is expected to be quarantined
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.waitForInitialCycle(data),
+			ExpectError: regexp.MustCompile("placed into quarantine|synchronization cycle failed"),
+		},
 	})
 }
 
@@ -125,3 +140,30 @@ resource "azuread_synchronization_job" "test" {
 }
 `, r.template(data))
 }
+
+func (r SynchronizationJobResource) waitForInitialCycle(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_synchronization_secret" "test" {
+  service_principal_id = data.azuread_service_principal.test.id
+
+  credential {
+    key   = "BaseAddress"
+    value = "https://adb-0000000000000000.0.azuredatabricks.net/api/2.0/preview/scim"
+  }
+  credential {
+    key   = "SecretToken"
+    value = "some-token"
+  }
+}
+
+resource "azuread_synchronization_job" "test" {
+  service_principal_id   = data.azuread_service_principal.test.id
+  template_id            = "dataBricks"
+  wait_for_initial_cycle = true
+
+  depends_on = [azuread_synchronization_secret.test]
+}
+`, r.template(data))
+}
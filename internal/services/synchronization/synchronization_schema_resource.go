@@ -0,0 +1,610 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package synchronization
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/synchronizationjob"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/synchronizationjobschema"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/synchronizationtemplateschema"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/synchronization/validate"
+)
+
+func synchronizationSchemaResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: synchronizationSchemaResourceCreate,
+		ReadContext:   synchronizationSchemaResourceRead,
+		UpdateContext: synchronizationSchemaResourceUpdate,
+		DeleteContext: synchronizationSchemaResourceDelete,
+
+		CustomizeDiff: synchronizationSchemaResourceCustomizeDiff,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(10 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(10 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			if _, errs := stable.ValidateServicePrincipalIdSynchronizationJobID(id, "id"); len(errs) > 0 {
+				out := ""
+				for _, err := range errs {
+					out += err.Error()
+				}
+				return errors.New(out)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"synchronization_job_id": {
+				Description:  "The ID of the synchronization job for which the schema should be managed",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: stable.ValidateServicePrincipalIdSynchronizationJobID,
+			},
+
+			"rule_id": {
+				Description:  "The ID of the synchronization rule containing the object mappings. Defaults to the first rule in the schema",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"object_mapping": {
+				Description: "Object mappings for the synchronization rule",
+				Type:        pluginsdk.TypeList,
+				Required:    true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"source_object_name": {
+							Description:  "The name of the object in the source directory",
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"target_object_name": {
+							Description:  "The name of the object in the target directory",
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"enabled": {
+							Description: "Whether this object mapping is enabled",
+							Type:        pluginsdk.TypeBool,
+							Optional:    true,
+							Default:     true,
+						},
+
+						"flow_types": {
+							Description: "The types of change that should be synchronized for this object",
+							Type:        pluginsdk.TypeSet,
+							Optional:    true,
+							Computed:    true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									string(stable.ObjectFlowTypes_Add),
+									string(stable.ObjectFlowTypes_Delete),
+									string(stable.ObjectFlowTypes_Update),
+								}, false),
+							},
+						},
+
+						"scoping_filter": {
+							Description: "Scoping filters determining which objects are in scope for synchronization. An object is in scope when it satisfies any one of the filters",
+							Type:        pluginsdk.TypeList,
+							Optional:    true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Description: "The name of the scoping filter",
+										Type:        pluginsdk.TypeString,
+										Optional:    true,
+									},
+
+									"clause": {
+										Description: "Clauses for the scoping filter, all of which must be satisfied",
+										Type:        pluginsdk.TypeList,
+										Required:    true,
+										Elem: &pluginsdk.Resource{
+											Schema: map[string]*pluginsdk.Schema{
+												"source_attribute_name": {
+													Description:  "The name of the source attribute to evaluate",
+													Type:         pluginsdk.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringIsNotEmpty,
+												},
+
+												"operator": {
+													Description:  "The operator used to evaluate the source attribute, e.g. `EQUALS` or `REGEX MATCH`",
+													Type:         pluginsdk.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringIsNotEmpty,
+												},
+
+												"values": {
+													Description: "The values with which the source attribute is compared",
+													Type:        pluginsdk.TypeList,
+													Optional:    true,
+													Elem: &pluginsdk.Schema{
+														Type: pluginsdk.TypeString,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+
+						"attribute_mapping": {
+							Description: "Attribute mappings for the object",
+							Type:        pluginsdk.TypeList,
+							Required:    true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"target_attribute_name": {
+										Description:  "The name of the attribute in the target object",
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"source_attribute_name": {
+										Description: "The name of the attribute in the source object, for a direct mapping",
+										Type:        pluginsdk.TypeString,
+										Optional:    true,
+									},
+
+									"constant_value": {
+										Description: "A constant value for the target attribute, for a constant mapping",
+										Type:        pluginsdk.TypeString,
+										Optional:    true,
+									},
+
+									"expression": {
+										Description:      "An expression used to derive the target attribute, for an expression mapping",
+										Type:             pluginsdk.TypeString,
+										Optional:         true,
+										ValidateDiagFunc: validate.SynchronizationExpression,
+									},
+
+									"default_value": {
+										Description: "The value to use when the source attribute is empty",
+										Type:        pluginsdk.TypeString,
+										Optional:    true,
+									},
+
+									"flow_behavior": {
+										Description:  "When the target attribute should be updated",
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										Default:      string(stable.AttributeFlowBehavior_FlowWhenChanged),
+										ValidateFunc: validation.StringInSlice(stable.PossibleValuesForAttributeFlowBehavior(), false),
+									},
+
+									"flow_type": {
+										Description:  "Under which conditions the target attribute should be updated",
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										Default:      string(stable.AttributeFlowType_Always),
+										ValidateFunc: validation.StringInSlice(stable.PossibleValuesForAttributeFlowType(), false),
+									},
+
+									"matching_precedence": {
+										Description:  "The precedence of this attribute when matching objects between the source and target. A value of `0` indicates this attribute is not used for matching",
+										Type:         pluginsdk.TypeInt,
+										Optional:     true,
+										Default:      0,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+
+									"export_missing_references": {
+										Description: "Whether references to objects that do not exist in the target should be exported",
+										Type:        pluginsdk.TypeBool,
+										Optional:    true,
+										Default:     false,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func synchronizationSchemaResourceCustomizeDiff(_ context.Context, diff *pluginsdk.ResourceDiff, _ interface{}) error {
+	objectMappings := make(map[string]bool)
+
+	for i, rawObjectMapping := range diff.Get("object_mapping").([]interface{}) {
+		if rawObjectMapping == nil {
+			continue
+		}
+		objectMapping := rawObjectMapping.(map[string]interface{})
+
+		objectMappingKey := strings.ToLower(fmt.Sprintf("%s/%s", objectMapping["source_object_name"], objectMapping["target_object_name"]))
+		if objectMappings[objectMappingKey] {
+			return fmt.Errorf("duplicate object mapping from %q to %q", objectMapping["source_object_name"], objectMapping["target_object_name"])
+		}
+		objectMappings[objectMappingKey] = true
+
+		targetAttributes := make(map[string]bool)
+		matchingPrecedences := make(map[int]bool)
+
+		for j, rawAttributeMapping := range objectMapping["attribute_mapping"].([]interface{}) {
+			if rawAttributeMapping == nil {
+				continue
+			}
+			attributeMapping := rawAttributeMapping.(map[string]interface{})
+			targetAttributeName := attributeMapping["target_attribute_name"].(string)
+
+			if targetAttributes[strings.ToLower(targetAttributeName)] {
+				return fmt.Errorf("duplicate attribute mapping for target attribute %q in object mapping from %q to %q", targetAttributeName, objectMapping["source_object_name"], objectMapping["target_object_name"])
+			}
+			targetAttributes[strings.ToLower(targetAttributeName)] = true
+
+			if precedence := attributeMapping["matching_precedence"].(int); precedence > 0 {
+				if matchingPrecedences[precedence] {
+					return fmt.Errorf("duplicate `matching_precedence` %d for target attribute %q in object mapping from %q to %q", precedence, targetAttributeName, objectMapping["source_object_name"], objectMapping["target_object_name"])
+				}
+				matchingPrecedences[precedence] = true
+			}
+
+			sources := 0
+			for _, field := range []string{"source_attribute_name", "constant_value", "expression"} {
+				if !diff.NewValueKnown(fmt.Sprintf("object_mapping.%d.attribute_mapping.%d.%s", i, j, field)) {
+					// Value is not yet known, so assume it will be set
+					sources++
+					continue
+				}
+				if attributeMapping[field].(string) != "" {
+					sources++
+				}
+			}
+			if sources != 1 {
+				return fmt.Errorf("exactly one of `source_attribute_name`, `constant_value` or `expression` must be specified for target attribute %q", targetAttributeName)
+			}
+		}
+	}
+
+	return nil
+}
+
+func synchronizationSchemaResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Synchronization.SynchronizationJobSchemaClient
+
+	id, err := stable.ParseServicePrincipalIdSynchronizationJobID(d.Get("synchronization_job_id").(string))
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "synchronization_job_id", "Parsing `synchronization_job_id`")
+	}
+
+	tf.LockByName(servicePrincipalResourceName, id.ServicePrincipalId)
+	defer tf.UnlockByName(servicePrincipalResourceName, id.ServicePrincipalId)
+
+	resp, err := client.GetSynchronizationJobSchema(ctx, *id, synchronizationjobschema.GetSynchronizationJobSchemaOperationOptions{RetryFunc: synchronizationRetryFunc()})
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return tf.ErrorDiagPathF(nil, "synchronization_job_id", "%s was not found", id)
+		}
+		return tf.ErrorDiagF(err, "Retrieving synchronization schema for %s", id)
+	}
+	if resp.Model == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving synchronization schema for %s", id)
+	}
+
+	if diags := synchronizationSchemaApply(ctx, d, meta, *id, *resp.Model); diags.HasError() {
+		return diags
+	}
+
+	d.SetId(id.ID())
+
+	return synchronizationSchemaResourceRead(ctx, d, meta)
+}
+
+func synchronizationSchemaResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Synchronization.SynchronizationJobSchemaClient
+
+	id, err := stable.ParseServicePrincipalIdSynchronizationJobID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing synchronization job ID %q", d.Id())
+	}
+
+	tf.LockByName(servicePrincipalResourceName, id.ServicePrincipalId)
+	defer tf.UnlockByName(servicePrincipalResourceName, id.ServicePrincipalId)
+
+	resp, err := client.GetSynchronizationJobSchema(ctx, *id, synchronizationjobschema.GetSynchronizationJobSchemaOperationOptions{RetryFunc: synchronizationRetryFunc()})
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving synchronization schema for %s", id)
+	}
+	if resp.Model == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving synchronization schema for %s", id)
+	}
+
+	if diags := synchronizationSchemaApply(ctx, d, meta, *id, *resp.Model); diags.HasError() {
+		return diags
+	}
+
+	return synchronizationSchemaResourceRead(ctx, d, meta)
+}
+
+// synchronizationSchemaApply merges the configured object mappings into the existing schema for the synchronization
+// job, and replaces the schema. The API only supports replacing the entire schema, which includes the directory
+// definitions and any object mappings that are not managed by this resource. Object mappings that have been removed
+// from the configuration are restored to their defaults from the synchronization template.
+func synchronizationSchemaApply(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}, id stable.ServicePrincipalIdSynchronizationJobId, schema stable.SynchronizationSchema) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Synchronization.SynchronizationJobSchemaClient
+
+	rule, err := findSynchronizationRule(schema, d.Get("rule_id").(string))
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "rule_id", "Retrieving synchronization rule for %s", id)
+	}
+
+	objectMappings, err := expandSynchronizationObjectMappings(ctx, client, id, d.Get("object_mapping").([]interface{}))
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "object_mapping", "Expanding object mappings for %s", id)
+	}
+
+	existingMappings := pointer.From(rule.ObjectMappings)
+
+	oldObjectMappings, newObjectMappings := d.GetChange("object_mapping")
+	if removed := removedSynchronizationObjectMappings(oldObjectMappings.([]interface{}), newObjectMappings.([]interface{})); len(removed) > 0 {
+		templateMappings, err := synchronizationTemplateObjectMappings(ctx, meta, id, rule.Id.GetOrZero())
+		if err != nil {
+			return tf.ErrorDiagF(err, "Retrieving default object mappings for %s", id)
+		}
+		existingMappings = restoreSynchronizationObjectMappings(existingMappings, templateMappings, removed)
+	}
+
+	// The rule is a reference into the schema, so this updates the schema in place
+	rule.ObjectMappings = pointer.To(mergeSynchronizationObjectMappings(existingMappings, objectMappings))
+
+	if _, err = client.UpdateSynchronizationJobSchema(ctx, id, schema, synchronizationjobschema.UpdateSynchronizationJobSchemaOperationOptions{RetryFunc: synchronizationRetryFunc()}); err != nil {
+		return tf.ErrorDiagF(err, "Updating synchronization schema for %s", id)
+	}
+
+	return nil
+}
+
+// synchronizationTemplateObjectMappings returns the default object mappings for the specified rule, from the schema of
+// the synchronization template on which the job is based. No object mappings are returned when the job is not based on
+// a template, or when the template does not include the rule.
+func synchronizationTemplateObjectMappings(ctx context.Context, meta interface{}, id stable.ServicePrincipalIdSynchronizationJobId, ruleId string) ([]stable.ObjectMapping, error) {
+	jobClient := meta.(*clients.Client).Synchronization.SynchronizationJobClient
+	templateSchemaClient := meta.(*clients.Client).Synchronization.SynchronizationTemplateSchemaClient
+
+	jobResp, err := jobClient.GetSynchronizationJob(ctx, id, synchronizationjob.GetSynchronizationJobOperationOptions{RetryFunc: synchronizationRetryFunc()})
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if jobResp.Model == nil {
+		return nil, fmt.Errorf("retrieving %s: model was nil", id)
+	}
+
+	templateId := jobResp.Model.TemplateId.GetOrZero()
+	if templateId == "" {
+		return nil, nil
+	}
+
+	synchronizationTemplateId := stable.NewServicePrincipalIdSynchronizationTemplateID(id.ServicePrincipalId, templateId)
+
+	resp, err := templateSchemaClient.GetSynchronizationTemplateSchema(ctx, synchronizationTemplateId, synchronizationtemplateschema.GetSynchronizationTemplateSchemaOperationOptions{RetryFunc: synchronizationRetryFunc()})
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("retrieving schema for %s: %+v", synchronizationTemplateId, err)
+	}
+	if resp.Model == nil {
+		return nil, fmt.Errorf("retrieving schema for %s: model was nil", synchronizationTemplateId)
+	}
+
+	rule, err := findSynchronizationRule(*resp.Model, ruleId)
+	if err != nil {
+		log.Printf("[DEBUG] No default object mappings found for rule %q in schema for %s: %+v", ruleId, synchronizationTemplateId, err)
+		return nil, nil
+	}
+
+	return pointer.From(rule.ObjectMappings), nil
+}
+
+func synchronizationSchemaResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Synchronization.SynchronizationJobSchemaClient
+
+	id, err := stable.ParseServicePrincipalIdSynchronizationJobID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing synchronization job ID %q", d.Id())
+	}
+
+	resp, err := client.GetSynchronizationJobSchema(ctx, *id, synchronizationjobschema.GetSynchronizationJobSchemaOperationOptions{RetryFunc: synchronizationRetryFunc()})
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] Synchronization schema for %s was not found - removing from state!", id)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving synchronization schema for %s", id)
+	}
+
+	schema := resp.Model
+	if schema == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving synchronization schema for %s", id)
+	}
+
+	rule, err := findSynchronizationRule(*schema, d.Get("rule_id").(string))
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving synchronization rule for %s", id)
+	}
+
+	tf.Set(d, "synchronization_job_id", id.ID())
+	tf.Set(d, "rule_id", rule.Id.GetOrZero())
+	tf.Set(d, "object_mapping", flattenSynchronizationObjectMappings(rule.ObjectMappings, d.Get("object_mapping").([]interface{})))
+
+	return nil
+}
+
+func synchronizationSchemaResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Synchronization.SynchronizationJobSchemaClient
+
+	id, err := stable.ParseServicePrincipalIdSynchronizationJobID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing synchronization job ID %q", d.Id())
+	}
+
+	tf.LockByName(servicePrincipalResourceName, id.ServicePrincipalId)
+	defer tf.UnlockByName(servicePrincipalResourceName, id.ServicePrincipalId)
+
+	resp, err := client.GetSynchronizationJobSchema(ctx, *id, synchronizationjobschema.GetSynchronizationJobSchemaOperationOptions{RetryFunc: synchronizationRetryFunc()})
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving synchronization schema for %s", id)
+	}
+
+	schema := resp.Model
+	if schema == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving synchronization schema for %s", id)
+	}
+
+	rule, err := findSynchronizationRule(*schema, d.Get("rule_id").(string))
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving synchronization rule for %s", id)
+	}
+
+	templateMappings, err := synchronizationTemplateObjectMappings(ctx, meta, *id, rule.Id.GetOrZero())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving default object mappings for %s", id)
+	}
+
+	// Only the object mappings managed by this resource are restored to their defaults, the rest of the schema is left unchanged
+	rule.ObjectMappings = pointer.To(restoreSynchronizationObjectMappings(pointer.From(rule.ObjectMappings), templateMappings, d.Get("object_mapping").([]interface{})))
+
+	if _, err = client.UpdateSynchronizationJobSchema(ctx, *id, *schema, synchronizationjobschema.UpdateSynchronizationJobSchemaOperationOptions{RetryFunc: synchronizationRetryFunc()}); err != nil {
+		return tf.ErrorDiagF(err, "Restoring default object mappings in synchronization schema for %s", id)
+	}
+
+	return nil
+}
+
+func expandSynchronizationObjectMappings(ctx context.Context, client *synchronizationjobschema.SynchronizationJobSchemaClient, id stable.ServicePrincipalIdSynchronizationJobId, in []interface{}) ([]stable.ObjectMapping, error) {
+	result := make([]stable.ObjectMapping, 0)
+
+	for _, raw := range in {
+		if raw == nil {
+			continue
+		}
+		item := raw.(map[string]interface{})
+
+		attributeMappings, err := expandSynchronizationAttributeMappings(ctx, client, id, item["attribute_mapping"].([]interface{}))
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, stable.ObjectMapping{
+			AttributeMappings: &attributeMappings,
+			Enabled:           pointer.To(item["enabled"].(bool)),
+			FlowTypes:         expandSynchronizationObjectFlowTypes(item["flow_types"].(*pluginsdk.Set).List()),
+			Scope:             expandSynchronizationScopingFilters(item["scoping_filter"].([]interface{})),
+			SourceObjectName:  nullable.Value(item["source_object_name"].(string)),
+			TargetObjectName:  nullable.Value(item["target_object_name"].(string)),
+		})
+	}
+
+	return result, nil
+}
+
+func expandSynchronizationAttributeMappings(ctx context.Context, client *synchronizationjobschema.SynchronizationJobSchemaClient, id stable.ServicePrincipalIdSynchronizationJobId, in []interface{}) ([]stable.AttributeMapping, error) {
+	result := make([]stable.AttributeMapping, 0)
+
+	for _, raw := range in {
+		if raw == nil {
+			continue
+		}
+		item := raw.(map[string]interface{})
+
+		var source *stable.AttributeMappingSource
+		if v := item["source_attribute_name"].(string); v != "" {
+			source = &stable.AttributeMappingSource{
+				Expression: nullable.Value(fmt.Sprintf("[%s]", v)),
+				Name:       nullable.Value(v),
+				Type:       pointer.To(stable.AttributeMappingSourceType_Attribute),
+			}
+		} else if v := item["constant_value"].(string); v != "" {
+			source = &stable.AttributeMappingSource{
+				Expression: nullable.Value(fmt.Sprintf("%q", v)),
+				Name:       nullable.Value(v),
+				Type:       pointer.To(stable.AttributeMappingSourceType_Constant),
+			}
+		} else if v := item["expression"].(string); v != "" {
+			parsed, err := parseSynchronizationExpression(ctx, client, id, v)
+			if err != nil {
+				return nil, fmt.Errorf("parsing expression for target attribute %q: %v", item["target_attribute_name"].(string), err)
+			}
+			source = parsed
+		}
+
+		result = append(result, stable.AttributeMapping{
+			DefaultValue:            nullable.NoZero(item["default_value"].(string)),
+			ExportMissingReferences: pointer.To(item["export_missing_references"].(bool)),
+			FlowBehavior:            pointer.To(stable.AttributeFlowBehavior(item["flow_behavior"].(string))),
+			FlowType:                pointer.To(stable.AttributeFlowType(item["flow_type"].(string))),
+			MatchingPriority:        pointer.To(int64(item["matching_precedence"].(int))),
+			Source:                  source,
+			TargetAttributeName:     nullable.Value(item["target_attribute_name"].(string)),
+		})
+	}
+
+	return result, nil
+}
+
+// parseSynchronizationExpression uses the API to convert an expression into the tree of functions and parameters
+// which is required when mapping an attribute from an expression.
+func parseSynchronizationExpression(ctx context.Context, client *synchronizationjobschema.SynchronizationJobSchemaClient, id stable.ServicePrincipalIdSynchronizationJobId, expression string) (*stable.AttributeMappingSource, error) {
+	request := synchronizationjobschema.ParseSynchronizationJobSchemaExpressionRequest{
+		Expression: nullable.Value(expression),
+	}
+
+	resp, err := client.ParseSynchronizationJobSchemaExpression(ctx, id, request, synchronizationjobschema.ParseSynchronizationJobSchemaExpressionOperationOptions{RetryFunc: synchronizationRetryFunc()})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Model == nil {
+		return nil, errors.New("model was nil")
+	}
+
+	if !pointer.From(resp.Model.ParsingSucceeded) || resp.Model.ParsedExpression == nil {
+		if resp.Model.Error != nil && resp.Model.Error.Message.GetOrZero() != "" {
+			return nil, errors.New(resp.Model.Error.Message.GetOrZero())
+		}
+		return nil, errors.New("the expression could not be parsed")
+	}
+
+	source := resp.Model.ParsedExpression
+	source.Expression = nullable.Value(expression)
+
+	return source, nil
+}
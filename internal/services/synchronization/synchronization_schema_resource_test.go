@@ -0,0 +1,236 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package synchronization_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/synchronizationjobschema"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+)
+
+type SynchronizationSchemaResource struct{}
+
+func TestAccSynchronizationSchema(t *testing.T) {
+	acceptance.RunTestsInSequence(t, map[string]map[string]func(t *testing.T){
+		"synchronizationSchema": {
+			"basic":    testAccSynchronizationSchema_basic,
+			"complete": testAccSynchronizationSchema_complete,
+			"update":   testAccSynchronizationSchema_update,
+		},
+	})
+}
+
+func testAccSynchronizationSchema_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_synchronization_schema", "test")
+	r := SynchronizationSchemaResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rule_id").Exists(),
+				check.That(data.ResourceName).Key("object_mapping.#").HasValue("1"),
+				check.That(data.ResourceName).Key("object_mapping.0.attribute_mapping.#").HasValue("3"),
+				check.That(data.ResourceName).Key("object_mapping.0.attribute_mapping.0.target_attribute_name").HasValue("userName"),
+				check.That(data.ResourceName).Key("object_mapping.0.attribute_mapping.0.matching_precedence").HasValue("1"),
+			),
+		},
+		data.ImportStep("object_mapping"),
+	})
+}
+
+func testAccSynchronizationSchema_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_synchronization_schema", "test")
+	r := SynchronizationSchemaResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("object_mapping.0.scoping_filter.#").HasValue("1"),
+				check.That(data.ResourceName).Key("object_mapping.0.attribute_mapping.#").HasValue("5"),
+				check.That(data.ResourceName).Key("object_mapping.0.attribute_mapping.4.constant_value").HasValue("Contoso"),
+			),
+		},
+		data.ImportStep("object_mapping"),
+	})
+}
+
+func testAccSynchronizationSchema_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_synchronization_schema", "test")
+	r := SynchronizationSchemaResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("object_mapping"),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("object_mapping"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("object_mapping"),
+	})
+}
+
+func (r SynchronizationSchemaResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Synchronization.SynchronizationJobSchemaClient
+
+	id, err := stable.ParseServicePrincipalIdSynchronizationJobID(state.ID)
+	if err != nil {
+		return nil, fmt.Errorf("parsing synchronization job ID: %v", err)
+	}
+
+	resp, err := client.GetSynchronizationJobSchema(ctx, *id, synchronizationjobschema.DefaultGetSynchronizationJobSchemaOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving synchronization schema for %s", id)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (SynchronizationSchemaResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+data "azuread_client_config" "test" {}
+
+data "azuread_application_template" "test" {
+  display_name = "Azure Databricks SCIM Provisioning Connector"
+}
+
+resource "azuread_application_from_template" "test" {
+  display_name = "acctestSynchronizationSchema-%[1]d"
+  template_id  = data.azuread_application_template.test.template_id
+}
+
+data "azuread_service_principal" "test" {
+  object_id = azuread_application_from_template.test.service_principal_object_id
+}
+
+resource "azuread_synchronization_job" "test" {
+  service_principal_id = data.azuread_service_principal.test.id
+  template_id          = "dataBricks"
+  enabled              = false
+}
+`, data.RandomInteger)
+}
+
+func (r SynchronizationSchemaResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_synchronization_schema" "test" {
+  synchronization_job_id = azuread_synchronization_job.test.id
+
+  object_mapping {
+    source_object_name = "User"
+    target_object_name = "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User"
+
+    attribute_mapping {
+      target_attribute_name = "userName"
+      source_attribute_name = "userPrincipalName"
+      matching_precedence   = 1
+    }
+
+    attribute_mapping {
+      target_attribute_name = "displayName"
+      source_attribute_name = "displayName"
+    }
+
+    attribute_mapping {
+      target_attribute_name = "active"
+      expression            = "Switch([IsSoftDeleted], , \"False\", \"True\", \"True\", \"False\")"
+    }
+  }
+}
+`, r.template(data))
+}
+
+func (r SynchronizationSchemaResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_synchronization_schema" "test" {
+  synchronization_job_id = azuread_synchronization_job.test.id
+
+  object_mapping {
+    source_object_name = "User"
+    target_object_name = "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User"
+    enabled            = true
+    flow_types         = ["Add", "Update", "Delete"]
+
+    scoping_filter {
+      name = "Employees"
+
+      clause {
+        source_attribute_name = "employeeType"
+        operator              = "EQUALS"
+        values                = ["Employee"]
+      }
+
+      clause {
+        source_attribute_name = "accountEnabled"
+        operator              = "IS TRUE"
+      }
+    }
+
+    attribute_mapping {
+      target_attribute_name = "userName"
+      source_attribute_name = "userPrincipalName"
+      matching_precedence   = 1
+    }
+
+    attribute_mapping {
+      target_attribute_name = "displayName"
+      expression            = "Join(\" \", [givenName], [surname])"
+      flow_behavior         = "FlowAlways"
+    }
+
+    attribute_mapping {
+      target_attribute_name = "active"
+      expression            = "Switch([IsSoftDeleted], , \"False\", \"True\", \"True\", \"False\")"
+    }
+
+    attribute_mapping {
+      target_attribute_name = "externalId"
+      source_attribute_name = "mailNickname"
+      default_value         = "unknown"
+      flow_type             = "ObjectAddOnly"
+    }
+
+    attribute_mapping {
+      target_attribute_name = "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User:organization"
+      constant_value        = "Contoso"
+    }
+  }
+}
+`, r.template(data))
+}
@@ -0,0 +1,196 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+)
+
+// SynchronizationExpression checks whether a value is a syntactically valid attribute mapping expression, as used by
+// synchronization schemas. Expressions are composed of function calls, attribute references in square brackets, string
+// literals in double quotes and integer literals. Function names and arguments are not checked against the functions
+// supported by the synchronization engine, this is left to the API.
+// See https://learn.microsoft.com/en-us/entra/identity/app-provisioning/functions-for-customizing-application-data
+func SynchronizationExpression(i interface{}, path cty.Path) (ret pluginsdk.Diagnostics) {
+	v, ok := i.(string)
+	if !ok {
+		ret = append(ret, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Expected a string value",
+			AttributePath: path,
+		})
+		return
+	}
+
+	if strings.TrimSpace(v) == "" {
+		ret = append(ret, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Expression must not be empty",
+			AttributePath: path,
+		})
+		return
+	}
+
+	p := &expressionParser{input: []rune(v)}
+	if err := p.parse(); err != nil {
+		ret = append(ret, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Invalid synchronization expression",
+			Detail:        err.Error(),
+			AttributePath: path,
+		})
+	}
+
+	return // nolint:nakedret
+}
+
+type expressionParser struct {
+	input []rune
+	pos   int
+}
+
+func (p *expressionParser) parse() error {
+	p.skipWhitespace()
+	if err := p.parseExpression(); err != nil {
+		return err
+	}
+	p.skipWhitespace()
+	if !p.eof() {
+		return p.errorf("unexpected %q after end of expression", p.peek())
+	}
+	return nil
+}
+
+func (p *expressionParser) parseExpression() error {
+	if p.eof() {
+		return p.errorf("unexpected end of expression")
+	}
+
+	switch c := p.peek(); {
+	case c == '[':
+		return p.parseAttribute()
+	case c == '"':
+		return p.parseString()
+	case c == '-' || unicode.IsDigit(c):
+		return p.parseNumber()
+	case unicode.IsLetter(c):
+		return p.parseFunction()
+	default:
+		return p.errorf("unexpected %q", c)
+	}
+}
+
+func (p *expressionParser) parseAttribute() error {
+	start := p.pos
+	p.pos++
+	for !p.eof() && p.peek() != ']' {
+		if p.peek() == '[' {
+			return p.errorf("unexpected %q in attribute reference", '[')
+		}
+		p.pos++
+	}
+	if p.eof() {
+		return fmt.Errorf("unterminated attribute reference starting at position %d", start+1)
+	}
+	if strings.TrimSpace(string(p.input[start+1:p.pos])) == "" {
+		return fmt.Errorf("empty attribute reference at position %d", start+1)
+	}
+	p.pos++
+	return nil
+}
+
+func (p *expressionParser) parseString() error {
+	start := p.pos
+	p.pos++
+	for !p.eof() {
+		switch p.peek() {
+		case '\\':
+			p.pos += 2
+			continue
+		case '"':
+			p.pos++
+			return nil
+		}
+		p.pos++
+	}
+	return fmt.Errorf("unterminated string literal starting at position %d", start+1)
+}
+
+func (p *expressionParser) parseNumber() error {
+	if p.peek() == '-' {
+		p.pos++
+	}
+	if p.eof() || !unicode.IsDigit(p.peek()) {
+		return p.errorf("expected a digit")
+	}
+	for !p.eof() && unicode.IsDigit(p.peek()) {
+		p.pos++
+	}
+	return nil
+}
+
+func (p *expressionParser) parseFunction() error {
+	start := p.pos
+	for !p.eof() && (unicode.IsLetter(p.peek()) || unicode.IsDigit(p.peek()) || p.peek() == '_') {
+		p.pos++
+	}
+	name := string(p.input[start:p.pos])
+
+	p.skipWhitespace()
+	if p.eof() || p.peek() != '(' {
+		return fmt.Errorf("expected %q after function name %q at position %d", '(', name, start+1)
+	}
+	p.pos++
+
+	// Arguments may be omitted, e.g. `Replace([mail], , "\\d", , "", , )`, so an empty argument is permitted
+	for {
+		p.skipWhitespace()
+		if p.eof() {
+			return fmt.Errorf("unterminated argument list for function %q at position %d", name, start+1)
+		}
+		if c := p.peek(); c != ',' && c != ')' {
+			if err := p.parseExpression(); err != nil {
+				return err
+			}
+			p.skipWhitespace()
+		}
+		if p.eof() {
+			return fmt.Errorf("unterminated argument list for function %q at position %d", name, start+1)
+		}
+
+		switch p.peek() {
+		case ',':
+			p.pos++
+		case ')':
+			p.pos++
+			return nil
+		default:
+			return p.errorf("expected %q or %q in argument list for function %q", ',', ')', name)
+		}
+	}
+}
+
+func (p *expressionParser) skipWhitespace() {
+	for !p.eof() && unicode.IsSpace(p.peek()) {
+		p.pos++
+	}
+}
+
+func (p *expressionParser) eof() bool {
+	return p.pos >= len(p.input)
+}
+
+func (p *expressionParser) peek() rune {
+	return p.input[p.pos]
+}
+
+func (p *expressionParser) errorf(format string, a ...interface{}) error {
+	return fmt.Errorf("%s at position %d", fmt.Sprintf(format, a...), p.pos+1)
+}
@@ -0,0 +1,114 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func TestSynchronizationExpression(t *testing.T) {
+	cases := []struct {
+		Value    string
+		TestName string
+		ErrCount int
+	}{
+		{
+			Value:    "[userPrincipalName]",
+			TestName: "Valid_Attribute",
+			ErrCount: 0,
+		},
+		{
+			Value:    `"Contoso"`,
+			TestName: "Valid_String",
+			ErrCount: 0,
+		},
+		{
+			Value:    `Join("@", NormalizeDiacritics(StripSpaces(Join(".", [givenName], [surname]))), "contoso.com")`,
+			TestName: "Valid_NestedFunctions",
+			ErrCount: 0,
+		},
+		{
+			Value:    `Replace([mail], "@contoso.com", , , "", , )`,
+			TestName: "Valid_OmittedArguments",
+			ErrCount: 0,
+		},
+		{
+			Value:    `Mid([employeeId], 1, 4)`,
+			TestName: "Valid_Numbers",
+			ErrCount: 0,
+		},
+		{
+			Value:    `Switch([country], "Unknown", "US", "United States", "GB", "United Kingdom")`,
+			TestName: "Valid_Switch",
+			ErrCount: 0,
+		},
+		{
+			Value:    `Append("Say \"hello\"", [displayName])`,
+			TestName: "Valid_EscapedQuotes",
+			ErrCount: 0,
+		},
+		{
+			Value:    `  ToLower( [mail] )  `,
+			TestName: "Valid_Whitespace",
+			ErrCount: 0,
+		},
+		{
+			Value:    "",
+			TestName: "Invalid_Empty",
+			ErrCount: 1,
+		},
+		{
+			Value:    "[userPrincipalName",
+			TestName: "Invalid_UnterminatedAttribute",
+			ErrCount: 1,
+		},
+		{
+			Value:    "[]",
+			TestName: "Invalid_EmptyAttribute",
+			ErrCount: 1,
+		},
+		{
+			Value:    `Join(".", [givenName], "contoso.com)`,
+			TestName: "Invalid_UnterminatedString",
+			ErrCount: 1,
+		},
+		{
+			Value:    `Join(".", [givenName], [surname]`,
+			TestName: "Invalid_UnterminatedArguments",
+			ErrCount: 1,
+		},
+		{
+			Value:    `ToLower [mail]`,
+			TestName: "Invalid_MissingParenthesis",
+			ErrCount: 1,
+		},
+		{
+			Value:    `ToLower([mail]))`,
+			TestName: "Invalid_TrailingCharacters",
+			ErrCount: 1,
+		},
+		{
+			Value:    `Join("." [givenName])`,
+			TestName: "Invalid_MissingComma",
+			ErrCount: 1,
+		},
+		{
+			Value:    `userPrincipalName`,
+			TestName: "Invalid_BareIdentifier",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			diags := SynchronizationExpression(tc.Value, cty.Path{})
+
+			if len(diags) != tc.ErrCount {
+				t.Fatalf("Expected SynchronizationExpression to have %d not %d errors for %q", tc.ErrCount, len(diags), tc.TestName)
+			}
+		})
+	}
+}
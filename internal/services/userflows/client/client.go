@@ -4,15 +4,63 @@
 package client
 
 import (
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identity/stable/b2xuserflow"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identity/stable/b2xuserflowapiconnectorconfigurationpostattributecollection"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identity/stable/b2xuserflowapiconnectorconfigurationpostfederationsignup"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identity/stable/b2xuserflowlanguage"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identity/stable/b2xuserflowuserattributeassignment"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identity/stable/b2xuserflowuserflowidentityprovider"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identity/stable/userflowattribute"
 	"github.com/hashicorp/terraform-provider-azuread/internal/common"
 )
 
 type Client struct {
-	UserFlowAttributeClient *userflowattribute.UserFlowAttributeClient
+	B2xUserFlowClient                        *b2xuserflow.B2xUserFlowClient
+	B2xUserFlowIdentityProviderClient        *b2xuserflowuserflowidentityprovider.B2xUserFlowUserFlowIdentityProviderClient
+	B2xUserFlowLanguageClient                *b2xuserflowlanguage.B2xUserFlowLanguageClient
+	B2xUserFlowPostAttributeCollectionClient *b2xuserflowapiconnectorconfigurationpostattributecollection.B2xUserFlowApiConnectorConfigurationPostAttributeCollectionClient
+	B2xUserFlowPostFederationSignupClient    *b2xuserflowapiconnectorconfigurationpostfederationsignup.B2xUserFlowApiConnectorConfigurationPostFederationSignupClient
+	B2xUserFlowUserAttributeAssignmentClient *b2xuserflowuserattributeassignment.B2xUserFlowUserAttributeAssignmentClient
+	UserFlowAttributeClient                  *userflowattribute.UserFlowAttributeClient
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
+	b2xUserFlowClient, err := b2xuserflow.NewB2xUserFlowClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(b2xUserFlowClient.Client)
+
+	b2xUserFlowIdentityProviderClient, err := b2xuserflowuserflowidentityprovider.NewB2xUserFlowUserFlowIdentityProviderClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(b2xUserFlowIdentityProviderClient.Client)
+
+	b2xUserFlowLanguageClient, err := b2xuserflowlanguage.NewB2xUserFlowLanguageClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(b2xUserFlowLanguageClient.Client)
+
+	b2xUserFlowPostAttributeCollectionClient, err := b2xuserflowapiconnectorconfigurationpostattributecollection.NewB2xUserFlowApiConnectorConfigurationPostAttributeCollectionClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(b2xUserFlowPostAttributeCollectionClient.Client)
+
+	b2xUserFlowPostFederationSignupClient, err := b2xuserflowapiconnectorconfigurationpostfederationsignup.NewB2xUserFlowApiConnectorConfigurationPostFederationSignupClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(b2xUserFlowPostFederationSignupClient.Client)
+
+	b2xUserFlowUserAttributeAssignmentClient, err := b2xuserflowuserattributeassignment.NewB2xUserFlowUserAttributeAssignmentClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(b2xUserFlowUserAttributeAssignmentClient.Client)
+
 	userFlowAttributeClient, err := userflowattribute.NewUserFlowAttributeClientWithBaseURI(o.Environment.MicrosoftGraph)
 	if err != nil {
 		return nil, err
@@ -20,6 +68,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	o.Configure(userFlowAttributeClient.Client)
 
 	return &Client{
-		UserFlowAttributeClient: userFlowAttributeClient,
+		B2xUserFlowClient:                        b2xUserFlowClient,
+		B2xUserFlowIdentityProviderClient:        b2xUserFlowIdentityProviderClient,
+		B2xUserFlowLanguageClient:                b2xUserFlowLanguageClient,
+		B2xUserFlowPostAttributeCollectionClient: b2xUserFlowPostAttributeCollectionClient,
+		B2xUserFlowPostFederationSignupClient:    b2xUserFlowPostFederationSignupClient,
+		B2xUserFlowUserAttributeAssignmentClient: b2xUserFlowUserAttributeAssignmentClient,
+		UserFlowAttributeClient:                  userFlowAttributeClient,
 	}, nil
 }
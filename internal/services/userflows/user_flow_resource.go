@@ -0,0 +1,564 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package userflows
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identity/stable/b2xuserflow"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identity/stable/b2xuserflowapiconnectorconfigurationpostattributecollection"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identity/stable/b2xuserflowapiconnectorconfigurationpostfederationsignup"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identity/stable/b2xuserflowlanguage"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identity/stable/b2xuserflowuserattributeassignment"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identity/stable/b2xuserflowuserflowidentityprovider"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/consistency"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+)
+
+func userFlowResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: userFlowResourceCreate,
+		ReadContext:   userFlowResourceRead,
+		UpdateContext: userFlowResourceUpdate,
+		DeleteContext: userFlowResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(10 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(10 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			if _, errs := stable.ValidateIdentityB2xUserFlowID(id, "id"); len(errs) > 0 {
+				out := ""
+				for _, err := range errs {
+					out += err.Error()
+				}
+				return errors.New(out)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Description:  "The name of the user flow, which will be prefixed with `B2X_1_`",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+
+			"identity_provider_ids": {
+				Description: "The resource IDs of the identity providers that users can sign up with",
+				Type:        pluginsdk.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: stable.ValidateIdentityIdentityProviderID,
+				},
+			},
+
+			"attribute": {
+				Description: "The user attributes to collect during sign-up, in the order they should be presented",
+				Type:        pluginsdk.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"user_flow_attribute_id": {
+							Description:  "The resource ID of the user flow attribute to collect",
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: stable.ValidateIdentityUserFlowAttributeID,
+						},
+
+						"display_name": {
+							Description: "The display name of the attribute, as shown to the user",
+							Type:        pluginsdk.TypeString,
+							Optional:    true,
+							Computed:    true,
+						},
+
+						"input_type": {
+							Description:  "The input type of the attribute",
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(stable.PossibleValuesForIdentityUserFlowAttributeInputType(), false),
+						},
+
+						"optional": {
+							Description: "Whether the user can skip providing a value for the attribute",
+							Type:        pluginsdk.TypeBool,
+							Optional:    true,
+							Default:     false,
+						},
+
+						"requires_verification": {
+							Description: "Whether the value of the attribute requires verification, only applicable to email addresses",
+							Type:        pluginsdk.TypeBool,
+							Optional:    true,
+							Default:     false,
+						},
+
+						"value": {
+							Description: "The values that the user can select from, for attributes with a selection input type",
+							Type:        pluginsdk.TypeList,
+							Optional:    true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Description:  "The name of the value, as shown to the user",
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"value": {
+										Description:  "The value that is stored when this value is selected",
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"default": {
+										Description: "Whether this value is selected by default",
+										Type:        pluginsdk.TypeBool,
+										Optional:    true,
+										Default:     false,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"language": {
+				Description: "Languages to enable or disable for the user flow",
+				Type:        pluginsdk.TypeSet,
+				Optional:    true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"language_id": {
+							Description:  "The language tag of the language, for example `en` or `fr`",
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"enabled": {
+							Description: "Whether the language is enabled for the user flow",
+							Type:        pluginsdk.TypeBool,
+							Required:    true,
+						},
+					},
+				},
+			},
+
+			"post_attribute_collection_api_connector_id": {
+				Description:  "The resource ID of the API connector to call after attributes have been collected",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: stable.ValidateIdentityApiConnectorID,
+			},
+
+			"post_federation_signup_api_connector_id": {
+				Description:  "The resource ID of the API connector to call after the user has signed in with an identity provider",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: stable.ValidateIdentityApiConnectorID,
+			},
+
+			"user_flow_type": {
+				Description: "The type of the user flow",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func userFlowResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).UserFlows.B2xUserFlowClient
+
+	name := d.Get("name").(string)
+
+	existingId := stable.NewIdentityB2xUserFlowID(fmt.Sprintf("B2X_1_%s", name))
+	if resp, err := client.GetB2xUserFlow(ctx, existingId, b2xuserflow.DefaultGetB2xUserFlowOperationOptions()); err != nil {
+		if !response.WasNotFound(resp.HttpResponse) {
+			return tf.ErrorDiagF(err, "Checking for existing user flow")
+		}
+	} else {
+		return tf.ImportAsExistsDiag("azuread_user_flow", existingId.ID())
+	}
+
+	properties := stable.B2xIdentityUserFlow{
+		Id:           pointer.To(name),
+		UserFlowType: pointer.To(stable.UserFlowType_SignUpOrSignIn),
+	}
+
+	resp, err := client.CreateB2xUserFlow(ctx, properties, b2xuserflow.DefaultCreateB2xUserFlowOperationOptions())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Creating user flow")
+	}
+
+	if resp.Model == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Creating user flow")
+	}
+
+	if resp.Model.Id == nil || *resp.Model.Id == "" {
+		return tf.ErrorDiagF(errors.New("API returned user flow with nil ID"), "Bad API Response")
+	}
+
+	id := stable.NewIdentityB2xUserFlowID(*resp.Model.Id)
+	d.SetId(id.ID())
+
+	// Now ensure we can retrieve the user flow consistently
+	if err = consistency.WaitForUpdate(ctx, func(ctx context.Context) (*bool, error) {
+		resp, err := client.GetB2xUserFlow(ctx, id, b2xuserflow.DefaultGetB2xUserFlowOperationOptions())
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return pointer.To(false), err
+		}
+		return pointer.To(resp.Model != nil), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for creation of %s", id)
+	}
+
+	if diags := userFlowResourceApplyConfiguration(ctx, d, meta, id); diags != nil {
+		return diags
+	}
+
+	return userFlowResourceRead(ctx, d, meta)
+}
+
+func userFlowResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	id, err := stable.ParseIdentityB2xUserFlowID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing ID")
+	}
+
+	if diags := userFlowResourceApplyConfiguration(ctx, d, meta, *id); diags != nil {
+		return diags
+	}
+
+	return userFlowResourceRead(ctx, d, meta)
+}
+
+// userFlowResourceApplyConfiguration reconciles the identity providers, attributes, languages and API connectors for a
+// user flow, for any of these that have changed
+func userFlowResourceApplyConfiguration(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}, id stable.IdentityB2xUserFlowId) pluginsdk.Diagnostics {
+	c := meta.(*clients.Client).UserFlows
+
+	if d.HasChange("identity_provider_ids") {
+		resp, err := c.B2xUserFlowIdentityProviderClient.ListB2xUserFlowIdentityProviders(ctx, id, b2xuserflowuserflowidentityprovider.DefaultListB2xUserFlowIdentityProvidersOperationOptions())
+		if err != nil {
+			return tf.ErrorDiagF(err, "Retrieving identity providers for %s", id)
+		}
+
+		existing := make(map[string]bool)
+		for _, provider := range pointer.From(resp.Model) {
+			if providerId := provider.IdentityProviderBase().Id; providerId != nil {
+				existing[strings.ToLower(*providerId)] = true
+			}
+		}
+
+		desired := make(map[string]bool)
+		for _, raw := range d.Get("identity_provider_ids").(*pluginsdk.Set).List() {
+			providerId, err := stable.ParseIdentityIdentityProviderID(raw.(string))
+			if err != nil {
+				return tf.ErrorDiagPathF(err, "identity_provider_ids", "Parsing identity provider ID")
+			}
+			desired[strings.ToLower(providerId.IdentityProviderBaseId)] = true
+
+			if existing[strings.ToLower(providerId.IdentityProviderBaseId)] {
+				continue
+			}
+
+			ref := stable.ReferenceCreate{
+				ODataId: pointer.To(c.B2xUserFlowIdentityProviderClient.Client.BaseUri + providerId.ID()),
+			}
+			if _, err = c.B2xUserFlowIdentityProviderClient.AddB2xUserFlowIdentityProviderRef(ctx, id, ref, b2xuserflowuserflowidentityprovider.DefaultAddB2xUserFlowIdentityProviderRefOperationOptions()); err != nil {
+				return tf.ErrorDiagF(err, "Adding %s to %s", providerId, id)
+			}
+		}
+
+		for _, provider := range pointer.From(resp.Model) {
+			providerId := provider.IdentityProviderBase().Id
+			if providerId == nil || desired[strings.ToLower(*providerId)] {
+				continue
+			}
+
+			refId := stable.NewIdentityB2xUserFlowIdUserFlowIdentityProviderID(id.B2xIdentityUserFlowId, *providerId)
+			if _, err = c.B2xUserFlowIdentityProviderClient.RemoveB2xUserFlowIdentityProviderRef(ctx, refId, b2xuserflowuserflowidentityprovider.DefaultRemoveB2xUserFlowIdentityProviderRefOperationOptions()); err != nil {
+				return tf.ErrorDiagF(err, "Removing identity provider %q from %s", *providerId, id)
+			}
+		}
+	}
+
+	if v, ok := d.GetOk("attribute"); ok && d.HasChange("attribute") {
+		if diags := userFlowResourceApplyAttributes(ctx, c.B2xUserFlowUserAttributeAssignmentClient, id, v.([]interface{})); diags != nil {
+			return diags
+		}
+	}
+
+	if d.HasChange("language") {
+		for _, raw := range d.Get("language").(*pluginsdk.Set).List() {
+			language := raw.(map[string]interface{})
+			languageId := stable.NewIdentityB2xUserFlowIdLanguageID(id.B2xIdentityUserFlowId, language["language_id"].(string))
+
+			properties := stable.UserFlowLanguageConfiguration{
+				IsEnabled: pointer.To(language["enabled"].(bool)),
+			}
+			if _, err := c.B2xUserFlowLanguageClient.UpdateB2xUserFlowLanguage(ctx, languageId, properties, b2xuserflowlanguage.DefaultUpdateB2xUserFlowLanguageOperationOptions()); err != nil {
+				return tf.ErrorDiagF(err, "Updating %s", languageId)
+			}
+		}
+	}
+
+	if d.HasChange("post_attribute_collection_api_connector_id") {
+		if v := d.Get("post_attribute_collection_api_connector_id").(string); v != "" {
+			connectorId, err := stable.ParseIdentityApiConnectorID(v)
+			if err != nil {
+				return tf.ErrorDiagPathF(err, "post_attribute_collection_api_connector_id", "Parsing API connector ID")
+			}
+			ref := stable.ReferenceUpdate{
+				ODataId: pointer.To(c.B2xUserFlowPostAttributeCollectionClient.Client.BaseUri + connectorId.ID()),
+			}
+			if _, err = c.B2xUserFlowPostAttributeCollectionClient.SetB2xUserFlowApiConnectorConfigurationPostAttributeCollectionRef(ctx, id, ref, b2xuserflowapiconnectorconfigurationpostattributecollection.DefaultSetB2xUserFlowApiConnectorConfigurationPostAttributeCollectionRefOperationOptions()); err != nil {
+				return tf.ErrorDiagF(err, "Setting post attribute collection API connector for %s", id)
+			}
+		} else {
+			if resp, err := c.B2xUserFlowPostAttributeCollectionClient.RemoveB2xUserFlowApiConnectorConfigurationPostAttributeCollectionRef(ctx, id, b2xuserflowapiconnectorconfigurationpostattributecollection.DefaultRemoveB2xUserFlowApiConnectorConfigurationPostAttributeCollectionRefOperationOptions()); err != nil && !response.WasNotFound(resp.HttpResponse) {
+				return tf.ErrorDiagF(err, "Removing post attribute collection API connector for %s", id)
+			}
+		}
+	}
+
+	if d.HasChange("post_federation_signup_api_connector_id") {
+		if v := d.Get("post_federation_signup_api_connector_id").(string); v != "" {
+			connectorId, err := stable.ParseIdentityApiConnectorID(v)
+			if err != nil {
+				return tf.ErrorDiagPathF(err, "post_federation_signup_api_connector_id", "Parsing API connector ID")
+			}
+			ref := stable.ReferenceUpdate{
+				ODataId: pointer.To(c.B2xUserFlowPostFederationSignupClient.Client.BaseUri + connectorId.ID()),
+			}
+			if _, err = c.B2xUserFlowPostFederationSignupClient.SetB2xUserFlowApiConnectorConfigurationPostFederationSignupRef(ctx, id, ref, b2xuserflowapiconnectorconfigurationpostfederationsignup.DefaultSetB2xUserFlowApiConnectorConfigurationPostFederationSignupRefOperationOptions()); err != nil {
+				return tf.ErrorDiagF(err, "Setting post federation sign-up API connector for %s", id)
+			}
+		} else {
+			if resp, err := c.B2xUserFlowPostFederationSignupClient.RemoveB2xUserFlowApiConnectorConfigurationPostFederationSignupRef(ctx, id, b2xuserflowapiconnectorconfigurationpostfederationsignup.DefaultRemoveB2xUserFlowApiConnectorConfigurationPostFederationSignupRefOperationOptions()); err != nil && !response.WasNotFound(resp.HttpResponse) {
+				return tf.ErrorDiagF(err, "Removing post federation sign-up API connector for %s", id)
+			}
+		}
+	}
+
+	return nil
+}
+
+// userFlowResourceApplyAttributes reconciles the user attribute assignments for a user flow, removing any attributes not
+// specified in the configuration, and then sets the order in which attributes are collected
+func userFlowResourceApplyAttributes(ctx context.Context, client *b2xuserflowuserattributeassignment.B2xUserFlowUserAttributeAssignmentClient, id stable.IdentityB2xUserFlowId, in []interface{}) pluginsdk.Diagnostics {
+	resp, err := client.ListB2xUserFlowUserAttributeAssignments(ctx, id, b2xuserflowuserattributeassignment.DefaultListB2xUserFlowUserAttributeAssignmentsOperationOptions())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving user attribute assignments for %s", id)
+	}
+
+	existing := make(map[string]bool)
+	for _, assignment := range pointer.From(resp.Model) {
+		if assignment.Id != nil {
+			existing[strings.ToLower(*assignment.Id)] = true
+		}
+	}
+
+	order := make([]string, 0)
+	desired := make(map[string]bool)
+	for _, raw := range in {
+		if raw == nil {
+			continue
+		}
+		attribute := raw.(map[string]interface{})
+
+		attributeId, err := stable.ParseIdentityUserFlowAttributeID(attribute["user_flow_attribute_id"].(string))
+		if err != nil {
+			return tf.ErrorDiagPathF(err, "attribute", "Parsing user flow attribute ID")
+		}
+
+		order = append(order, attributeId.IdentityUserFlowAttributeId)
+		desired[strings.ToLower(attributeId.IdentityUserFlowAttributeId)] = true
+
+		properties := stable.IdentityUserFlowAttributeAssignment{
+			DisplayName:          nullable.NoZero(attribute["display_name"].(string)),
+			IsOptional:           pointer.To(attribute["optional"].(bool)),
+			RequiresVerification: pointer.To(attribute["requires_verification"].(bool)),
+			UserAttributeValues:  expandUserFlowAttributeValues(attribute["value"].([]interface{})),
+		}
+		if v := attribute["input_type"].(string); v != "" {
+			properties.UserInputType = pointer.To(stable.IdentityUserFlowAttributeInputType(v))
+		}
+
+		if existing[strings.ToLower(attributeId.IdentityUserFlowAttributeId)] {
+			assignmentId := stable.NewIdentityB2xUserFlowIdUserAttributeAssignmentID(id.B2xIdentityUserFlowId, attributeId.IdentityUserFlowAttributeId)
+			if _, err = client.UpdateB2xUserFlowUserAttributeAssignment(ctx, assignmentId, properties, b2xuserflowuserattributeassignment.DefaultUpdateB2xUserFlowUserAttributeAssignmentOperationOptions()); err != nil {
+				return tf.ErrorDiagF(err, "Updating %s", assignmentId)
+			}
+			continue
+		}
+
+		var userAttribute stable.IdentityUserFlowAttribute = stable.BaseIdentityUserFlowAttributeImpl{
+			Id: pointer.To(attributeId.IdentityUserFlowAttributeId),
+		}
+		properties.UserAttribute = &userAttribute
+
+		if _, err = client.CreateB2xUserFlowUserAttributeAssignment(ctx, id, properties, b2xuserflowuserattributeassignment.DefaultCreateB2xUserFlowUserAttributeAssignmentOperationOptions()); err != nil {
+			return tf.ErrorDiagF(err, "Assigning %s to %s", attributeId, id)
+		}
+	}
+
+	for _, assignment := range pointer.From(resp.Model) {
+		if assignment.Id == nil || desired[strings.ToLower(*assignment.Id)] {
+			continue
+		}
+
+		assignmentId := stable.NewIdentityB2xUserFlowIdUserAttributeAssignmentID(id.B2xIdentityUserFlowId, *assignment.Id)
+		if _, err = client.DeleteB2xUserFlowUserAttributeAssignment(ctx, assignmentId, b2xuserflowuserattributeassignment.DefaultDeleteB2xUserFlowUserAttributeAssignmentOperationOptions()); err != nil {
+			return tf.ErrorDiagF(err, "Removing %s", assignmentId)
+		}
+	}
+
+	request := b2xuserflowuserattributeassignment.SetB2xUserFlowUserAttributeAssignmentsOrderRequest{
+		NewAssignmentOrder: &stable.AssignmentOrder{
+			Order: &order,
+		},
+	}
+	if _, err = client.SetB2xUserFlowUserAttributeAssignmentsOrder(ctx, id, request, b2xuserflowuserattributeassignment.DefaultSetB2xUserFlowUserAttributeAssignmentsOrderOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Setting the order of user attribute assignments for %s", id)
+	}
+
+	return nil
+}
+
+func userFlowResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	c := meta.(*clients.Client).UserFlows
+
+	id, err := stable.ParseIdentityB2xUserFlowID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing ID")
+	}
+
+	resp, err := c.B2xUserFlowClient.GetB2xUserFlow(ctx, *id, b2xuserflow.DefaultGetB2xUserFlowOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", id)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving %s", id)
+	}
+
+	if resp.Model == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", id)
+	}
+
+	tf.Set(d, "name", strings.TrimPrefix(id.B2xIdentityUserFlowId, "B2X_1_"))
+	tf.Set(d, "user_flow_type", string(pointer.From(resp.Model.UserFlowType)))
+
+	providersResp, err := c.B2xUserFlowIdentityProviderClient.ListB2xUserFlowIdentityProviders(ctx, *id, b2xuserflowuserflowidentityprovider.DefaultListB2xUserFlowIdentityProvidersOperationOptions())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving identity providers for %s", id)
+	}
+	identityProviderIds := make([]string, 0)
+	for _, provider := range pointer.From(providersResp.Model) {
+		if providerId := provider.IdentityProviderBase().Id; providerId != nil {
+			identityProviderIds = append(identityProviderIds, stable.NewIdentityIdentityProviderID(*providerId).ID())
+		}
+	}
+	tf.Set(d, "identity_provider_ids", identityProviderIds)
+
+	assignmentsResp, err := c.B2xUserFlowUserAttributeAssignmentClient.ListB2xUserFlowUserAttributeAssignments(ctx, *id, b2xuserflowuserattributeassignment.DefaultListB2xUserFlowUserAttributeAssignmentsOperationOptions())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving user attribute assignments for %s", id)
+	}
+	tf.Set(d, "attribute", flattenUserFlowAttributeAssignments(pointer.From(assignmentsResp.Model)))
+
+	// Only the languages specified in the configuration are tracked, since every user flow includes all supported languages
+	if v, ok := d.GetOk("language"); ok {
+		languagesResp, err := c.B2xUserFlowLanguageClient.ListB2xUserFlowLanguages(ctx, *id, b2xuserflowlanguage.DefaultListB2xUserFlowLanguagesOperationOptions())
+		if err != nil {
+			return tf.ErrorDiagF(err, "Retrieving languages for %s", id)
+		}
+		languages, err := flattenUserFlowLanguages(pointer.From(languagesResp.Model), v.(*pluginsdk.Set).List())
+		if err != nil {
+			return tf.ErrorDiagPathF(err, "language", "Retrieving languages for %s", id)
+		}
+		tf.Set(d, "language", languages)
+	}
+
+	postAttributeCollectionApiConnectorId := ""
+	if connectorResp, err := c.B2xUserFlowPostAttributeCollectionClient.GetB2xUserFlowApiConnectorConfigurationPostAttributeCollection(ctx, *id, b2xuserflowapiconnectorconfigurationpostattributecollection.DefaultGetB2xUserFlowApiConnectorConfigurationPostAttributeCollectionOperationOptions()); err != nil {
+		if !response.WasNotFound(connectorResp.HttpResponse) {
+			return tf.ErrorDiagF(err, "Retrieving post attribute collection API connector for %s", id)
+		}
+	} else if connectorResp.Model != nil && connectorResp.Model.Id != nil {
+		postAttributeCollectionApiConnectorId = stable.NewIdentityApiConnectorID(*connectorResp.Model.Id).ID()
+	}
+	tf.Set(d, "post_attribute_collection_api_connector_id", postAttributeCollectionApiConnectorId)
+
+	postFederationSignupApiConnectorId := ""
+	if connectorResp, err := c.B2xUserFlowPostFederationSignupClient.GetB2xUserFlowApiConnectorConfigurationPostFederationSignup(ctx, *id, b2xuserflowapiconnectorconfigurationpostfederationsignup.DefaultGetB2xUserFlowApiConnectorConfigurationPostFederationSignupOperationOptions()); err != nil {
+		if !response.WasNotFound(connectorResp.HttpResponse) {
+			return tf.ErrorDiagF(err, "Retrieving post federation sign-up API connector for %s", id)
+		}
+	} else if connectorResp.Model != nil && connectorResp.Model.Id != nil {
+		postFederationSignupApiConnectorId = stable.NewIdentityApiConnectorID(*connectorResp.Model.Id).ID()
+	}
+	tf.Set(d, "post_federation_signup_api_connector_id", postFederationSignupApiConnectorId)
+
+	return nil
+}
+
+func userFlowResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).UserFlows.B2xUserFlowClient
+
+	id, err := stable.ParseIdentityB2xUserFlowID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing ID")
+	}
+
+	if _, err := client.DeleteB2xUserFlow(ctx, *id, b2xuserflow.DefaultDeleteB2xUserFlowOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Deleting %s", id)
+	}
+
+	if err := consistency.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+		if resp, err := client.GetB2xUserFlow(ctx, *id, b2xuserflow.DefaultGetB2xUserFlowOperationOptions()); err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+		return pointer.To(true), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for deletion of %s", id)
+	}
+
+	return nil
+}
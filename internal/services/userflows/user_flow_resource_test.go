@@ -0,0 +1,256 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package userflows_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identity/stable/b2xuserflow"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+)
+
+type UserFlowResource struct{}
+
+func TestAccUserFlowResource_serialised(t *testing.T) {
+	testCases := map[string]map[string]func(t *testing.T){
+		"basic": {
+			"basic": testAccUserFlow_basic,
+		},
+		"complete": {
+			"complete": testAccUserFlow_complete,
+		},
+		"updates": {
+			"update": testAccUserFlow_update,
+		},
+		"requires_import": {
+			"requires_import": testAccUserFlow_requiresImport,
+		},
+	}
+
+	for group, m := range testCases {
+		m := m
+		t.Run(group, func(t *testing.T) {
+			for name, tc := range m {
+				tc := tc
+				t.Run(name, func(t *testing.T) {
+					tc(t)
+				})
+			}
+		})
+	}
+}
+
+func testAccUserFlow_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user_flow", "test")
+	r := UserFlowResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("user_flow_type").HasValue("signUpOrSignIn"),
+				check.That(data.ResourceName).Key("identity_provider_ids.#").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccUserFlow_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user_flow", "test")
+	r := UserFlowResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("identity_provider_ids.#").HasValue("1"),
+				check.That(data.ResourceName).Key("attribute.#").HasValue("3"),
+				check.That(data.ResourceName).Key("attribute.0.user_flow_attribute_id").HasValue("/identity/userFlowAttributes/email"),
+				check.That(data.ResourceName).Key("attribute.2.user_flow_attribute_id").MatchesOtherKey(check.That("azuread_user_flow_attribute.test").Key("id")),
+				check.That(data.ResourceName).Key("language.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("language"),
+	})
+}
+
+func testAccUserFlow_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user_flow", "test")
+	r := UserFlowResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("attribute.#").HasValue("3"),
+			),
+		},
+		data.ImportStep("language"),
+		{
+			Config: r.reordered(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("attribute.#").HasValue("2"),
+				check.That(data.ResourceName).Key("attribute.0.user_flow_attribute_id").MatchesOtherKey(check.That("azuread_user_flow_attribute.test").Key("id")),
+			),
+		},
+		data.ImportStep("language"),
+	})
+}
+
+func testAccUserFlow_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user_flow", "test")
+	r := UserFlowResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport(data)),
+	})
+}
+
+func (r UserFlowResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.UserFlows.B2xUserFlowClient
+
+	id, err := stable.ParseIdentityB2xUserFlowID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetB2xUserFlow(ctx, *id, b2xuserflow.DefaultGetB2xUserFlowOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+
+		return nil, fmt.Errorf("retrieving %s: %v", id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (r UserFlowResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_user_flow" "test" {
+  name = "acctestUserFlow%[1]s"
+}
+`, data.RandomString)
+}
+
+func (r UserFlowResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_user_flow_attribute" "test" {
+  display_name = "acctestUserFlowAttr%[1]s"
+  description  = "acctest description %[1]s"
+  data_type    = "string"
+}
+
+resource "azuread_user_flow" "test" {
+  name                  = "acctestUserFlow%[1]s"
+  identity_provider_ids = ["/identity/identityProviders/EmailPassword-OAUTH"]
+
+  attribute {
+    user_flow_attribute_id = "/identity/userFlowAttributes/email"
+    requires_verification  = true
+  }
+
+  attribute {
+    user_flow_attribute_id = "/identity/userFlowAttributes/city"
+    display_name           = "City"
+    optional               = true
+  }
+
+  attribute {
+    user_flow_attribute_id = azuread_user_flow_attribute.test.id
+    display_name           = "Partner tier"
+    input_type             = "radioSingleSelect"
+
+    value {
+      name    = "Gold"
+      value   = "gold"
+      default = true
+    }
+
+    value {
+      name  = "Silver"
+      value = "silver"
+    }
+  }
+
+  language {
+    language_id = "fr"
+    enabled     = false
+  }
+}
+`, data.RandomString)
+}
+
+func (r UserFlowResource) reordered(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_user_flow_attribute" "test" {
+  display_name = "acctestUserFlowAttr%[1]s"
+  description  = "acctest description %[1]s"
+  data_type    = "string"
+}
+
+resource "azuread_user_flow" "test" {
+  name                  = "acctestUserFlow%[1]s"
+  identity_provider_ids = ["/identity/identityProviders/EmailPassword-OAUTH"]
+
+  attribute {
+    user_flow_attribute_id = azuread_user_flow_attribute.test.id
+    display_name           = "Partner tier"
+  }
+
+  attribute {
+    user_flow_attribute_id = "/identity/userFlowAttributes/email"
+    requires_verification  = true
+  }
+
+  language {
+    language_id = "fr"
+    enabled     = true
+  }
+}
+`, data.RandomString)
+}
+
+func (r UserFlowResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_user_flow" "import" {
+  name = azuread_user_flow.test.name
+}
+`, r.basic(data))
+}
@@ -0,0 +1,96 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package userflows
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+)
+
+func expandUserFlowAttributeValues(in []interface{}) *[]stable.UserAttributeValuesItem {
+	if len(in) == 0 {
+		return nil
+	}
+
+	result := make([]stable.UserAttributeValuesItem, 0)
+	for _, raw := range in {
+		if raw == nil {
+			continue
+		}
+		value := raw.(map[string]interface{})
+
+		result = append(result, stable.UserAttributeValuesItem{
+			IsDefault: pointer.To(value["default"].(bool)),
+			Name:      nullable.Value(value["name"].(string)),
+			Value:     nullable.Value(value["value"].(string)),
+		})
+	}
+
+	return &result
+}
+
+func flattenUserFlowAttributeAssignments(in []stable.IdentityUserFlowAttributeAssignment) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0)
+
+	for _, assignment := range in {
+		if assignment.Id == nil {
+			continue
+		}
+
+		values := make([]map[string]interface{}, 0)
+		for _, value := range pointer.From(assignment.UserAttributeValues) {
+			values = append(values, map[string]interface{}{
+				"default": pointer.From(value.IsDefault),
+				"name":    value.Name.GetOrZero(),
+				"value":   value.Value.GetOrZero(),
+			})
+		}
+
+		result = append(result, map[string]interface{}{
+			"display_name":           assignment.DisplayName.GetOrZero(),
+			"input_type":             string(pointer.From(assignment.UserInputType)),
+			"optional":               pointer.From(assignment.IsOptional),
+			"requires_verification":  pointer.From(assignment.RequiresVerification),
+			"user_flow_attribute_id": stable.NewIdentityUserFlowAttributeID(*assignment.Id).ID(),
+			"value":                  values,
+		})
+	}
+
+	return result
+}
+
+// flattenUserFlowLanguages returns the languages for a user flow, limited to those which are specified in the
+// configuration. An error is returned for any specified language which is not supported by the user flow.
+func flattenUserFlowLanguages(in []stable.UserFlowLanguageConfiguration, configured []interface{}) ([]map[string]interface{}, error) {
+	result := make([]map[string]interface{}, 0)
+
+	for _, raw := range configured {
+		if raw == nil {
+			continue
+		}
+		languageId := raw.(map[string]interface{})["language_id"].(string)
+
+		found := false
+		for _, language := range in {
+			if language.Id != nil && strings.EqualFold(*language.Id, languageId) {
+				result = append(result, map[string]interface{}{
+					"enabled":     pointer.From(language.IsEnabled),
+					"language_id": languageId,
+				})
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return nil, fmt.Errorf("the language %q is not supported by the user flow", languageId)
+		}
+	}
+
+	return result, nil
+}
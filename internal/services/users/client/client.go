@@ -6,19 +6,35 @@ package client
 import (
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/me/stable/me"
 	userBeta "github.com/hashicorp/go-azure-sdk/microsoft-graph/users/beta/user"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/users/stable/authenticationphonemethod"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/users/stable/authenticationtemporaryaccesspassmethod"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/users/stable/manager"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/users/stable/user"
 	"github.com/hashicorp/terraform-provider-azuread/internal/common"
 )
 
 type Client struct {
-	ManagerClient  *manager.ManagerClient
-	MeClient       *me.MeClient
-	UserClient     *user.UserClient
-	UserClientBeta *userBeta.UserClient
+	AuthenticationPhoneMethodClient               *authenticationphonemethod.AuthenticationPhoneMethodClient
+	AuthenticationTemporaryAccessPassMethodClient *authenticationtemporaryaccesspassmethod.AuthenticationTemporaryAccessPassMethodClient
+	ManagerClient                                 *manager.ManagerClient
+	MeClient                                      *me.MeClient
+	UserClient                                    *user.UserClient
+	UserClientBeta                                *userBeta.UserClient
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
+	authenticationPhoneMethodClient, err := authenticationphonemethod.NewAuthenticationPhoneMethodClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(authenticationPhoneMethodClient.Client)
+
+	authenticationTemporaryAccessPassMethodClient, err := authenticationtemporaryaccesspassmethod.NewAuthenticationTemporaryAccessPassMethodClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(authenticationTemporaryAccessPassMethodClient.Client)
+
 	managerClient, err := manager.NewManagerClientWithBaseURI(o.Environment.MicrosoftGraph)
 	if err != nil {
 		return nil, err
@@ -45,6 +61,8 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	o.Configure(userClientBeta.Client)
 
 	return &Client{
+		AuthenticationPhoneMethodClient:               authenticationPhoneMethodClient,
+		AuthenticationTemporaryAccessPassMethodClient: authenticationTemporaryAccessPassMethodClient,
 		ManagerClient:  managerClient,
 		MeClient:       meClient,
 		UserClient:     userClient,
@@ -18,3 +18,5 @@ const (
 )
 
 var possibleValuesForConsentProvidedForMinor = []string{ConsentProvidedForMinorDenied, ConsentProvidedForMinorGranted, ConsentProvidedForMinorNotRequired}
+
+const userResourceName = "azuread_user"
@@ -0,0 +1,88 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = &UserLicenseAssignmentId{}
+
+// UserLicenseAssignmentId is the ID of a license directly assigned to a user, which is identified by its SKU ID
+type UserLicenseAssignmentId struct {
+	UserId string
+	SkuId  string
+}
+
+func NewUserLicenseAssignmentID(userId string, skuId string) UserLicenseAssignmentId {
+	return UserLicenseAssignmentId{
+		UserId: userId,
+		SkuId:  skuId,
+	}
+}
+
+// ParseUserLicenseAssignmentID parses 'input' into a UserLicenseAssignmentId
+func ParseUserLicenseAssignmentID(input string) (*UserLicenseAssignmentId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&UserLicenseAssignmentId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := UserLicenseAssignmentId{}
+	if err = id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+// ValidateUserLicenseAssignmentID checks that 'input' can be parsed as a UserLicenseAssignmentId
+func ValidateUserLicenseAssignmentID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseUserLicenseAssignmentID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+func (id *UserLicenseAssignmentId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.UserId, ok = input.Parsed["userId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "userId", input)
+	}
+
+	if id.SkuId, ok = input.Parsed["skuId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "skuId", input)
+	}
+
+	return nil
+}
+
+func (id UserLicenseAssignmentId) ID() string {
+	return fmt.Sprintf("/users/%s/assignedLicenses/%s", id.UserId, id.SkuId)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this ID
+func (id UserLicenseAssignmentId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("users", "users", "users"),
+		resourceids.UserSpecifiedSegment("userId", "userId"),
+		resourceids.StaticSegment("assignedLicenses", "assignedLicenses", "assignedLicenses"),
+		resourceids.UserSpecifiedSegment("skuId", "skuId"),
+	}
+}
+
+func (id UserLicenseAssignmentId) String() string {
+	return fmt.Sprintf("User License Assignment (User: %q, SKU: %q)", id.UserId, id.SkuId)
+}
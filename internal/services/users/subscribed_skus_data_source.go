@@ -0,0 +1,244 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package users
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/licenses"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/hashicorp/terraform-provider-azuread/internal/sdk"
+)
+
+type SubscribedSkusId string
+
+func (id SubscribedSkusId) ID() string {
+	return string(id)
+}
+
+func (SubscribedSkusId) String() string {
+	return "Subscribed SKUs"
+}
+
+type SubscribedSkusDataSourceModel struct {
+	SkuPartNumber string          `tfschema:"sku_part_number"`
+	Skus          []SubscribedSku `tfschema:"skus"`
+}
+
+type SubscribedSku struct {
+	AppliesTo        string        `tfschema:"applies_to"`
+	CapabilityStatus string        `tfschema:"capability_status"`
+	ConsumedUnits    int64         `tfschema:"consumed_units"`
+	EnabledUnits     int64         `tfschema:"enabled_units"`
+	ServicePlans     []ServicePlan `tfschema:"service_plans"`
+	SkuId            string        `tfschema:"sku_id"`
+	SkuPartNumber    string        `tfschema:"sku_part_number"`
+	SuspendedUnits   int64         `tfschema:"suspended_units"`
+	WarningUnits     int64         `tfschema:"warning_units"`
+}
+
+type ServicePlan struct {
+	AppliesTo          string `tfschema:"applies_to"`
+	ProvisioningStatus string `tfschema:"provisioning_status"`
+	ServicePlanId      string `tfschema:"service_plan_id"`
+	ServicePlanName    string `tfschema:"service_plan_name"`
+}
+
+type SubscribedSkusDataSource struct{}
+
+var _ sdk.DataSource = SubscribedSkusDataSource{}
+
+func (r SubscribedSkusDataSource) ResourceType() string {
+	return "azuread_subscribed_skus"
+}
+
+func (r SubscribedSkusDataSource) ModelObject() interface{} {
+	return &SubscribedSkusDataSourceModel{}
+}
+
+func (r SubscribedSkusDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"sku_part_number": {
+			Description:  "The part number of a SKU to return, e.g. `ENTERPRISEPACK`. When not specified, all subscribed SKUs are returned",
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r SubscribedSkusDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"skus": {
+			Description: "A list of commercial subscriptions acquired by the tenant",
+			Type:        pluginsdk.TypeList,
+			Computed:    true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"applies_to": {
+						Description: "The target class for this SKU, either `User` or `Company`",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"capability_status": {
+						Description: "The status of the SKU, e.g. `Enabled`, `Warning`, `Suspended`, `Deleted` or `LockedOut`",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"consumed_units": {
+						Description: "The number of licenses that have been assigned",
+						Type:        pluginsdk.TypeInt,
+						Computed:    true,
+					},
+
+					"enabled_units": {
+						Description: "The number of units that are enabled for the active subscription",
+						Type:        pluginsdk.TypeInt,
+						Computed:    true,
+					},
+
+					"service_plans": {
+						Description: "A list of service plans included in the SKU",
+						Type:        pluginsdk.TypeList,
+						Computed:    true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"applies_to": {
+									Description: "The object the service plan can be assigned to, either `User` or `Company`",
+									Type:        pluginsdk.TypeString,
+									Computed:    true,
+								},
+
+								"provisioning_status": {
+									Description: "The provisioning status of the service plan",
+									Type:        pluginsdk.TypeString,
+									Computed:    true,
+								},
+
+								"service_plan_id": {
+									Description: "The ID of the service plan",
+									Type:        pluginsdk.TypeString,
+									Computed:    true,
+								},
+
+								"service_plan_name": {
+									Description: "The name of the service plan",
+									Type:        pluginsdk.TypeString,
+									Computed:    true,
+								},
+							},
+						},
+					},
+
+					"sku_id": {
+						Description: "The ID of the SKU",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"sku_part_number": {
+						Description: "The part number of the SKU, e.g. `ENTERPRISEPACK`",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"suspended_units": {
+						Description: "The number of units that are suspended because the subscription has been cancelled",
+						Type:        pluginsdk.TypeInt,
+						Computed:    true,
+					},
+
+					"warning_units": {
+						Description: "The number of units that are in a warning state and will be suspended unless the subscription is renewed",
+						Type:        pluginsdk.TypeInt,
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r SubscribedSkusDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Users.UserClient
+			tenantId := metadata.Client.TenantID
+
+			var state SubscribedSkusDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			result, err := licenses.ListSubscribedSkus(ctx, client.Client)
+			if err != nil {
+				return fmt.Errorf("listing subscribed SKUs: %+v", err)
+			}
+			if result == nil {
+				return fmt.Errorf("listing subscribed SKUs: result was nil")
+			}
+
+			skuIds := make([]string, 0)
+			state.Skus = make([]SubscribedSku, 0)
+
+			for _, sku := range *result {
+				if state.SkuPartNumber != "" && !strings.EqualFold(sku.SkuPartNumber.GetOrZero(), state.SkuPartNumber) {
+					continue
+				}
+
+				servicePlans := make([]ServicePlan, 0)
+				for _, plan := range pointer.From(sku.ServicePlans) {
+					servicePlans = append(servicePlans, ServicePlan{
+						AppliesTo:          plan.AppliesTo.GetOrZero(),
+						ProvisioningStatus: plan.ProvisioningStatus.GetOrZero(),
+						ServicePlanId:      plan.ServicePlanId.GetOrZero(),
+						ServicePlanName:    plan.ServicePlanName.GetOrZero(),
+					})
+				}
+
+				subscribedSku := SubscribedSku{
+					AppliesTo:        sku.AppliesTo.GetOrZero(),
+					CapabilityStatus: sku.CapabilityStatus.GetOrZero(),
+					ConsumedUnits:    sku.ConsumedUnits.GetOrZero(),
+					ServicePlans:     servicePlans,
+					SkuId:            sku.SkuId.GetOrZero(),
+					SkuPartNumber:    sku.SkuPartNumber.GetOrZero(),
+				}
+
+				if units := sku.PrepaidUnits; units != nil {
+					subscribedSku.EnabledUnits = units.Enabled.GetOrZero()
+					subscribedSku.SuspendedUnits = units.Suspended.GetOrZero()
+					subscribedSku.WarningUnits = units.Warning.GetOrZero()
+				}
+
+				skuIds = append(skuIds, subscribedSku.SkuId)
+				state.Skus = append(state.Skus, subscribedSku)
+			}
+
+			if state.SkuPartNumber != "" && len(state.Skus) == 0 {
+				return fmt.Errorf("no subscribed SKU was found with the part number %q", state.SkuPartNumber)
+			}
+
+			// Generate a unique ID based on result
+			h := sha1.New()
+			if _, err := h.Write([]byte(strings.Join(skuIds, "/"))); err != nil {
+				return fmt.Errorf("unable to compute hash for SKU IDs: %+v", err)
+			}
+
+			metadata.SetID(SubscribedSkusId(fmt.Sprintf("subscribedSkus#%s#%s", tenantId, base64.URLEncoding.EncodeToString(h.Sum(nil)))))
+
+			return metadata.Encode(&state)
+		},
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package users_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type SubscribedSkusDataSource struct{}
+
+func TestAccSubscribedSkusDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_subscribed_skus", "test")
+	r := SubscribedSkusDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("skus.#").Exists(),
+				check.That(data.ResourceName).Key("skus.0.sku_id").IsUuid(),
+				check.That(data.ResourceName).Key("skus.0.sku_part_number").Exists(),
+				check.That(data.ResourceName).Key("skus.0.service_plans.#").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccSubscribedSkusDataSource_bySkuPartNumber(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_subscribed_skus", "test")
+	r := SubscribedSkusDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.bySkuPartNumber(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("skus.#").HasValue("1"),
+				check.That(data.ResourceName).Key("skus.0.sku_id").IsUuid(),
+			),
+		},
+	})
+}
+
+func (SubscribedSkusDataSource) basic() string {
+	return `
+provider "azuread" {}
+
+data "azuread_subscribed_skus" "test" {}
+`
+}
+
+func (r SubscribedSkusDataSource) bySkuPartNumber() string {
+	return `
+provider "azuread" {}
+
+data "azuread_subscribed_skus" "all" {}
+
+data "azuread_subscribed_skus" "test" {
+  sku_part_number = data.azuread_subscribed_skus.all.skus.0.sku_part_number
+}
+`
+}
@@ -0,0 +1,305 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package users
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/users/stable/user"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/licenses"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/hashicorp/terraform-provider-azuread/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/users/parse"
+)
+
+type UserLicenseAssignmentModel struct {
+	DisabledServicePlans []string `tfschema:"disabled_service_plans"`
+	SkuId                string   `tfschema:"sku_id"`
+	SkuPartNumber        string   `tfschema:"sku_part_number"`
+	UserId               string   `tfschema:"user_id"`
+}
+
+var _ sdk.ResourceWithUpdate = UserLicenseAssignmentResource{}
+
+type UserLicenseAssignmentResource struct{}
+
+func (r UserLicenseAssignmentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return parse.ValidateUserLicenseAssignmentID
+}
+
+func (r UserLicenseAssignmentResource) ResourceType() string {
+	return "azuread_user_license_assignment"
+}
+
+func (r UserLicenseAssignmentResource) ModelObject() interface{} {
+	return &UserLicenseAssignmentModel{}
+}
+
+func (r UserLicenseAssignmentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"user_id": {
+			Description:  "The resource ID of the user to which the license is assigned",
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: stable.ValidateUserID,
+		},
+
+		"sku_id": {
+			Description:  "The ID of the SKU to assign",
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ExactlyOneOf: []string{"sku_id", "sku_part_number"},
+			ValidateFunc: validation.IsUUID,
+		},
+
+		"sku_part_number": {
+			Description:  "The part number of the SKU to assign, e.g. `ENTERPRISEPACK`",
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ExactlyOneOf: []string{"sku_id", "sku_part_number"},
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"disabled_service_plans": {
+			Description: "A set of service plan names to disable for this license",
+			Type:        pluginsdk.TypeSet,
+			Optional:    true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func (r UserLicenseAssignmentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r UserLicenseAssignmentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Users.UserClient
+
+			var model UserLicenseAssignmentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			userId, err := stable.ParseUserID(model.UserId)
+			if err != nil {
+				return err
+			}
+
+			sku, err := licenses.FindSubscribedSku(ctx, client.Client, model.SkuId, model.SkuPartNumber)
+			if err != nil {
+				return err
+			}
+
+			disabledPlans, err := licenses.ExpandServicePlans(*sku, model.DisabledServicePlans)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewUserLicenseAssignmentID(userId.UserId, sku.SkuId.GetOrZero())
+
+			tf.LockByName(userResourceName, id.UserId)
+			defer tf.UnlockByName(userResourceName, id.UserId)
+
+			existing, err := findUserAssignedLicense(ctx, client, id)
+			if err != nil {
+				return err
+			}
+			if existing != nil {
+				return tf.ImportAsExistsError(r.ResourceType(), id.ID())
+			}
+
+			request := user.AssignLicenseRequest{
+				AddLicenses: &[]stable.AssignedLicense{
+					{
+						SkuId:         nullable.Value(id.SkuId),
+						DisabledPlans: disabledPlans,
+					},
+				},
+				RemoveLicenses: &[]string{},
+			}
+
+			if _, err = client.AssignLicense(ctx, *userId, request, user.DefaultAssignLicenseOperationOptions()); err != nil {
+				return fmt.Errorf("assigning license %q to %s: %+v", sku.SkuPartNumber.GetOrZero(), userId, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r UserLicenseAssignmentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Users.UserClient
+
+			id, err := parse.ParseUserLicenseAssignmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			license, err := findUserAssignedLicense(ctx, client, *id)
+			if err != nil {
+				return err
+			}
+			if license == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			sku, err := licenses.FindSubscribedSku(ctx, client.Client, id.SkuId, "")
+			if err != nil {
+				return err
+			}
+
+			// SKU part numbers and service plan names are matched case-insensitively, so retain the configured casing
+			var existing UserLicenseAssignmentModel
+			if err = metadata.Decode(&existing); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			skuPartNumber := sku.SkuPartNumber.GetOrZero()
+			if strings.EqualFold(existing.SkuPartNumber, skuPartNumber) {
+				skuPartNumber = existing.SkuPartNumber
+			}
+
+			state := UserLicenseAssignmentModel{
+				DisabledServicePlans: licenses.FlattenServicePlans(*sku, license.DisabledPlans, existing.DisabledServicePlans),
+				SkuId:                id.SkuId,
+				SkuPartNumber:        skuPartNumber,
+				UserId:               stable.NewUserID(id.UserId).ID(),
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r UserLicenseAssignmentResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Users.UserClient
+
+			id, err := parse.ParseUserLicenseAssignmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model UserLicenseAssignmentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			sku, err := licenses.FindSubscribedSku(ctx, client.Client, id.SkuId, "")
+			if err != nil {
+				return err
+			}
+
+			disabledPlans, err := licenses.ExpandServicePlans(*sku, model.DisabledServicePlans)
+			if err != nil {
+				return err
+			}
+
+			tf.LockByName(userResourceName, id.UserId)
+			defer tf.UnlockByName(userResourceName, id.UserId)
+
+			// Assigning a license which is already assigned replaces its disabled plans
+			request := user.AssignLicenseRequest{
+				AddLicenses: &[]stable.AssignedLicense{
+					{
+						SkuId:         nullable.Value(id.SkuId),
+						DisabledPlans: disabledPlans,
+					},
+				},
+				RemoveLicenses: &[]string{},
+			}
+
+			if _, err = client.AssignLicense(ctx, stable.NewUserID(id.UserId), request, user.DefaultAssignLicenseOperationOptions()); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r UserLicenseAssignmentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Users.UserClient
+
+			id, err := parse.ParseUserLicenseAssignmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			tf.LockByName(userResourceName, id.UserId)
+			defer tf.UnlockByName(userResourceName, id.UserId)
+
+			request := user.AssignLicenseRequest{
+				AddLicenses:    &[]stable.AssignedLicense{},
+				RemoveLicenses: &[]string{id.SkuId},
+			}
+
+			if _, err = client.AssignLicense(ctx, stable.NewUserID(id.UserId), request, user.DefaultAssignLicenseOperationOptions()); err != nil {
+				return fmt.Errorf("removing %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// findUserAssignedLicense returns the assignment state for the license with the specified SKU which is directly assigned
+// to a user, or nil if the user or license was not found. Licenses inherited through group-based licensing are ignored.
+func findUserAssignedLicense(ctx context.Context, client *user.UserClient, id parse.UserLicenseAssignmentId) (*stable.LicenseAssignmentState, error) {
+	userId := stable.NewUserID(id.UserId)
+
+	options := user.GetUserOperationOptions{
+		Select: &[]string{"id", "licenseAssignmentStates"},
+	}
+
+	resp, err := client.GetUser(ctx, userId, options)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", userId, err)
+	}
+	if resp.Model == nil {
+		return nil, fmt.Errorf("retrieving %s: model was nil", userId)
+	}
+
+	for _, state := range pointer.From(resp.Model.LicenseAssignmentStates) {
+		if strings.EqualFold(state.SkuId.GetOrZero(), id.SkuId) && state.AssignedByGroup.GetOrZero() == "" {
+			return &state, nil
+		}
+	}
+
+	return nil, nil
+}
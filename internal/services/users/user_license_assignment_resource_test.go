@@ -0,0 +1,165 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package users_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/users/stable/user"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/users/parse"
+)
+
+type UserLicenseAssignmentResource struct{}
+
+func TestAccUserLicenseAssignment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user_license_assignment", "test")
+	r := UserLicenseAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("sku_id").IsUuid(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccUserLicenseAssignment_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user_license_assignment", "test")
+	r := UserLicenseAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.disabledServicePlans(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("disabled_service_plans.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("disabled_service_plans.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccUserLicenseAssignment_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user_license_assignment", "test")
+	r := UserLicenseAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport(data)),
+	})
+}
+
+func (r UserLicenseAssignmentResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Users.UserClient
+
+	id, err := parse.ParseUserLicenseAssignmentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetUser(ctx, stable.NewUserID(id.UserId), user.GetUserOperationOptions{Select: &[]string{"id", "licenseAssignmentStates"}})
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to retrieve %s: %v", id, err)
+	}
+
+	if resp.Model != nil {
+		for _, license := range pointer.From(resp.Model.LicenseAssignmentStates) {
+			if strings.EqualFold(license.SkuId.GetOrZero(), id.SkuId) && license.AssignedByGroup.GetOrZero() == "" {
+				return pointer.To(true), nil
+			}
+		}
+	}
+
+	return pointer.To(false), nil
+}
+
+func (UserLicenseAssignmentResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+data "azuread_subscribed_skus" "test" {}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser'%[1]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+  usage_location      = "US"
+}
+`, data.RandomInteger, data.RandomPassword)
+}
+
+func (r UserLicenseAssignmentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_user_license_assignment" "test" {
+  user_id         = azuread_user.test.id
+  sku_part_number = data.azuread_subscribed_skus.test.skus.0.sku_part_number
+}
+`, r.template(data))
+}
+
+func (r UserLicenseAssignmentResource) disabledServicePlans(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_user_license_assignment" "test" {
+  user_id                = azuread_user.test.id
+  sku_part_number        = data.azuread_subscribed_skus.test.skus.0.sku_part_number
+  disabled_service_plans = [data.azuread_subscribed_skus.test.skus.0.service_plans.0.service_plan_name]
+}
+`, r.template(data))
+}
+
+func (r UserLicenseAssignmentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_user_license_assignment" "import" {
+  user_id = azuread_user_license_assignment.test.user_id
+  sku_id  = azuread_user_license_assignment.test.sku_id
+}
+`, r.basic(data))
+}
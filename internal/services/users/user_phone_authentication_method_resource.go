@@ -0,0 +1,230 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package users
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/users/stable/authenticationphonemethod"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/hashicorp/terraform-provider-azuread/internal/sdk"
+)
+
+type UserPhoneAuthenticationMethodModel struct {
+	PhoneNumber    string `tfschema:"phone_number"`
+	PhoneType      string `tfschema:"phone_type"`
+	SmsSignInState string `tfschema:"sms_sign_in_state"`
+	UserId         string `tfschema:"user_id"`
+}
+
+var _ sdk.ResourceWithUpdate = UserPhoneAuthenticationMethodResource{}
+
+type UserPhoneAuthenticationMethodResource struct{}
+
+func (r UserPhoneAuthenticationMethodResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return stable.ValidateUserIdAuthenticationPhoneMethodID
+}
+
+func (r UserPhoneAuthenticationMethodResource) ResourceType() string {
+	return "azuread_user_phone_authentication_method"
+}
+
+func (r UserPhoneAuthenticationMethodResource) ModelObject() interface{} {
+	return &UserPhoneAuthenticationMethodModel{}
+}
+
+func (r UserPhoneAuthenticationMethodResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"user_id": {
+			Description:  "The resource ID of the user for whom to register the phone number",
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: stable.ValidateUserID,
+		},
+
+		"phone_type": {
+			Description:  "The type of phone number to register",
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(stable.PossibleValuesForAuthenticationPhoneType(), false),
+		},
+
+		"phone_number": {
+			Description: "The phone number, in the format `+{country code} {number}x{extension}`, e.g. `+1 5555551234`",
+			Type:        pluginsdk.TypeString,
+			Required:    true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^\+\d{1,3} \d+(x\d+)?$`),
+				"must be in the format `+{country code} {number}x{extension}`, with the extension being optional",
+			),
+		},
+	}
+}
+
+func (r UserPhoneAuthenticationMethodResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"sms_sign_in_state": {
+			Description: "Whether a mobile phone number is ready to be used for SMS sign-in",
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+		},
+	}
+}
+
+func (r UserPhoneAuthenticationMethodResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Users.AuthenticationPhoneMethodClient
+
+			var model UserPhoneAuthenticationMethodModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			userId, err := stable.ParseUserID(model.UserId)
+			if err != nil {
+				return err
+			}
+
+			tf.LockByName(userResourceName, userId.UserId)
+			defer tf.UnlockByName(userResourceName, userId.UserId)
+
+			// Each user can only have one phone number of each type
+			existing, err := client.ListAuthenticationPhoneMethods(ctx, *userId, authenticationphonemethod.DefaultListAuthenticationPhoneMethodsOperationOptions())
+			if err != nil {
+				return fmt.Errorf("listing phone authentication methods for %s: %+v", userId, err)
+			}
+			for _, method := range pointer.From(existing.Model) {
+				if string(pointer.From(method.PhoneType)) == model.PhoneType && method.Id != nil {
+					return tf.ImportAsExistsError(r.ResourceType(), stable.NewUserIdAuthenticationPhoneMethodID(userId.UserId, *method.Id).ID())
+				}
+			}
+
+			properties := stable.PhoneAuthenticationMethod{
+				PhoneNumber: nullable.Value(model.PhoneNumber),
+				PhoneType:   pointer.To(stable.AuthenticationPhoneType(model.PhoneType)),
+			}
+
+			resp, err := client.CreateAuthenticationPhoneMethod(ctx, *userId, properties, authenticationphonemethod.DefaultCreateAuthenticationPhoneMethodOperationOptions())
+			if err != nil {
+				return fmt.Errorf("creating phone authentication method for %s: %+v", userId, err)
+			}
+
+			method := resp.Model
+			if method == nil {
+				return fmt.Errorf("creating phone authentication method for %s: model was nil", userId)
+			}
+			if method.Id == nil {
+				return errors.New("creating phone authentication method: model returned with nil ID")
+			}
+
+			id := stable.NewUserIdAuthenticationPhoneMethodID(userId.UserId, *method.Id)
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r UserPhoneAuthenticationMethodResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Users.AuthenticationPhoneMethodClient
+
+			id, err := stable.ParseUserIdAuthenticationPhoneMethodID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.GetAuthenticationPhoneMethod(ctx, *id, authenticationphonemethod.DefaultGetAuthenticationPhoneMethodOperationOptions())
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			method := resp.Model
+			if method == nil {
+				return fmt.Errorf("retrieving %s: model was nil", id)
+			}
+
+			state := UserPhoneAuthenticationMethodModel{
+				PhoneNumber:    method.PhoneNumber.GetOrZero(),
+				PhoneType:      string(pointer.From(method.PhoneType)),
+				SmsSignInState: string(pointer.From(method.SmsSignInState)),
+				UserId:         stable.NewUserID(id.UserId).ID(),
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r UserPhoneAuthenticationMethodResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Users.AuthenticationPhoneMethodClient
+
+			id, err := stable.ParseUserIdAuthenticationPhoneMethodID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model UserPhoneAuthenticationMethodModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			properties := stable.PhoneAuthenticationMethod{
+				PhoneNumber: nullable.Value(model.PhoneNumber),
+				PhoneType:   pointer.To(stable.AuthenticationPhoneType(model.PhoneType)),
+			}
+
+			if _, err = client.UpdateAuthenticationPhoneMethod(ctx, *id, properties, authenticationphonemethod.DefaultUpdateAuthenticationPhoneMethodOperationOptions()); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r UserPhoneAuthenticationMethodResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Users.AuthenticationPhoneMethodClient
+
+			id, err := stable.ParseUserIdAuthenticationPhoneMethodID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			tf.LockByName(userResourceName, id.UserId)
+			defer tf.UnlockByName(userResourceName, id.UserId)
+
+			if _, err = client.DeleteAuthenticationPhoneMethod(ctx, *id, authenticationphonemethod.DefaultDeleteAuthenticationPhoneMethodOperationOptions()); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package users_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/users/stable/authenticationphonemethod"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+)
+
+type UserPhoneAuthenticationMethodResource struct{}
+
+func TestAccUserPhoneAuthenticationMethod_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user_phone_authentication_method", "test")
+	r := UserPhoneAuthenticationMethodResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "+1 5555551234"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("phone_number").HasValue("+1 5555551234"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccUserPhoneAuthenticationMethod_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user_phone_authentication_method", "test")
+	r := UserPhoneAuthenticationMethodResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "+1 5555551234"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, "+44 7700900123"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("phone_number").HasValue("+44 7700900123"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccUserPhoneAuthenticationMethod_office(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user_phone_authentication_method", "test")
+	r := UserPhoneAuthenticationMethodResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.office(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r UserPhoneAuthenticationMethodResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Users.AuthenticationPhoneMethodClient
+
+	id, err := stable.ParseUserIdAuthenticationPhoneMethodID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetAuthenticationPhoneMethod(ctx, *id, authenticationphonemethod.DefaultGetAuthenticationPhoneMethodOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to retrieve %s: %v", id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (UserPhoneAuthenticationMethodResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser'%[1]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+}
+`, data.RandomInteger, data.RandomPassword)
+}
+
+func (r UserPhoneAuthenticationMethodResource) basic(data acceptance.TestData, phoneNumber string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_user_phone_authentication_method" "test" {
+  user_id      = azuread_user.test.id
+  phone_type   = "mobile"
+  phone_number = "%[2]s"
+}
+`, r.template(data), phoneNumber)
+}
+
+func (r UserPhoneAuthenticationMethodResource) office(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_user_phone_authentication_method" "test" {
+  user_id      = azuread_user.test.id
+  phone_type   = "office"
+  phone_number = "+1 5555550100x123"
+}
+`, r.template(data))
+}
@@ -0,0 +1,231 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package users
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/users/stable/authenticationtemporaryaccesspassmethod"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/suppress"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/hashicorp/terraform-provider-azuread/internal/sdk"
+)
+
+type UserTemporaryAccessPassModel struct {
+	CreatedDateTime       string `tfschema:"created_date_time"`
+	LifetimeInMinutes     int64  `tfschema:"lifetime_in_minutes"`
+	MethodUsabilityReason string `tfschema:"method_usability_reason"`
+	StartDateTime         string `tfschema:"start_date_time"`
+	TemporaryAccessPass   string `tfschema:"temporary_access_pass"`
+	Usable                bool   `tfschema:"usable"`
+	UsableOnce            bool   `tfschema:"usable_once"`
+	UserId                string `tfschema:"user_id"`
+}
+
+var _ sdk.Resource = UserTemporaryAccessPassResource{}
+
+type UserTemporaryAccessPassResource struct{}
+
+func (r UserTemporaryAccessPassResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return stable.ValidateUserIdAuthenticationTemporaryAccessPassMethodID
+}
+
+func (r UserTemporaryAccessPassResource) ResourceType() string {
+	return "azuread_user_temporary_access_pass"
+}
+
+func (r UserTemporaryAccessPassResource) ModelObject() interface{} {
+	return &UserTemporaryAccessPassModel{}
+}
+
+func (r UserTemporaryAccessPassResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"user_id": {
+			Description:  "The resource ID of the user for whom to issue the Temporary Access Pass",
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: stable.ValidateUserID,
+		},
+
+		"lifetime_in_minutes": {
+			Description:  "The lifetime of the Temporary Access Pass in minutes, between 10 minutes and 30 days. Defaults to the lifetime configured in the authentication methods policy",
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IntBetween(10, 43200),
+		},
+
+		"start_date_time": {
+			Description:      "The date and time when the Temporary Access Pass becomes available to use. Defaults to the time of creation",
+			Type:             pluginsdk.TypeString,
+			Optional:         true,
+			Computed:         true,
+			ForceNew:         true,
+			ValidateFunc:     validation.IsRFC3339Time,
+			DiffSuppressFunc: suppress.RFC3339Time,
+		},
+
+		"usable_once": {
+			Description: "Whether the Temporary Access Pass is limited to a one-time use",
+			Type:        pluginsdk.TypeBool,
+			Optional:    true,
+			ForceNew:    true,
+			Default:     false,
+		},
+	}
+}
+
+func (r UserTemporaryAccessPassResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"created_date_time": {
+			Description: "The date and time when the Temporary Access Pass was created",
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+		},
+
+		"method_usability_reason": {
+			Description: "Details about the usability state of the Temporary Access Pass",
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+		},
+
+		"temporary_access_pass": {
+			Description: "The Temporary Access Pass used to authenticate, which is only available upon creation",
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+			Sensitive:   true,
+		},
+
+		"usable": {
+			Description: "Whether the Temporary Access Pass is currently usable",
+			Type:        pluginsdk.TypeBool,
+			Computed:    true,
+		},
+	}
+}
+
+func (r UserTemporaryAccessPassResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Users.AuthenticationTemporaryAccessPassMethodClient
+
+			var model UserTemporaryAccessPassModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			userId, err := stable.ParseUserID(model.UserId)
+			if err != nil {
+				return err
+			}
+
+			properties := stable.TemporaryAccessPassAuthenticationMethod{
+				IsUsableOnce:      nullable.Value(model.UsableOnce),
+				LifetimeInMinutes: nullable.NoZero(model.LifetimeInMinutes),
+				StartDateTime:     nullable.NoZero(model.StartDateTime),
+			}
+
+			resp, err := client.CreateAuthenticationTemporaryAccessPassMethod(ctx, *userId, properties, authenticationtemporaryaccesspassmethod.DefaultCreateAuthenticationTemporaryAccessPassMethodOperationOptions())
+			if err != nil {
+				return fmt.Errorf("creating Temporary Access Pass for %s: %+v", userId, err)
+			}
+
+			method := resp.Model
+			if method == nil {
+				return fmt.Errorf("creating Temporary Access Pass for %s: model was nil", userId)
+			}
+			if method.Id == nil {
+				return errors.New("creating Temporary Access Pass: model returned with nil ID")
+			}
+
+			id := stable.NewUserIdAuthenticationTemporaryAccessPassMethodID(userId.UserId, *method.Id)
+			metadata.SetID(id)
+
+			// The pass is only returned when it is created, so it must be recorded now
+			if err = metadata.ResourceData.Set("temporary_access_pass", method.TemporaryAccessPass.GetOrZero()); err != nil {
+				return fmt.Errorf("setting `temporary_access_pass`: %+v", err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r UserTemporaryAccessPassResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Users.AuthenticationTemporaryAccessPassMethodClient
+
+			id, err := stable.ParseUserIdAuthenticationTemporaryAccessPassMethodID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var existing UserTemporaryAccessPassModel
+			if err = metadata.Decode(&existing); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			resp, err := client.GetAuthenticationTemporaryAccessPassMethod(ctx, *id, authenticationtemporaryaccesspassmethod.DefaultGetAuthenticationTemporaryAccessPassMethodOperationOptions())
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			method := resp.Model
+			if method == nil {
+				return fmt.Errorf("retrieving %s: model was nil", id)
+			}
+
+			state := UserTemporaryAccessPassModel{
+				CreatedDateTime:       method.CreatedDateTime.GetOrZero(),
+				LifetimeInMinutes:     method.LifetimeInMinutes.GetOrZero(),
+				MethodUsabilityReason: method.MethodUsabilityReason.GetOrZero(),
+				StartDateTime:         method.StartDateTime.GetOrZero(),
+				TemporaryAccessPass:   existing.TemporaryAccessPass,
+				Usable:                method.IsUsable.GetOrZero(),
+				UsableOnce:            method.IsUsableOnce.GetOrZero(),
+				UserId:                stable.NewUserID(id.UserId).ID(),
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r UserTemporaryAccessPassResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Users.AuthenticationTemporaryAccessPassMethodClient
+
+			id, err := stable.ParseUserIdAuthenticationTemporaryAccessPassMethodID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if resp, err := client.DeleteAuthenticationTemporaryAccessPassMethod(ctx, *id, authenticationtemporaryaccesspassmethod.DefaultDeleteAuthenticationTemporaryAccessPassMethodOperationOptions()); err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return nil
+				}
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
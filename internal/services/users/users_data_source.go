@@ -13,16 +13,83 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
-	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/users/stable/user"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
 	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/lookup"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
 )
 
+// usersFilterProperties are the user properties which can be referenced in an advanced query filter
+var usersFilterProperties = []string{
+	"accountEnabled",
+	"ageGroup",
+	"assignedLicenses",
+	"assignedPlans",
+	"businessPhones",
+	"city",
+	"companyName",
+	"consentProvidedForMinor",
+	"country",
+	"createdDateTime",
+	"creationType",
+	"department",
+	"displayName",
+	"employeeHireDate",
+	"employeeId",
+	"employeeOrgData",
+	"employeeType",
+	"externalUserState",
+	"faxNumber",
+	"givenName",
+	"id",
+	"identities",
+	"imAddresses",
+	"jobTitle",
+	"lastPasswordChangeDateTime",
+	"legalAgeGroupClassification",
+	"mail",
+	"mailNickname",
+	"mobilePhone",
+	"officeLocation",
+	"onPremisesDistinguishedName",
+	"onPremisesDomainName",
+	"onPremisesExtensionAttributes",
+	"onPremisesImmutableId",
+	"onPremisesLastSyncDateTime",
+	"onPremisesSamAccountName",
+	"onPremisesSecurityIdentifier",
+	"onPremisesSyncEnabled",
+	"onPremisesUserPrincipalName",
+	"otherMails",
+	"passwordPolicies",
+	"postalCode",
+	"preferredLanguage",
+	"proxyAddresses",
+	"showInAddressList",
+	"state",
+	"streetAddress",
+	"surname",
+	"usageLocation",
+	"userPrincipalName",
+	"userType",
+}
+
+// usersSearchProperties are the user properties which can be referenced in a search expression
+var usersSearchProperties = []string{
+	"displayName",
+	"givenName",
+	"mail",
+	"mailNickname",
+	"otherMails",
+	"proxyAddresses",
+	"surname",
+	"userPrincipalName",
+}
+
 func usersData() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		ReadContext: usersDataSourceRead,
@@ -33,11 +100,12 @@ func usersData() *pluginsdk.Resource {
 
 		Schema: map[string]*pluginsdk.Schema{
 			"employee_ids": {
-				Description:  "The employee identifier assigned to the user by the organisation",
-				Type:         pluginsdk.TypeList,
-				Optional:     true,
-				Computed:     true,
-				ExactlyOneOf: []string{"object_ids", "user_principal_names", "mail_nicknames", "mails", "employee_ids", "return_all"},
+				Description:   "The employee identifier assigned to the user by the organisation",
+				Type:          pluginsdk.TypeList,
+				Optional:      true,
+				Computed:      true,
+				AtLeastOneOf:  []string{"object_ids", "user_principal_names", "mail_nicknames", "mails", "employee_ids", "filter", "return_all", "search"},
+				ConflictsWith: []string{"object_ids", "user_principal_names", "mail_nicknames", "mails", "filter", "return_all", "search"},
 				Elem: &pluginsdk.Schema{
 					Type:         pluginsdk.TypeString,
 					ValidateFunc: validation.StringIsNotEmpty,
@@ -45,11 +113,12 @@ func usersData() *pluginsdk.Resource {
 			},
 
 			"mail_nicknames": {
-				Description:  "The email aliases of the users",
-				Type:         pluginsdk.TypeList,
-				Optional:     true,
-				Computed:     true,
-				ExactlyOneOf: []string{"object_ids", "user_principal_names", "mail_nicknames", "mails", "employee_ids", "return_all"},
+				Description:   "The email aliases of the users",
+				Type:          pluginsdk.TypeList,
+				Optional:      true,
+				Computed:      true,
+				AtLeastOneOf:  []string{"object_ids", "user_principal_names", "mail_nicknames", "mails", "employee_ids", "filter", "return_all", "search"},
+				ConflictsWith: []string{"object_ids", "user_principal_names", "mails", "employee_ids", "filter", "return_all", "search"},
 				Elem: &pluginsdk.Schema{
 					Type:         pluginsdk.TypeString,
 					ValidateFunc: validation.StringIsNotEmpty,
@@ -57,11 +126,12 @@ func usersData() *pluginsdk.Resource {
 			},
 
 			"mails": {
-				Description:  "The SMTP address of the users",
-				Type:         pluginsdk.TypeList,
-				Optional:     true,
-				Computed:     true,
-				ExactlyOneOf: []string{"object_ids", "user_principal_names", "mail_nicknames", "mails", "employee_ids", "return_all"},
+				Description:   "The SMTP address of the users",
+				Type:          pluginsdk.TypeList,
+				Optional:      true,
+				Computed:      true,
+				AtLeastOneOf:  []string{"object_ids", "user_principal_names", "mail_nicknames", "mails", "employee_ids", "filter", "return_all", "search"},
+				ConflictsWith: []string{"object_ids", "user_principal_names", "mail_nicknames", "employee_ids", "filter", "return_all", "search"},
 				Elem: &pluginsdk.Schema{
 					Type:         pluginsdk.TypeString,
 					ValidateFunc: validation.StringIsNotEmpty,
@@ -69,11 +139,12 @@ func usersData() *pluginsdk.Resource {
 			},
 
 			"object_ids": {
-				Description:  "The object IDs of the users",
-				Type:         pluginsdk.TypeList,
-				Optional:     true,
-				Computed:     true,
-				ExactlyOneOf: []string{"object_ids", "user_principal_names", "mail_nicknames", "mails", "employee_ids", "return_all"},
+				Description:   "The object IDs of the users",
+				Type:          pluginsdk.TypeList,
+				Optional:      true,
+				Computed:      true,
+				AtLeastOneOf:  []string{"object_ids", "user_principal_names", "mail_nicknames", "mails", "employee_ids", "filter", "return_all", "search"},
+				ConflictsWith: []string{"user_principal_names", "mail_nicknames", "mails", "employee_ids", "filter", "return_all", "search"},
 				Elem: &pluginsdk.Schema{
 					Type:         pluginsdk.TypeString,
 					ValidateFunc: validation.IsUUID,
@@ -81,23 +152,42 @@ func usersData() *pluginsdk.Resource {
 			},
 
 			"user_principal_names": {
-				Description:  "The user principal names (UPNs) of the users",
-				Type:         pluginsdk.TypeList,
-				Optional:     true,
-				Computed:     true,
-				ExactlyOneOf: []string{"object_ids", "user_principal_names", "mail_nicknames", "mails", "employee_ids", "return_all"},
+				Description:   "The user principal names (UPNs) of the users",
+				Type:          pluginsdk.TypeList,
+				Optional:      true,
+				Computed:      true,
+				AtLeastOneOf:  []string{"object_ids", "user_principal_names", "mail_nicknames", "mails", "employee_ids", "filter", "return_all", "search"},
+				ConflictsWith: []string{"object_ids", "mail_nicknames", "mails", "employee_ids", "filter", "return_all", "search"},
 				Elem: &pluginsdk.Schema{
 					Type:         pluginsdk.TypeString,
 					ValidateFunc: validation.StringIsNotEmpty,
 				},
 			},
 
+			"filter": {
+				Description:   "An OData `$filter` expression used to find users. This is sent as an advanced query, using the `ConsistencyLevel: eventual` header and `$count`, so that additional properties and operators can be used",
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				AtLeastOneOf:  []string{"object_ids", "user_principal_names", "mail_nicknames", "mails", "employee_ids", "filter", "return_all", "search"},
+				ConflictsWith: []string{"object_ids", "user_principal_names", "mail_nicknames", "mails", "employee_ids", "return_all"},
+				ValidateFunc:  validation.ODataFilter(usersFilterProperties),
+			},
+
+			"search": {
+				Description:   "An OData `$search` expression used to find users, in the form `\"property:value\"`. This is sent using the `ConsistencyLevel: eventual` header and `$count`, and is combined with `filter` when both are specified",
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				AtLeastOneOf:  []string{"object_ids", "user_principal_names", "mail_nicknames", "mails", "employee_ids", "filter", "return_all", "search"},
+				ConflictsWith: []string{"object_ids", "user_principal_names", "mail_nicknames", "mails", "employee_ids", "return_all"},
+				ValidateFunc:  validation.ODataSearch(usersSearchProperties),
+			},
+
 			"ignore_missing": {
 				Description:   "Ignore missing users and return users that were found. The data source will still fail if no users are found",
 				Type:          pluginsdk.TypeBool,
 				Optional:      true,
 				Default:       false,
-				ConflictsWith: []string{"return_all"},
+				ConflictsWith: []string{"filter", "return_all", "search"},
 			},
 
 			"return_all": {
@@ -105,8 +195,8 @@ func usersData() *pluginsdk.Resource {
 				Type:          pluginsdk.TypeBool,
 				Optional:      true,
 				Default:       false,
-				ConflictsWith: []string{"ignore_missing"},
-				ExactlyOneOf:  []string{"object_ids", "user_principal_names", "mail_nicknames", "mails", "employee_ids", "return_all"},
+				AtLeastOneOf:  []string{"object_ids", "user_principal_names", "mail_nicknames", "mails", "employee_ids", "filter", "return_all", "search"},
+				ConflictsWith: []string{"ignore_missing", "object_ids", "user_principal_names", "mail_nicknames", "mails", "employee_ids", "filter", "search"},
 			},
 
 			"users": {
@@ -194,6 +284,8 @@ func usersDataSourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta in
 	var expectedCount int
 	ignoreMissing := d.Get("ignore_missing").(bool)
 	returnAll := d.Get("return_all").(bool)
+	filter := d.Get("filter").(string)
+	search := d.Get("search").(string)
 
 	// Users API changes which fields it sends by default, so we explicitly select the fields we want, to guard against this
 	fieldsToSelect := []string{
@@ -225,137 +317,155 @@ func usersDataSourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta in
 
 		foundUsers = append(foundUsers, *resp.Model...)
 
-	} else if upns, ok := d.Get("user_principal_names").([]interface{}); ok && len(upns) > 0 {
-		expectedCount = len(upns)
-		for _, v := range upns {
-			options := user.ListUsersOperationOptions{
-				Filter: pointer.To(fmt.Sprintf("userPrincipalName eq '%s'", odata.EscapeSingleQuote(v.(string)))),
-				Select: &fieldsToSelect,
-			}
-			resp, err := client.ListUsers(ctx, options)
-			if err != nil {
-				return tf.ErrorDiagF(err, "Finding user with UPN: %q", v)
+	} else if filter != "" || search != "" {
+		// Advanced queries require the ConsistencyLevel header and a $count parameter
+		options := user.ListUsersOperationOptions{
+			ConsistencyLevel: pointer.To(odata.ConsistencyLevelEventual),
+			Count:            pointer.To(true),
+			Select:           &fieldsToSelect,
+		}
+		if filter != "" {
+			options.Filter = pointer.To(filter)
+		}
+		if search != "" {
+			options.Search = pointer.To(search)
+		}
+
+		resp, err := client.ListUsers(ctx, options)
+		if err != nil {
+			if filter == "" {
+				return tf.ErrorDiagPathF(err, "search", "Could not retrieve users matching search: %q", search)
 			}
-			if resp.Model == nil {
-				return tf.ErrorDiagF(errors.New("API returned nil result"), "Bad API Response")
+			return tf.ErrorDiagPathF(err, "filter", "Could not retrieve users matching filter: %q", filter)
+		}
+		if resp.Model == nil {
+			return tf.ErrorDiagF(errors.New("API returned nil result"), "Bad API Response")
+		}
+		if len(*resp.Model) == 0 {
+			if filter == "" {
+				return tf.ErrorDiagPathF(nil, "search", "No users found matching search: %q", search)
 			}
-			count := len(*resp.Model)
+			return tf.ErrorDiagPathF(nil, "filter", "No users found matching filter: %q", filter)
+		}
+
+		foundUsers = append(foundUsers, *resp.Model...)
+
+	} else if upns, ok := d.Get("user_principal_names").([]interface{}); ok && len(upns) > 0 {
+		expectedCount = len(upns)
+		results, err := listUsersByProperty(ctx, client, "userPrincipalName", tf.ExpandStringSlice(upns), fieldsToSelect, func(u stable.User) string {
+			return u.UserPrincipalName.GetOrZero()
+		})
+		if err != nil {
+			return tf.ErrorDiagF(err, "Finding users by UPN")
+		}
+
+		for _, v := range tf.ExpandStringSlice(upns) {
+			matches := results[strings.ToLower(v)]
+			count := len(matches)
 			if count > 1 {
 				return tf.ErrorDiagPathF(nil, "user_principal_names", "More than one user found with UPN: %q", v)
 			} else if count == 0 {
 				if ignoreMissing {
 					continue
 				}
-				return tf.ErrorDiagPathF(err, "user_principal_names", "User with UPN %q was not found", v)
+				return tf.ErrorDiagPathF(nil, "user_principal_names", "User with UPN %q was not found", v)
 			}
 
-			foundUsers = append(foundUsers, (*resp.Model)[0])
+			foundUsers = append(foundUsers, matches[0])
 		}
 
-	} else {
-		if objectIds, ok := d.Get("object_ids").([]interface{}); ok && len(objectIds) > 0 {
-			expectedCount = len(objectIds)
-			for _, v := range objectIds {
-				resp, err := client.GetUser(ctx, stable.NewUserID(v.(string)), user.GetUserOperationOptions{Select: &fieldsToSelect})
-				if err != nil {
-					if response.WasNotFound(resp.HttpResponse) {
-						if ignoreMissing {
-							continue
-						}
-						return tf.ErrorDiagPathF(nil, "object_id", "User not found with object ID: %q", v)
-					}
-					return tf.ErrorDiagF(err, "Retrieving user with object ID: %q", v)
-				}
-				if resp.Model == nil {
-					return tf.ErrorDiagPathF(nil, "object_id", "User not found with object ID: %q", v)
-				}
+	} else if objectIds, ok := d.Get("object_ids").([]interface{}); ok && len(objectIds) > 0 {
+		expectedCount = len(objectIds)
+		results, err := listUsersByProperty(ctx, client, "id", tf.ExpandStringSlice(objectIds), fieldsToSelect, func(u stable.User) string {
+			return pointer.From(u.Id)
+		})
+		if err != nil {
+			return tf.ErrorDiagF(err, "Retrieving users by object ID")
+		}
 
-				foundUsers = append(foundUsers, *resp.Model)
+		for _, v := range tf.ExpandStringSlice(objectIds) {
+			matches := results[strings.ToLower(v)]
+			if len(matches) == 0 {
+				if ignoreMissing {
+					continue
+				}
+				return tf.ErrorDiagPathF(nil, "object_id", "User not found with object ID: %q", v)
 			}
 
-		} else if mailNicknames, ok := d.Get("mail_nicknames").([]interface{}); ok && len(mailNicknames) > 0 {
-			expectedCount = len(mailNicknames)
-			for _, v := range mailNicknames {
-				options := user.ListUsersOperationOptions{
-					Filter: pointer.To(fmt.Sprintf("mailNickname eq '%s'", odata.EscapeSingleQuote(v.(string)))),
-					Select: &fieldsToSelect,
-				}
-				resp, err := client.ListUsers(ctx, options)
-				if err != nil {
-					return tf.ErrorDiagF(err, "Finding user with email alias: %q", v)
-				}
-				if resp.Model == nil {
-					return tf.ErrorDiagF(errors.New("API returned nil result"), "Bad API Response")
-				}
-				if len(*resp.Model) == 0 {
-					if ignoreMissing {
-						continue
-					}
-					return tf.ErrorDiagPathF(err, "mail_nicknames", "no user(s) found with email alias: %q", v)
-				}
+			foundUsers = append(foundUsers, matches[0])
+		}
 
-				foundUsers = append(foundUsers, *resp.Model...)
-			}
+	} else if mailNicknames, ok := d.Get("mail_nicknames").([]interface{}); ok && len(mailNicknames) > 0 {
+		expectedCount = len(mailNicknames)
+		results, err := listUsersByProperty(ctx, client, "mailNickname", tf.ExpandStringSlice(mailNicknames), fieldsToSelect, func(u stable.User) string {
+			return u.MailNickname.GetOrZero()
+		})
+		if err != nil {
+			return tf.ErrorDiagF(err, "Finding users by email alias")
+		}
 
-		} else if mails, ok := d.Get("mails").([]interface{}); ok && len(mails) > 0 {
-			expectedCount = len(mails)
-			for _, v := range mails {
-				options := user.ListUsersOperationOptions{
-					Filter: pointer.To(fmt.Sprintf("mail eq '%s'", odata.EscapeSingleQuote(v.(string)))),
-					Select: &fieldsToSelect,
-				}
-				resp, err := client.ListUsers(ctx, options)
-				if err != nil {
-					return tf.ErrorDiagF(err, "Finding user with mail address: %q", v)
-				}
-				if resp.Model == nil {
-					return tf.ErrorDiagF(errors.New("API returned nil result"), "Bad API Response")
+		for _, v := range tf.ExpandStringSlice(mailNicknames) {
+			matches := results[strings.ToLower(v)]
+			if len(matches) == 0 {
+				if ignoreMissing {
+					continue
 				}
+				return tf.ErrorDiagPathF(nil, "mail_nicknames", "no user(s) found with email alias: %q", v)
+			}
 
-				count := len(*resp.Model)
-				if count > 1 {
-					return tf.ErrorDiagPathF(nil, "mails", "More than one user found with mail address: %q", v)
-				} else if count == 0 {
-					if ignoreMissing {
-						continue
-					}
-					return tf.ErrorDiagPathF(err, "mails", "User not found with mail address: %q", v)
-				}
+			foundUsers = append(foundUsers, matches...)
+		}
 
-				foundUsers = append(foundUsers, (*resp.Model)[0])
-			}
+	} else if mails, ok := d.Get("mails").([]interface{}); ok && len(mails) > 0 {
+		expectedCount = len(mails)
+		results, err := listUsersByProperty(ctx, client, "mail", tf.ExpandStringSlice(mails), fieldsToSelect, func(u stable.User) string {
+			return u.Mail.GetOrZero()
+		})
+		if err != nil {
+			return tf.ErrorDiagF(err, "Finding users by mail address")
+		}
 
-		} else if employeeIds, ok := d.Get("employee_ids").([]interface{}); ok && len(employeeIds) > 0 {
-			expectedCount = len(employeeIds)
-			for _, v := range employeeIds {
-				options := user.ListUsersOperationOptions{
-					Filter: pointer.To(fmt.Sprintf("employeeId eq '%s'", odata.EscapeSingleQuote(v.(string)))),
-					Select: &fieldsToSelect,
-				}
-				resp, err := client.ListUsers(ctx, options)
-				if err != nil {
-					return tf.ErrorDiagF(err, "Finding user with employee ID: %q", v)
-				}
-				if resp.Model == nil {
-					return tf.ErrorDiagF(errors.New("API returned nil result"), "Bad API Response")
+		for _, v := range tf.ExpandStringSlice(mails) {
+			matches := results[strings.ToLower(v)]
+			count := len(matches)
+			if count > 1 {
+				return tf.ErrorDiagPathF(nil, "mails", "More than one user found with mail address: %q", v)
+			} else if count == 0 {
+				if ignoreMissing {
+					continue
 				}
+				return tf.ErrorDiagPathF(nil, "mails", "User not found with mail address: %q", v)
+			}
 
-				count := len(*resp.Model)
-				if count > 1 {
-					return tf.ErrorDiagPathF(nil, "employee_ids", "More than one user found with employee ID: %q", v)
-				} else if count == 0 {
-					if ignoreMissing {
-						continue
-					}
-					return tf.ErrorDiagPathF(err, "employee_ids", "User not found with employee ID: %q", v)
-				}
+			foundUsers = append(foundUsers, matches[0])
+		}
 
-				foundUsers = append(foundUsers, (*resp.Model)[0])
+	} else if employeeIds, ok := d.Get("employee_ids").([]interface{}); ok && len(employeeIds) > 0 {
+		expectedCount = len(employeeIds)
+		results, err := listUsersByProperty(ctx, client, "employeeId", tf.ExpandStringSlice(employeeIds), fieldsToSelect, func(u stable.User) string {
+			return u.EmployeeId.GetOrZero()
+		})
+		if err != nil {
+			return tf.ErrorDiagF(err, "Finding users by employee ID")
+		}
+
+		for _, v := range tf.ExpandStringSlice(employeeIds) {
+			matches := results[strings.ToLower(v)]
+			count := len(matches)
+			if count > 1 {
+				return tf.ErrorDiagPathF(nil, "employee_ids", "More than one user found with employee ID: %q", v)
+			} else if count == 0 {
+				if ignoreMissing {
+					continue
+				}
+				return tf.ErrorDiagPathF(nil, "employee_ids", "User not found with employee ID: %q", v)
 			}
+
+			foundUsers = append(foundUsers, matches[0])
 		}
 	}
 
-	if !returnAll && !ignoreMissing {
+	if !returnAll && !ignoreMissing && filter == "" && search == "" {
 		if len(foundUsers) < expectedCount {
 			return tf.ErrorDiagF(fmt.Errorf("expected at least: %d, actual: %d", expectedCount, len(foundUsers)), "Unexpected number of users returned")
 		}
@@ -416,3 +526,22 @@ func usersDataSourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta in
 
 	return nil
 }
+
+// listUsersByProperty retrieves users where the specified property matches any of the provided values, keyed by the
+// lower-cased value returned by key. See lookup.ByProperty.
+func listUsersByProperty(ctx context.Context, client *user.UserClient, property string, values []string, fieldsToSelect []string, key func(stable.User) string) (map[string][]stable.User, error) {
+	return lookup.ByProperty(ctx, property, values, func(ctx context.Context, filter string) ([]stable.User, error) {
+		options := user.ListUsersOperationOptions{
+			Filter: pointer.To(filter),
+			Select: &fieldsToSelect,
+		}
+		resp, err := client.ListUsers(ctx, options)
+		if err != nil {
+			return nil, fmt.Errorf("listing users with filter %q: %+v", filter, err)
+		}
+		if resp.Model == nil {
+			return nil, errors.New("API returned nil result")
+		}
+		return *resp.Model, nil
+	}, key)
+}
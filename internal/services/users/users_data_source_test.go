@@ -210,6 +210,45 @@ func TestAccUsersDataSource_returnAll(t *testing.T) {
 	}})
 }
 
+func TestAccUsersDataSource_byFilter(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_users", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{{
+		Config: UsersDataSource{}.byFilter(data),
+		Check: acceptance.ComposeTestCheckFunc(
+			check.That(data.ResourceName).Key("user_principal_names.#").HasValue("3"),
+			check.That(data.ResourceName).Key("object_ids.#").HasValue("3"),
+			check.That(data.ResourceName).Key("users.#").HasValue("3"),
+		),
+	}})
+}
+
+func TestAccUsersDataSource_byFilterWithSearch(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_users", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{{
+		Config: UsersDataSource{}.byFilterWithSearch(data),
+		Check: acceptance.ComposeTestCheckFunc(
+			check.That(data.ResourceName).Key("user_principal_names.#").HasValue("1"),
+			check.That(data.ResourceName).Key("object_ids.#").HasValue("1"),
+			check.That(data.ResourceName).Key("users.#").HasValue("1"),
+		),
+	}})
+}
+
+func TestAccUsersDataSource_bySearch(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_users", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{{
+		Config: UsersDataSource{}.bySearch(data),
+		Check: acceptance.ComposeTestCheckFunc(
+			check.That(data.ResourceName).Key("user_principal_names.#").HasValue("1"),
+			check.That(data.ResourceName).Key("object_ids.#").HasValue("1"),
+			check.That(data.ResourceName).Key("users.#").HasValue("1"),
+		),
+	}})
+}
+
 func (UsersDataSource) byUserPrincipalNames(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %[1]s
@@ -351,6 +390,43 @@ data "azuread_users" "test" {
 `, UserResource{}.threeUsersABC(data))
 }
 
+func (UsersDataSource) byFilter(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_users" "test" {
+  filter = "startsWith(displayName, 'acctestUser-%[2]d-') and accountEnabled eq true"
+
+  depends_on = [azuread_user.testA, azuread_user.testB, azuread_user.testC]
+}
+`, UserResource{}.threeUsersABC(data), data.RandomInteger)
+}
+
+func (UsersDataSource) byFilterWithSearch(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_users" "test" {
+  filter = "accountEnabled eq true and userType eq 'Member'"
+  search = "\"displayName:acctestUser-%[2]d-B\""
+
+  depends_on = [azuread_user.testA, azuread_user.testB, azuread_user.testC]
+}
+`, UserResource{}.threeUsersABC(data), data.RandomInteger)
+}
+
+func (UsersDataSource) bySearch(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_users" "test" {
+  search = "\"displayName:acctestUser-%[2]d-B\""
+
+  depends_on = [azuread_user.testA, azuread_user.testB, azuread_user.testC]
+}
+`, UserResource{}.threeUsersABC(data), data.RandomInteger)
+}
+
 func (UsersDataSource) noNames() string {
 	return `
 data "azuread_users" "test" {